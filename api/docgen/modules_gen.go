@@ -0,0 +1,92 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package docgen
+
+// moduleMethods holds the documentation and the permission levels of the methods of the modules.
+var moduleMethods = map[string]map[string]methodInfo{
+	"blob": {
+		"Submit":   {Doc: "Submit sends Blobs and reports the height in which they were included.\nAllows sending multiple Blobs atomically synchronously.\nUses default wallet registered on the Node.\n", Perm: "write"},
+		"Get":      {Doc: "Get retrieves the blob by commitment under the given namespace and height.\n", Perm: "read"},
+		"GetAll":   {Doc: "GetAll returns all blobs under the given namespaces and height.\n", Perm: "read"},
+		"GetProof": {Doc: "GetProof retrieves proofs in the given namespaces at the given height by commitment.\n", Perm: "read"},
+		"Included": {Doc: "Included checks whether a blob's given commitment(Merkle subtree root) is included at\ngiven height and under the namespace.\n", Perm: "read"},
+	},
+	"das": {
+		"SamplingStats": {Doc: "SamplingStats returns the current statistics over the DA sampling process.\n", Perm: "read"},
+		"WaitCatchUp":   {Doc: "WaitCatchUp blocks until DASer finishes catching up to the network head.\n", Perm: "read"},
+	},
+	"fraud": {
+		"Subscribe": {Doc: "Subscribe allows to subscribe on a Proof pub sub topic by its type.\n", Perm: "public"},
+		"Get":       {Doc: "Get fetches fraud proofs from the disk by its type.\n", Perm: "public"},
+	},
+	"header": {
+		"LocalHead":                {Doc: "LocalHead returns the ExtendedHeader of the chain head.\n", Perm: "read"},
+		"GetByHash":                {Doc: "GetByHash returns the header of the given hash from the node's header store.\n", Perm: "public"},
+		"GetVerifiedRangeByHeight": {Doc: "GetVerifiedRangeByHeight returns the given range [from:to) of ExtendedHeaders\nfrom the node's header store and verifies that the returned headers are\nadjacent to each other.\n", Perm: "public"},
+		"GetByHeight":              {Doc: "GetByHeight returns the ExtendedHeader at the given height if it is\ncurrently available.\n", Perm: "public"},
+		"WaitForHeight":            {Doc: "WaitForHeight blocks until the header at the given height has been processed\nby the store or context deadline is exceeded.\n", Perm: "read"},
+		"SyncState":                {Doc: "SyncState returns the current state of the header Syncer.\n", Perm: "read"},
+		"SyncWait":                 {Doc: "SyncWait blocks until the header Syncer is synced to network head.\n", Perm: "read"},
+		"NetworkHead":              {Doc: "NetworkHead provides the Syncer's view of the current network head.\n", Perm: "public"},
+		"Subscribe":                {Doc: "Subscribe to recent ExtendedHeaders from the network.\n", Perm: "public"},
+	},
+	"node": {
+		"Info":           {Doc: "Info returns administrative information about the node.\n", Perm: "admin"},
+		"LogLevelSet":    {Doc: "LogLevelSet sets the given component log level to the given level.\n", Perm: "admin"},
+		"AuthVerify":     {Doc: "AuthVerify returns the permissions assigned to the given token.\n", Perm: "admin"},
+		"AuthNew":        {Doc: "AuthNew signs and returns a new token with the given permissions.\n", Perm: "admin"},
+		"AuthIssue":      {Doc: "AuthIssue signs and returns a new token with the given permissions, label and optional scope,\nwhich expires after the given ttl. Zero ttl means the token never expires.\nCallers with a scoped token cannot issue tokens.\n", Perm: "admin"},
+		"AuthList":       {Doc: "AuthList lists the unexpired tokens issued by the node.\n", Perm: "admin"},
+		"AuthRevoke":     {Doc: "AuthRevoke revokes the issued token with the given ID.\n", Perm: "admin"},
+		"AuditQuery":     {Doc: "AuditQuery returns the most recent RPC audit log entries matching the query in chronological\norder.\n", Perm: "admin"},
+		"SnapshotCreate": {Doc: "SnapshotCreate writes a snapshot of the node's EDS store, header store and DAS checkpoint\ninto the given directory on the node's machine. An incomplete snapshot in the directory is\nresumed.\n", Perm: "admin"},
+	},
+	"p2p": {
+		"Info":                  {Doc: "Info returns address information about the host.\n", Perm: "admin"},
+		"Peers":                 {Doc: "Peers returns connected peers.\n", Perm: "admin"},
+		"PeerInfo":              {Doc: "PeerInfo returns a small slice of information Peerstore has on the\ngiven peer.\n", Perm: "admin"},
+		"Connect":               {Doc: "Connect ensures there is a connection between this host and the peer with\ngiven peer.\n", Perm: "admin"},
+		"ClosePeer":             {Doc: "ClosePeer closes the connection to a given peer.\n", Perm: "admin"},
+		"Connectedness":         {Doc: "Connectedness returns a state signaling connection capabilities.\n", Perm: "admin"},
+		"NATStatus":             {Doc: "NATStatus returns the current NAT status.\n", Perm: "admin"},
+		"BlockPeer":             {Doc: "BlockPeer adds a peer to the set of blocked peers.\n", Perm: "admin"},
+		"UnblockPeer":           {Doc: "UnblockPeer removes a peer from the set of blocked peers.\n", Perm: "admin"},
+		"ListBlockedPeers":      {Doc: "ListBlockedPeers returns a list of blocked peers.\n", Perm: "admin"},
+		"ListBlacklistedPeers":  {Doc: "ListBlacklistedPeers returns the peers blacklisted for misbehaving while serving shares, with\nthe reasons and expiration times. It is not available on bridge nodes.\n", Perm: "admin"},
+		"RemoveBlacklistedPeer": {Doc: "RemoveBlacklistedPeer removes a peer from the blacklist of misbehaving peers and unblocks it.\nIt is not available on bridge nodes.\n", Perm: "admin"},
+		"Protect":               {Doc: "Protect adds a peer to the list of peers who have a bidirectional\npeering agreement that they are protected from being trimmed, dropped\nor negatively scored.\n", Perm: "admin"},
+		"Unprotect":             {Doc: "Unprotect removes a peer from the list of peers who have a bidirectional\npeering agreement that they are protected from being trimmed, dropped\nor negatively scored, returning a bool representing whether the given\npeer is protected or not.\n", Perm: "admin"},
+		"IsProtected":           {Doc: "IsProtected returns whether the given peer is protected.\n", Perm: "admin"},
+		"BandwidthStats":        {Doc: "BandwidthStats returns a Stats struct with bandwidth metrics for all\ndata sent/received by the local peer, regardless of protocol or remote\npeer IDs.\n", Perm: "admin"},
+		"BandwidthForPeer":      {Doc: "BandwidthForPeer returns a Stats struct with bandwidth metrics associated with the given peer.ID.\nThe metrics returned include all traffic sent / received for the peer, regardless of protocol.\n", Perm: "admin"},
+		"BandwidthForProtocol":  {Doc: "BandwidthForProtocol returns a Stats struct with bandwidth metrics associated with the given\nprotocol.ID.\n", Perm: "admin"},
+		"ResourceState":         {Doc: "ResourceState returns the state of the resource manager.\n", Perm: "admin"},
+		"PubSubPeers":           {Doc: "PubSubPeers returns the peer IDs of the peers joined on\nthe given topic.\n", Perm: "admin"},
+		"PeerScores":            {Doc: "PeerScores returns the scores of the peers requested for shares, per shrex protocol.\nIt is not available on bridge nodes.\n", Perm: "admin"},
+	},
+	"share": {
+		"SharesAvailable":           {Doc: "SharesAvailable subjectively validates if Shares committed to the given Root are available on\nthe Network.\n", Perm: "public"},
+		"ProbabilityOfAvailability": {Doc: "ProbabilityOfAvailability calculates the probability of the data square\nbeing available based on the number of samples collected.\n", Perm: "public"},
+		"GetShare":                  {Doc: "GetShare gets a Share by coordinates in EDS.\n", Perm: "public"},
+		"GetEDS":                    {Doc: "GetEDS gets the full EDS identified by the given root.\n", Perm: "public"},
+		"GetSharesByNamespace":      {Doc: "GetSharesByNamespace gets all shares from an EDS within the given namespace.\nShares are returned in a row-by-row order if the namespace spans multiple rows.\n", Perm: "public"},
+		"ExportEDS":                 {Doc: "ExportEDS returns the EDS committed to by the header at the given height, serialized as a\nCARv1 file containing the CAR header and the original data square (first quadrant).\n", Perm: "admin"},
+		"ImportEDS":                 {Doc: "ImportEDS validates the given CARv1 file against the DataHash of the header at the given\nheight and stores the EDS in the local EDS store. Only supported by bridge and full nodes.\n", Perm: "admin"},
+	},
+	"state": {
+		"IsStopped":                 {Doc: "IsStopped checks if the Module's context has been stopped\n", Perm: "public"},
+		"AccountAddress":            {Doc: "AccountAddress retrieves the address of the node's account/signer\n", Perm: "read"},
+		"Balance":                   {Doc: "Balance retrieves the Celestia coin balance for the node's account/signer\nand verifies it against the corresponding block's AppHash.\n", Perm: "read"},
+		"BalanceForAddress":         {Doc: "BalanceForAddress retrieves the Celestia coin balance for the given address and verifies\nthe returned balance against the corresponding block's AppHash.\n\nNOTE: the balance returned is the balance reported by the block right before\nthe node's current head (head-1). This is due to the fact that for block N, the block's\n`AppHash` is the result of applying the previous block's transaction list.\n", Perm: "public"},
+		"Transfer":                  {Doc: "Transfer sends the given amount of coins from default wallet of the node to the given account\naddress.\n", Perm: "write"},
+		"SubmitTx":                  {Doc: "SubmitTx submits the given transaction/message to the\nCelestia network and blocks until the tx is included in\na block.\n", Perm: "write"},
+		"SubmitPayForBlob":          {Doc: "SubmitPayForBlob builds, signs and submits a PayForBlob transaction.\n", Perm: "write"},
+		"CancelUnbondingDelegation": {Doc: "CancelUnbondingDelegation cancels a user's pending undelegation from a validator.\n", Perm: "write"},
+		"BeginRedelegate":           {Doc: "BeginRedelegate sends a user's delegated tokens to a new validator for redelegation.\n", Perm: "write"},
+		"Undelegate":                {Doc: "Undelegate undelegates a user's delegated tokens, unbonding them from the current validator.\n", Perm: "write"},
+		"Delegate":                  {Doc: "Delegate sends a user's liquid tokens to a validator for delegation.\n", Perm: "write"},
+		"QueryDelegation":           {Doc: "QueryDelegation retrieves the delegation information between a delegator and a validator.\n", Perm: "public"},
+		"QueryUnbonding":            {Doc: "QueryUnbonding retrieves the unbonding status between a delegator and a validator.\n", Perm: "public"},
+		"QueryRedelegations":        {Doc: "QueryRedelegations retrieves the status of the redelegations between a delegator and a validator.\n", Perm: "public"},
+	},
+}
@@ -6,8 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"go/ast"
-	"go/parser"
-	"go/token"
 	"net"
 	"reflect"
 	"strings"
@@ -16,7 +14,6 @@ import (
 	go_openrpc_reflect "github.com/etclabscore/go-openrpc-reflect"
 	meta_schema "github.com/open-rpc/meta-schema"
 
-	"github.com/celestiaorg/celestia-node/api/rpc/client"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 )
 
@@ -28,63 +25,32 @@ const (
 	DocsName = "Celestia Node GitHub"
 )
 
-type Visitor struct {
-	Methods map[string]ast.Node
-}
-
-func (v *Visitor) Visit(node ast.Node) ast.Visitor {
-	st, ok := node.(*ast.TypeSpec)
-	if !ok {
-		return v
-	}
-
-	if st.Name.Name != "Module" {
-		return nil
-	}
-
-	iface := st.Type.(*ast.InterfaceType)
-	for _, m := range iface.Methods.List {
-		if len(m.Names) > 0 {
-			v.Methods[m.Names[0].Name] = m
-		}
-	}
+type Comments = map[string]string
 
-	return v
+// methodInfo describes a method of a module.
+type methodInfo struct {
+	Doc  string
+	Perm string
 }
 
-type Comments = map[string]string
-
-func ParseCommentsFromNodebuilderModules(moduleNames ...string) (Comments, Comments) {
-	fset := token.NewFileSet()
+// ModuleComments returns the documentation and the permission levels of the methods of the given
+// modules keyed by the module name followed by the method name. They are generated out of the
+// Module interfaces by `go generate ./api`.
+func ModuleComments(moduleNames ...string) (Comments, Comments) {
 	nodeComments := make(Comments)
 	permComments := make(Comments)
 	for _, moduleName := range moduleNames {
-		fileName := fmt.Sprintf("nodebuilder/%s/%s.go", moduleName, moduleName)
-		f, err := parser.ParseFile(fset, fileName, nil, parser.AllErrors|parser.ParseComments)
-		if err != nil {
-			panic(err)
+		methods, ok := moduleMethods[moduleName]
+		if !ok {
+			panic(fmt.Sprintf("docgen: unknown module %s", moduleName))
 		}
-
-		cmap := ast.NewCommentMap(fset, f, f.Comments)
-
-		v := &Visitor{make(map[string]ast.Node)}
-		ast.Walk(v, f)
-
-		for mn, node := range v.Methods {
-			filteredComments := cmap.Filter(node).Comments()
-			if len(filteredComments) == 0 {
-				nodeComments[moduleName+mn] = "No comment exists yet for this method."
-			} else {
-				nodeComments[moduleName+mn] = filteredComments[0].Text()
+		for name, info := range methods {
+			doc := info.Doc
+			if doc == "" {
+				doc = "No comment exists yet for this method."
 			}
-		}
-
-		module := reflect.TypeOf(client.Modules[moduleName]).Elem()
-		var meth reflect.StructField
-		for i := 0; i < module.NumField(); i++ {
-			meth = module.Field(i)
-			perms := meth.Tag.Get("perm")
-			permComments[meth.Name] = perms
+			nodeComments[moduleName+name] = doc
+			permComments[moduleName+name] = info.Perm
 		}
 	}
 	return nodeComments, permComments
@@ -162,7 +128,7 @@ func NewOpenRPCDocument(comments Comments, permissions Comments) *go_openrpc_ref
 
 	// remove the default implementation from the method descriptions
 	appReflector.FnGetMethodDescription = func(r reflect.Value, m reflect.Method, funcDecl *ast.FuncDecl) (string, error) {
-		if v, ok := permissions[m.Name]; ok {
+		if v, ok := permissions[extractPackageNameFromAPIMethod(m)+m.Name]; ok {
 			return "Auth level: " + v, nil
 		}
 		return "", nil // noComment
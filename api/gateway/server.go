@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 	"sync/atomic"
@@ -36,6 +37,12 @@ func NewServer(address, port string) *Server {
 	return server
 }
 
+// EnableTLS makes the gateway Server accept TLS connections only. It must be called before the
+// Server is started.
+func (s *Server) EnableTLS(cfg *tls.Config) {
+	s.srv.TLSConfig = cfg
+}
+
 // Start starts the gateway Server, listening on the given address.
 func (s *Server) Start(context.Context) error {
 	couldStart := s.started.CompareAndSwap(false, true)
@@ -47,6 +54,9 @@ func (s *Server) Start(context.Context) error {
 	if err != nil {
 		return err
 	}
+	if s.srv.TLSConfig != nil {
+		listener = tls.NewListener(listener, s.srv.TLSConfig)
+	}
 	s.listener = listener
 	log.Infow("server started", "listening on", s.srv.Addr)
 	//nolint:errcheck
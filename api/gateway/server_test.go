@@ -10,6 +10,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig"
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig/tlstest"
 )
 
 const (
@@ -70,6 +73,45 @@ func TestCorsEnabled(t *testing.T) {
 	require.Equal(t, resp.Header.Get("Access-Control-Allow-Origin"), "*")
 }
 
+func TestServer_TLS(t *testing.T) {
+	ca := tlstest.NewCA(t)
+	certPath, keyPath, caPath := ca.WriteFiles(t, t.TempDir(), "localhost")
+	cfg := &tlsconfig.Config{CertPath: certPath, KeyPath: keyPath, ClientCAPath: caPath}
+	tlsCfg, err := cfg.ServerConfig()
+	require.NoError(t, err)
+
+	server := NewServer(address, port)
+	server.EnableTLS(tlsCfg)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	err = server.Start(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop(ctx))
+	})
+
+	// register ping handler
+	ping := new(ping)
+	server.RegisterHandlerFunc("/ping", ping.ServeHTTP, http.MethodGet)
+
+	url := fmt.Sprintf("https://%s/ping", server.ListenAddr())
+
+	cli := &http.Client{Transport: &http.Transport{TLSClientConfig: ca.ClientConfig(t, "client")}}
+	resp, err := cli.Get(url)
+	require.NoError(t, err)
+	buf, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "pong", string(buf))
+
+	// clients without a certificate are rejected
+	cli = &http.Client{Transport: &http.Transport{TLSClientConfig: ca.ClientConfig(t, "")}}
+	_, err = cli.Get(url) //nolint:bodyclose
+	require.Error(t, err)
+}
+
 // TestServer_contextLeakProtection tests to ensure a context
 // deadline was added by the context wrapper middleware server-side.
 func TestServer_contextLeakProtection(t *testing.T) {
@@ -0,0 +1,4 @@
+// Package api holds the APIs the node is served over.
+package api
+
+//go:generate go run ./gen -root ..
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+)
+
+// compat records the method signatures of every version of the modules, keyed by the module name,
+// the version and the method name.
+type compat map[string]map[string]map[string]string
+
+func loadCompat(path string) (compat, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return make(compat), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := make(compat)
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// check ensures the methods recorded for the current version of the module are unchanged, so that
+// the clients of the version keep working, and records the methods added to it. Changing or
+// removing a method requires bumping the version of the module.
+func (c compat) check(mod *module) error {
+	version := "v" + strconv.Itoa(mod.Version)
+	versions, ok := c[mod.Name]
+	if !ok {
+		versions = make(map[string]map[string]string)
+		c[mod.Name] = versions
+	}
+	recorded, ok := versions[version]
+	if !ok {
+		recorded = make(map[string]string)
+		versions[version] = recorded
+	}
+
+	current := make(map[string]string, len(mod.Methods))
+	for _, m := range mod.Methods {
+		current[m.Name] = m.signature()
+	}
+	for name, signature := range recorded {
+		actual, ok := current[name]
+		switch {
+		case !ok:
+			return fmt.Errorf("%s.%s was removed from %s %s: bump the //version: of the module",
+				mod.Name, name, mod.Name, version)
+		case actual != signature:
+			return fmt.Errorf("%s.%s changed from %s to %s in %s %s: bump the //version: of the module",
+				mod.Name, name, signature, actual, mod.Name, version)
+		}
+	}
+	for name, signature := range current {
+		recorded[name] = signature
+	}
+	return nil
+}
+
+func (c compat) marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,496 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	// generatedFile is the name of the file the API wrappers are generated into in module packages.
+	generatedFile = "api_gen.go"
+	// docgenFile is the path of the file the OpenRPC metadata is generated into.
+	docgenFile = "api/docgen/modules_gen.go"
+	// compatFile is the path of the file recording the method signatures of every released version
+	// of the modules.
+	compatFile = "api/compat.json"
+
+	projectPath = "github.com/celestiaorg/celestia-node"
+	companyPath = "github.com/celestiaorg/"
+)
+
+var (
+	permRegexp    = regexp.MustCompile(`^perm:(\w+)$`)
+	versionRegexp = regexp.MustCompile(`^version:(\d+)$`)
+)
+
+// module is a node module with a Module interface.
+type module struct {
+	Name    string
+	Package string
+	Version int
+	Imports [][]string
+	Methods []*method
+}
+
+// method is a method of the Module interface.
+type method struct {
+	Name    string
+	Doc     string
+	Perm    string
+	Params  []param
+	Results []string
+}
+
+// signature returns the signature of the method without the parameter names.
+func (m *method) signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		params[i] = p.Type
+		if p.Variadic {
+			params[i] = "..." + p.Type
+		}
+	}
+	return "func(" + strings.Join(params, ", ") + ")" + funcs["results"].(func([]string) string)(m.Results)
+}
+
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// generate returns the generated files keyed by their paths relative to the root of the
+// repository.
+func generate(root string) (map[string][]byte, error) {
+	dirs, err := filepath.Glob(filepath.Join(root, "nodebuilder", "*"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(dirs)
+
+	compat, err := loadCompat(filepath.Join(root, compatFile))
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	var modules []*module
+	for _, dir := range dirs {
+		if st, err := os.Stat(dir); err != nil || !st.IsDir() {
+			continue
+		}
+		name := filepath.Base(dir)
+		mod, err := parseModule(dir, filepath.Join(dir, name+".go"))
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", name, err)
+		}
+		if mod == nil {
+			continue
+		}
+		if err = compat.check(mod); err != nil {
+			return nil, err
+		}
+
+		data, err := render(apiTemplate, mod)
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", name, err)
+		}
+		files[path.Join("nodebuilder", name, generatedFile)] = data
+		modules = append(modules, mod)
+	}
+
+	data, err := render(docgenTemplate, modules)
+	if err != nil {
+		return nil, fmt.Errorf("docgen: %w", err)
+	}
+	files[docgenFile] = data
+
+	data, err = compat.marshal()
+	if err != nil {
+		return nil, err
+	}
+	files[compatFile] = data
+	return files, nil
+}
+
+// parseModule parses the Module interface out of the file. It returns nil if the file does not
+// exist or has no Module interface.
+func parseModule(dir, file string) (*module, error) {
+	if _, err := os.Stat(file); os.IsNotExist(err) {
+		return nil, nil
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	iface, doc := findModule(f)
+	if iface == nil {
+		return nil, nil
+	}
+
+	mod := &module{
+		Name:    filepath.Base(dir),
+		Package: f.Name.Name,
+	}
+	if doc != nil {
+		for _, c := range doc.List {
+			if match := versionRegexp.FindStringSubmatch(strings.TrimPrefix(c.Text, "//")); match != nil {
+				mod.Version, _ = strconv.Atoi(match[1])
+			}
+		}
+	}
+	if mod.Version == 0 {
+		return nil, fmt.Errorf("Module has no //version: annotation")
+	}
+	used := make(map[string]bool)
+	for _, field := range iface.Methods.List {
+		if len(field.Names) == 0 {
+			return nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+		m, err := parseMethod(field)
+		if err != nil {
+			return nil, err
+		}
+		mod.Methods = append(mod.Methods, m)
+
+		ast.Inspect(field.Type, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					used[id.Name] = true
+				}
+			}
+			return true
+		})
+	}
+
+	imports, err := resolveImports(dir, f.Imports, used)
+	if err != nil {
+		return nil, err
+	}
+	mod.Imports = groupImports(imports)
+	return mod, nil
+}
+
+// findModule returns the Module interface of the file and its doc comment.
+func findModule(f *ast.File) (*ast.InterfaceType, *ast.CommentGroup) {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if iface, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == "Module" {
+				if ts.Doc != nil {
+					return iface, ts.Doc
+				}
+				return iface, gen.Doc
+			}
+		}
+	}
+	return nil, nil
+}
+
+func parseMethod(field *ast.Field) (*method, error) {
+	m := &method{
+		Name: field.Names[0].Name,
+		Doc:  field.Doc.Text(),
+	}
+	if field.Comment != nil {
+		// the annotation is read from the raw comment, as Text drops directive-like comments
+		for _, c := range field.Comment.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if match := permRegexp.FindStringSubmatch(text); match != nil {
+				m.Perm = match[1]
+			}
+		}
+	}
+	if m.Perm == "" {
+		return nil, fmt.Errorf("method %s has no //perm: annotation", m.Name)
+	}
+
+	fn := field.Type.(*ast.FuncType)
+	names := make(map[string]bool)
+	for i, p := range fn.Params.List {
+		typ := types.ExprString(p.Type)
+		ellipsis, variadic := p.Type.(*ast.Ellipsis)
+		if variadic {
+			typ = types.ExprString(ellipsis.Elt)
+		}
+
+		pnames := make([]string, 0, len(p.Names))
+		for _, n := range p.Names {
+			pnames = append(pnames, n.Name)
+		}
+		if len(pnames) == 0 {
+			pnames = append(pnames, "_")
+		}
+		for _, name := range pnames {
+			if name == "_" {
+				name = paramName(p.Type, i)
+			}
+			for names[name] {
+				name += strconv.Itoa(i)
+			}
+			names[name] = true
+			m.Params = append(m.Params, param{Name: name, Type: typ, Variadic: variadic})
+		}
+	}
+	if fn.Results != nil {
+		for _, r := range fn.Results.List {
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.Results = append(m.Results, types.ExprString(r.Type))
+			}
+		}
+	}
+	return m, nil
+}
+
+// paramName derives the name of an unnamed parameter from its type.
+func paramName(expr ast.Expr, i int) string {
+	plural := false
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+			continue
+		case *ast.ArrayType:
+			expr, plural = e.Elt, true
+			continue
+		case *ast.Ellipsis:
+			expr, plural = e.Elt, true
+			continue
+		}
+		break
+	}
+
+	var name string
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		if e.Sel.Name == "Context" {
+			return "ctx"
+		}
+		name = e.Sel.Name
+	case *ast.Ident:
+		if types.Universe.Lookup(e.Name) == nil {
+			name = e.Name
+		}
+	}
+	if name == "" {
+		return "p" + strconv.Itoa(i)
+	}
+
+	if strings.ToUpper(name) == name {
+		name = strings.ToLower(name)
+	} else {
+		runes := []rune(name)
+		runes[0] = unicode.ToLower(runes[0])
+		name = string(runes)
+	}
+	if plural {
+		name += "s"
+	}
+	if token.IsKeyword(name) {
+		name = "p" + strconv.Itoa(i)
+	}
+	return name
+}
+
+// resolveImports returns the imports of the file the used package names refer to, formatted as
+// import specs.
+func resolveImports(dir string, specs []*ast.ImportSpec, used map[string]bool) ([]string, error) {
+	imports := make([]string, 0, len(used))
+	resolved := make(map[string]bool)
+	var unresolved []*ast.ImportSpec
+	for _, spec := range specs {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case spec.Name != nil:
+			if used[spec.Name.Name] {
+				imports = append(imports, spec.Name.Name+" "+spec.Path.Value)
+				resolved[spec.Name.Name] = true
+			}
+		case used[path.Base(importPath)]:
+			imports = append(imports, spec.Path.Value)
+			resolved[path.Base(importPath)] = true
+		default:
+			unresolved = append(unresolved, spec)
+		}
+	}
+
+	// the names of packages not matching their paths require loading the packages
+	for name := range used {
+		if resolved[name] {
+			continue
+		}
+		found := false
+		for _, spec := range unresolved {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			pkg, err := build.Import(importPath, dir, 0)
+			if err != nil {
+				return nil, fmt.Errorf("loading %s: %w", importPath, err)
+			}
+			if pkg.Name == name {
+				imports = append(imports, spec.Path.Value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no import found for package %s", name)
+		}
+	}
+	return imports, nil
+}
+
+// groupImports groups the import specs into standard library, third party, company and project
+// imports.
+func groupImports(imports []string) [][]string {
+	groups := make([][]string, 4)
+	for _, spec := range imports {
+		importPath := spec[strings.Index(spec, `"`)+1 : len(spec)-1]
+		group := 1
+		switch {
+		case strings.HasPrefix(importPath, projectPath):
+			group = 3
+		case strings.HasPrefix(importPath, companyPath):
+			group = 2
+		case !strings.Contains(strings.Split(importPath, "/")[0], "."):
+			group = 0
+		}
+		groups[group] = append(groups[group], spec)
+	}
+
+	out := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			return importPathOf(group[i]) < importPathOf(group[j])
+		})
+		out = append(out, group)
+	}
+	return out
+}
+
+func importPathOf(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
+
+func render(tmpl *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, buf.String())
+	}
+	return out, nil
+}
+
+var funcs = template.FuncMap{
+	"params": func(params []param) string {
+		out := make([]string, len(params))
+		for i, p := range params {
+			if p.Variadic {
+				out[i] = p.Name + " ..." + p.Type
+			} else {
+				out[i] = p.Name + " " + p.Type
+			}
+		}
+		return strings.Join(out, ", ")
+	},
+	"args": func(params []param) string {
+		out := make([]string, len(params))
+		for i, p := range params {
+			out[i] = p.Name
+			if p.Variadic {
+				out[i] += "..."
+			}
+		}
+		return strings.Join(out, ", ")
+	},
+	"results": func(results []string) string {
+		switch len(results) {
+		case 0:
+			return ""
+		case 1:
+			return " " + results[0]
+		default:
+			return " (" + strings.Join(results, ", ") + ")"
+		}
+	},
+	"quote": strconv.Quote,
+}
+
+var apiTemplate = template.Must(template.New("api").Funcs(funcs).Parse(`// Code generated by api/gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+{{range .}}	{{.}}
+{{end}}
+{{- end}}
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "{{.Name}}.v{{.Version}}"
+// RPC namespace.
+const ModuleVersion = {{.Version}}
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+{{- range .Methods}}
+		{{.Name}} func({{params .Params}}){{results .Results}} ` + "`" + `perm:"{{.Perm}}"` + "`" + `
+{{- end}}
+	}
+}
+{{range .Methods}}
+func (api *API) {{.Name}}({{params .Params}}){{results .Results}} {
+	{{if .Results}}return {{end}}api.Internal.{{.Name}}({{args .Params}})
+}
+{{end}}`))
+
+var docgenTemplate = template.Must(template.New("docgen").Funcs(funcs).Parse(`// Code generated by api/gen. DO NOT EDIT.
+
+package docgen
+
+// moduleMethods holds the documentation and the permission levels of the methods of the modules.
+var moduleMethods = map[string]map[string]methodInfo{
+{{- range .}}
+	{{quote .Name}}: {
+{{- range .Methods}}
+		{{quote .Name}}: {Doc: {{quote .Doc}}, Perm: {{quote .Perm}}},
+{{- end}}
+	},
+{{- end}}
+}
+`))
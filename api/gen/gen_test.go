@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated ensures the generated files are up to date with the Module interfaces.
+func TestGenerated(t *testing.T) {
+	root := filepath.Join("..", "..")
+	files, err := generate(root)
+	require.NoError(t, err)
+
+	for path, expected := range files {
+		actual, err := os.ReadFile(filepath.Join(root, path))
+		require.NoError(t, err, "%s is missing, run `go generate ./api`", path)
+		require.Equal(t, string(expected), string(actual), "%s is out of date, run `go generate ./api`", path)
+	}
+
+	// files of removed modules must be removed as well
+	stale, err := filepath.Glob(filepath.Join(root, "nodebuilder", "*", generatedFile))
+	require.NoError(t, err)
+	for _, path := range stale {
+		rel, err := filepath.Rel(root, path)
+		require.NoError(t, err)
+		require.Contains(t, files, filepath.ToSlash(rel), "%s is stale, remove it", rel)
+	}
+}
+
+func TestGenerate_UnnamedParams(t *testing.T) {
+	files, err := generate(filepath.Join("..", ".."))
+	require.NoError(t, err)
+	// unnamed parameters are named after their types
+	require.Contains(t, string(files["nodebuilder/blob/api_gen.go"]),
+		"func (api *API) GetAll(ctx context.Context, height uint64, namespaces []share.Namespace)")
+}
+
+// TestCompat_Check ensures methods of a released version cannot be changed without bumping the
+// version of the module.
+func TestCompat_Check(t *testing.T) {
+	mod := func(version int, methods ...*method) *module {
+		return &module{Name: "test", Version: version, Methods: methods}
+	}
+	get := &method{
+		Name:    "Get",
+		Params:  []param{{Name: "ctx", Type: "context.Context"}, {Name: "height", Type: "uint64"}},
+		Results: []string{"[]byte", "error"},
+	}
+	changed := &method{
+		Name:    "Get",
+		Params:  []param{{Name: "ctx", Type: "context.Context"}, {Name: "height", Type: "int"}},
+		Results: []string{"[]byte", "error"},
+	}
+	head := &method{
+		Name:    "Head",
+		Params:  []param{{Name: "ctx", Type: "context.Context"}},
+		Results: []string{"error"},
+	}
+
+	c := make(compat)
+	require.NoError(t, c.check(mod(1, get)))
+	require.Equal(t, "func(context.Context, uint64) ([]byte, error)", c["test"]["v1"]["Get"])
+
+	// adding methods is compatible
+	require.NoError(t, c.check(mod(1, get, head)))
+	require.Contains(t, c["test"]["v1"], "Head")
+
+	// changing or removing them is not
+	require.ErrorContains(t, c.check(mod(1, changed, head)), "changed")
+	require.ErrorContains(t, c.check(mod(1, get)), "removed")
+
+	// unless the version is bumped
+	require.NoError(t, c.check(mod(2, changed)))
+	require.Equal(t, "func(context.Context, uint64) ([]byte, error)", c["test"]["v1"]["Get"])
+	require.Equal(t, "func(context.Context, int) ([]byte, error)", c["test"]["v2"]["Get"])
+}
@@ -0,0 +1,48 @@
+// Command gen generates the RPC wrappers of the node's modules out of their Module interfaces.
+//
+// Every method of a Module interface in nodebuilder/<module>/<module>.go must be annotated with
+// its permission level, e.g.:
+//
+//	// GetByHeight returns the ExtendedHeader at the given height.
+//	GetByHeight(context.Context, uint64) (*header.ExtendedHeader, error) //perm:public
+//
+// Every Module interface must be annotated with the version of its API, e.g. //version:1, which
+// is served under the "<module>.v<version>" RPC namespace. The signatures of the methods of every
+// version are recorded in api/compat.json, and gen fails if a recorded method is changed or removed
+// without bumping the version, as that breaks the clients of the version.
+//
+// For every module, gen writes the API struct with the Internal func fields the JSON-RPC client and
+// server are built from and the methods forwarding to them into nodebuilder/<module>/api_gen.go.
+// The documentation and permissions of the methods used by the OpenRPC spec are written into
+// api/docgen/modules_gen.go.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var root = flag.String("root", ".", "path to the root of the repository")
+
+func main() {
+	flag.Parse()
+	if err := run(*root); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(root string) error {
+	files, err := generate(root)
+	if err != nil {
+		return err
+	}
+	for path, data := range files {
+		if err := os.WriteFile(filepath.Join(root, path), data, 0644); err != nil { //nolint:gosec
+			return err
+		}
+	}
+	return nil
+}
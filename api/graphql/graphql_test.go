@@ -0,0 +1,239 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/cristalhq/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	blobpkg "github.com/celestiaorg/celestia-node/blob"
+	daspkg "github.com/celestiaorg/celestia-node/das"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	blobMock "github.com/celestiaorg/celestia-node/nodebuilder/blob/mocks"
+	"github.com/celestiaorg/celestia-node/nodebuilder/das"
+	dasMock "github.com/celestiaorg/celestia-node/nodebuilder/das/mocks"
+	headermod "github.com/celestiaorg/celestia-node/nodebuilder/header"
+	headerMock "github.com/celestiaorg/celestia-node/nodebuilder/header/mocks"
+	"github.com/celestiaorg/celestia-node/nodebuilder/share"
+	shareMock "github.com/celestiaorg/celestia-node/nodebuilder/share/mocks"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/sharetest"
+)
+
+type mocks struct {
+	header *headerMock.MockModule
+	share  *shareMock.MockModule
+	blob   *blobMock.MockModule
+	das    *dasMock.MockModule
+}
+
+func TestGraphQL(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv, m := setupServer(ctx, t, signer)
+	endpoint := "http://" + srv.ListenAddr() + "/graphql"
+
+	token, err := perms.NewTokenWithPerms(signer, perms.ReadPerms)
+	require.NoError(t, err)
+
+	t.Run("headers", func(t *testing.T) {
+		hdrs := headertest.NewTestSuite(t, 3).GenExtendedHeaders(3)
+		m.header.EXPECT().GetByHeight(gomock.Any(), uint64(1)).Return(hdrs[0], nil)
+		m.header.EXPECT().GetVerifiedRangeByHeight(gomock.Any(), gomock.Any(), uint64(4)).Return(hdrs[1:], nil)
+
+		var data struct {
+			Headers []struct {
+				Height     uint64
+				Hash       string
+				SquareSize int
+			}
+		}
+		errs := query(t, endpoint, "", `{ headers(from: 1, to: "3") { height hash squareSize } }`, &data)
+		require.Empty(t, errs)
+		require.Len(t, data.Headers, 3)
+		for i, h := range data.Headers {
+			require.Equal(t, hdrs[i].Height(), h.Height)
+			require.Equal(t, hdrs[i].Hash().String(), h.Hash)
+			require.Equal(t, 1, h.SquareSize)
+		}
+
+		errs = query(t, endpoint, "", `{ headers(from: 1, to: 5000) { height } }`, &data)
+		require.NotEmpty(t, errs)
+	})
+
+	t.Run("namespaces and blobs", func(t *testing.T) {
+		ns := sharetest.RandV0Namespace()
+		eds, dah := edstest.RandEDSWithNamespace(t, ns, 2)
+		eh := headertest.RandExtendedHeader(t)
+		eh.DAH = &dah
+		b, err := blobpkg.NewBlobV0(ns, []byte("data"))
+		require.NoError(t, err)
+
+		m.header.EXPECT().GetByHeight(gomock.Any(), eh.Height()).Return(eh, nil)
+		m.share.EXPECT().GetEDS(gomock.Any(), gomock.Any()).Return(eds, nil)
+		m.blob.EXPECT().GetAll(gomock.Any(), eh.Height(), gomock.Any()).Return([]*blobpkg.Blob{b}, nil)
+
+		var data struct {
+			Header struct {
+				SquareSize int
+				Namespaces []string
+				Blobs      []struct {
+					Namespace string
+					Size      int
+				}
+			}
+		}
+		q := `query($height: Uint64!, $ns: String!) {
+			header(height: $height) { squareSize namespaces blobs(namespaces: [$ns]) { namespace size } }
+		}`
+		errs := query(t, endpoint, string(token), q, &data, "height", eh.Height(), "ns", ns.String())
+		require.Empty(t, errs)
+		require.Equal(t, 2, data.Header.SquareSize)
+		require.Equal(t, []string{ns.String()}, data.Header.Namespaces)
+		require.Len(t, data.Header.Blobs, 1)
+		require.Equal(t, ns.String(), data.Header.Blobs[0].Namespace)
+		require.Equal(t, 4, data.Header.Blobs[0].Size)
+
+		// namespaces without blobs are not an error
+		m.blob.EXPECT().GetAll(gomock.Any(), uint64(10), gomock.Any()).Return(nil, blobpkg.ErrBlobNotFound)
+		errs = query(t, endpoint, string(token), `query($ns: String!) { blobs(height: 10, namespaces: [$ns]) { size } }`,
+			&data, "ns", ns.String())
+		require.Empty(t, errs)
+	})
+
+	t.Run("permissions", func(t *testing.T) {
+		var data struct {
+			SamplingStats struct {
+				SampledChainHead uint64
+				Failed           []struct {
+					Height uint64
+					Tries  int
+				}
+			}
+		}
+		q := `{ samplingStats { sampledChainHead failed { height tries } } }`
+		errs := query(t, endpoint, "", q, &data)
+		require.NotEmpty(t, errs)
+
+		m.das.EXPECT().SamplingStats(gomock.Any()).Return(daspkg.SamplingStats{
+			SampledChainHead: 10,
+			Failed:           map[uint64]int{7: 1, 5: 2},
+		}, nil)
+		errs = query(t, endpoint, string(token), q, &data)
+		require.Empty(t, errs)
+		require.EqualValues(t, 10, data.SamplingStats.SampledChainHead)
+		require.Len(t, data.SamplingStats.Failed, 2)
+		require.EqualValues(t, 5, data.SamplingStats.Failed[0].Height)
+		require.Equal(t, 2, data.SamplingStats.Failed[0].Tries)
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		hdrs := headertest.NewTestSuite(t, 3).GenExtendedHeaders(2)
+		headers := make(chan *header.ExtendedHeader, len(hdrs))
+		for _, h := range hdrs {
+			headers <- h
+		}
+		close(headers)
+		m.header.EXPECT().Subscribe(gomock.Any()).Return((<-chan *header.ExtendedHeader)(headers), nil)
+
+		dialer := websocket.Dialer{Subprotocols: []string{subprotocol}}
+		conn, _, err := dialer.DialContext(ctx, "ws://"+srv.ListenAddr()+"/graphql", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, conn.Close())
+		})
+
+		var msg message
+		require.NoError(t, conn.WriteJSON(message{Type: "connection_init"}))
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, "connection_ack", msg.Type)
+
+		payload, err := json.Marshal(request{Query: `subscription { newHeaders { height } }`})
+		require.NoError(t, err)
+		require.NoError(t, conn.WriteJSON(message{ID: "1", Type: "subscribe", Payload: payload}))
+		for _, h := range hdrs {
+			require.NoError(t, conn.ReadJSON(&msg))
+			require.Equal(t, "next", msg.Type)
+			require.Equal(t, "1", msg.ID)
+
+			var resp struct {
+				Data struct {
+					NewHeaders struct{ Height uint64 }
+				}
+			}
+			require.NoError(t, json.Unmarshal(msg.Payload, &resp))
+			require.Equal(t, h.Height(), resp.Data.NewHeaders.Height)
+		}
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, "complete", msg.Type)
+	})
+}
+
+// query posts the query with the variables given as key-value pairs and decodes its data into out,
+// returning the errors of the response.
+func query(t *testing.T, endpoint, token, q string, out interface{}, vars ...interface{}) []json.RawMessage {
+	variables := make(map[string]interface{}, len(vars)/2)
+	for i := 0; i < len(vars); i += 2 {
+		variables[vars[i].(string)] = vars[i+1]
+	}
+	body, err := json.Marshal(request{Query: q, Variables: variables})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set(perms.AuthKey, "Bearer "+url.PathEscape(token))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res struct {
+		Data   json.RawMessage
+		Errors []json.RawMessage
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	if len(res.Errors) == 0 {
+		require.NoError(t, json.Unmarshal(res.Data, out))
+	}
+	return res.Errors
+}
+
+// setupServer starts the server with the GraphQL handler over mocked modules.
+func setupServer(ctx context.Context, t *testing.T, signer jwt.Signer) (*rpc.Server, mocks) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		header: headerMock.NewMockModule(ctrl),
+		share:  shareMock.NewMockModule(ctrl),
+		blob:   blobMock.NewMockModule(ctrl),
+		das:    dasMock.NewMockModule(ctrl),
+	}
+
+	srv := rpc.NewServer("127.0.0.1", "0", signer, nil)
+	headerAPI, shareAPI, blobAPI, dasAPI := &headermod.API{}, &share.API{}, &blob.API{}, &das.API{}
+	srv.RegisterVersionedService("header", headermod.ModuleVersion, m.header, headerAPI)
+	srv.RegisterVersionedService("share", share.ModuleVersion, m.share, shareAPI)
+	srv.RegisterVersionedService("blob", blob.ModuleVersion, m.blob, blobAPI)
+	srv.RegisterVersionedService("das", das.ModuleVersion, m.das, dasAPI)
+	srv.RegisterHTTPHandler("/graphql", NewHandler(headerAPI, shareAPI, blobAPI, dasAPI))
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(ctx))
+	})
+	return srv, m
+}
@@ -0,0 +1,265 @@
+// Package graphql serves a GraphQL API over the header, share, blob and das modules, letting
+// clients select exactly the fields they need across them in a single query, e.g. the square size
+// and the blob namespaces of a range of headers. New headers can be subscribed to over a
+// websocket, with the graphql-transport-ws protocol.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	gql "github.com/graph-gophers/graphql-go"
+	logging "github.com/ipfs/go-log/v2"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	"github.com/celestiaorg/celestia-node/nodebuilder/das"
+	"github.com/celestiaorg/celestia-node/nodebuilder/header"
+	"github.com/celestiaorg/celestia-node/nodebuilder/share"
+)
+
+var log = logging.Logger("graphql")
+
+const (
+	// maxDepth limits the nesting of the queries.
+	maxDepth = 8
+	// maxBodySize limits the size of the query requests.
+	maxBodySize = 1 << 20
+	// subprotocol is the websocket subprotocol of the subscriptions.
+	subprotocol = "graphql-transport-ws"
+)
+
+// Handler serves the GraphQL queries over HTTP and the subscriptions over websockets.
+type Handler struct {
+	schema   *gql.Schema
+	upgrader websocket.Upgrader
+}
+
+// NewHandler creates a Handler resolving the queries with the given modules. The modules are
+// expected to be the APIs registered with rpc.Server.RegisterVersionedService, so that the fields
+// are resolved with the permissions of the caller.
+func NewHandler(
+	headerMod header.Module,
+	shareMod share.Module,
+	blobMod blob.Module,
+	dasMod das.Module,
+) *Handler {
+	r := &resolver{header: headerMod, share: shareMod, blob: blobMod, das: dasMod}
+	return &Handler{
+		schema: gql.MustParseSchema(schema, r, gql.MaxDepth(maxDepth)),
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{subprotocol},
+			// the requests are authenticated with the token rather than the origin
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+	}
+}
+
+// request is the GraphQL request of a query or a subscription.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		h.serveWebsocket(w, r)
+		return
+	}
+
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if vars := r.URL.Query().Get("variables"); vars != "" {
+			if err := decodeJSON(strings.NewReader(vars), &req.Variables); err != nil {
+				http.Error(w, "invalid variables: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := decodeJSON(http.MaxBytesReader(w, r.Body, maxBodySize), &req); err != nil {
+			http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp := h.schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Debugw("writing response", "err", err)
+	}
+}
+
+// decodeJSON decodes the numbers of the variables as json.Number, so that the heights above 2^53
+// keep their precision.
+func decodeJSON(r io.Reader, v interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// message is the message of the graphql-transport-ws protocol.
+type message struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// serveWebsocket serves the operations sent over the websocket until it is closed. Each operation
+// runs until it completes, fails or is completed by the client.
+func (h *Handler) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debugw("upgrading to websocket", "err", err)
+		return
+	}
+	defer conn.Close()
+	if conn.Subprotocol() != subprotocol {
+		closeWebsocket(conn, 4406, "subprotocol not acceptable")
+		return
+	}
+
+	// the operations are bound to the context of the request, which holds the permissions
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	var (
+		writeLk sync.Mutex
+		opsLk   sync.Mutex
+		ops     = make(map[string]context.CancelFunc)
+		acked   bool
+	)
+	write := func(msg message) {
+		writeLk.Lock()
+		defer writeLk.Unlock()
+		if err := conn.WriteJSON(msg); err != nil {
+			log.Debugw("writing websocket message", "err", err)
+			cancel()
+		}
+	}
+	closeWith := func(code int, reason string) {
+		writeLk.Lock()
+		defer writeLk.Unlock()
+		closeWebsocket(conn, code, reason)
+	}
+	complete := func(id string) bool {
+		opsLk.Lock()
+		defer opsLk.Unlock()
+		opCancel, ok := ops[id]
+		if ok {
+			opCancel()
+			delete(ops, id)
+		}
+		return ok
+	}
+
+	conn.SetReadLimit(maxBodySize)
+	for {
+		var msg message
+		if err := conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Debugw("reading websocket message", "err", err)
+			}
+			return
+		}
+
+		switch msg.Type {
+		case "connection_init":
+			if acked {
+				closeWith(4429, "too many initialisation requests")
+				return
+			}
+			acked = true
+			write(message{Type: "connection_ack"})
+		case "ping":
+			write(message{Type: "pong"})
+		case "pong":
+		case "subscribe":
+			if !acked {
+				closeWith(4401, "unauthorized")
+				return
+			}
+			var req request
+			if err := decodeJSON(bytes.NewReader(msg.Payload), &req); err != nil {
+				closeWith(4400, "invalid subscribe payload")
+				return
+			}
+
+			opsLk.Lock()
+			if _, ok := ops[msg.ID]; ok || msg.ID == "" {
+				opsLk.Unlock()
+				closeWith(4409, "subscriber for "+msg.ID+" already exists")
+				return
+			}
+			opCtx, opCancel := context.WithCancel(ctx)
+			ops[msg.ID] = opCancel
+			opsLk.Unlock()
+
+			go func(id string) {
+				h.runOperation(opCtx, id, req, write)
+				if complete(id) {
+					write(message{ID: id, Type: "complete"})
+				}
+			}(msg.ID)
+		case "complete":
+			complete(msg.ID)
+		default:
+			closeWith(4400, "unknown message type "+msg.Type)
+			return
+		}
+	}
+}
+
+// runOperation runs the operation and writes its results until it ends or ctx is done.
+func (h *Handler) runOperation(ctx context.Context, id string, req request, write func(message)) {
+	results, err := h.schema.Subscribe(ctx, req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		payload, _ := json.Marshal([]map[string]string{{"message": err.Error()}})
+		write(message{ID: id, Type: "error", Payload: payload})
+		return
+	}
+
+	for {
+		select {
+		case res, ok := <-results:
+			if !ok {
+				return
+			}
+			resp, ok := res.(*gql.Response)
+			if ok && resp.Data == nil && len(resp.Errors) > 0 {
+				payload, _ := json.Marshal(resp.Errors)
+				write(message{ID: id, Type: "error", Payload: payload})
+				return
+			}
+			payload, err := json.Marshal(res)
+			if err != nil {
+				log.Debugw("marshaling subscription result", "err", err)
+				return
+			}
+			write(message{ID: id, Type: "next", Payload: payload})
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// closeWebsocket closes the websocket with the code and reason of the graphql-transport-ws
+// protocol.
+func closeWebsocket(conn *websocket.Conn, code int, reason string) {
+	msg := websocket.FormatCloseMessage(code, reason)
+	if err := conn.WriteMessage(websocket.CloseMessage, msg); err != nil {
+		log.Debugw("closing websocket", "err", err)
+	}
+}
@@ -0,0 +1,398 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/das"
+	"github.com/celestiaorg/celestia-node/header"
+	blobmod "github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	dasmod "github.com/celestiaorg/celestia-node/nodebuilder/das"
+	headermod "github.com/celestiaorg/celestia-node/nodebuilder/header"
+	sharemod "github.com/celestiaorg/celestia-node/nodebuilder/share"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// maxRange is the maximum amount of headers a single headers query returns.
+const maxRange = 1000
+
+// resolver is the root resolver of the schema.
+type resolver struct {
+	header headermod.Module
+	share  sharemod.Module
+	blob   blobmod.Module
+	das    dasmod.Module
+}
+
+func (r *resolver) Header(ctx context.Context, args struct {
+	Height *Uint64
+	Hash   *string
+}) (*headerResolver, error) {
+	var (
+		eh  *header.ExtendedHeader
+		err error
+	)
+	switch {
+	case args.Height != nil && args.Hash != nil:
+		return nil, errors.New("either height or hash can be given")
+	case args.Height != nil:
+		eh, err = r.header.GetByHeight(ctx, uint64(*args.Height))
+	case args.Hash != nil:
+		var hash []byte
+		hash, err = hex.DecodeString(*args.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hash: %w", err)
+		}
+		eh, err = r.header.GetByHash(ctx, libhead.Hash(hash))
+	default:
+		eh, err = r.header.LocalHead(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.newHeaderResolver(eh), nil
+}
+
+func (r *resolver) Headers(ctx context.Context, args struct {
+	From Uint64
+	To   Uint64
+}) ([]*headerResolver, error) {
+	from, to := uint64(args.From), uint64(args.To)
+	switch {
+	case from > to:
+		return nil, fmt.Errorf("from %d is above to %d", from, to)
+	case to-from >= maxRange:
+		return nil, fmt.Errorf("range of %d headers exceeds the maximum of %d", to-from+1, maxRange)
+	}
+
+	first, err := r.header.GetByHeight(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	hdrs := []*header.ExtendedHeader{first}
+	if to > from {
+		rest, err := r.header.GetVerifiedRangeByHeight(ctx, first, to+1)
+		if err != nil {
+			return nil, err
+		}
+		hdrs = append(hdrs, rest...)
+	}
+
+	resolvers := make([]*headerResolver, len(hdrs))
+	for i, eh := range hdrs {
+		resolvers[i] = r.newHeaderResolver(eh)
+	}
+	return resolvers, nil
+}
+
+func (r *resolver) NetworkHead(ctx context.Context) (*headerResolver, error) {
+	eh, err := r.header.NetworkHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.newHeaderResolver(eh), nil
+}
+
+func (r *resolver) Blobs(ctx context.Context, args struct {
+	Height     Uint64
+	Namespaces []string
+}) ([]*blobResolver, error) {
+	return r.blobs(ctx, uint64(args.Height), args.Namespaces)
+}
+
+func (r *resolver) SamplingStats(ctx context.Context) (*samplingStatsResolver, error) {
+	stats, err := r.das.SamplingStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &samplingStatsResolver{stats: stats}, nil
+}
+
+func (r *resolver) NewHeaders(ctx context.Context) (<-chan *headerResolver, error) {
+	sub, err := r.header.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *headerResolver)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case eh, ok := <-sub:
+				if !ok {
+					return
+				}
+				select {
+				case out <- r.newHeaderResolver(eh):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (r *resolver) blobs(ctx context.Context, height uint64, namespaces []string) ([]*blobResolver, error) {
+	nss := make([]share.Namespace, len(namespaces))
+	for i, s := range namespaces {
+		ns, err := parseNamespace(s)
+		if err != nil {
+			return nil, err
+		}
+		nss[i] = ns
+	}
+
+	blobs, err := r.blob.GetAll(ctx, height, nss)
+	// the namespaces without blobs are not an error, the GetAll errors are joined per namespace
+	if err != nil && !(len(blobs) == 0 && errors.Is(err, blob.ErrBlobNotFound)) {
+		return nil, err
+	}
+	resolvers := make([]*blobResolver, len(blobs))
+	for i, b := range blobs {
+		resolvers[i] = &blobResolver{blob: b}
+	}
+	return resolvers, nil
+}
+
+// parseNamespace parses the hex encoded namespace of blobs.
+func parseNamespace(s string) (share.Namespace, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace %q: %w", s, err)
+	}
+	ns := share.Namespace(b)
+	if err = ns.ValidateForBlob(); err != nil {
+		return nil, fmt.Errorf("invalid namespace %q: %w", s, err)
+	}
+	return ns, nil
+}
+
+func (r *resolver) newHeaderResolver(eh *header.ExtendedHeader) *headerResolver {
+	return &headerResolver{root: r, eh: eh}
+}
+
+type headerResolver struct {
+	root *resolver
+	eh   *header.ExtendedHeader
+}
+
+func (h *headerResolver) Height() Uint64 {
+	return Uint64(h.eh.Height())
+}
+
+func (h *headerResolver) Hash() string {
+	return h.eh.Hash().String()
+}
+
+func (h *headerResolver) ChainID() string {
+	return h.eh.ChainID()
+}
+
+func (h *headerResolver) Time() string {
+	return h.eh.Time().UTC().Format(time.RFC3339Nano)
+}
+
+func (h *headerResolver) LastHeaderHash() string {
+	return h.eh.LastHeader().String()
+}
+
+func (h *headerResolver) DataHash() string {
+	return h.eh.DataHash.String()
+}
+
+func (h *headerResolver) ValidatorsHash() string {
+	return h.eh.ValidatorsHash.String()
+}
+
+func (h *headerResolver) AppHash() string {
+	return h.eh.AppHash.String()
+}
+
+func (h *headerResolver) ProposerAddress() string {
+	return h.eh.ProposerAddress.String()
+}
+
+func (h *headerResolver) SquareSize() int32 {
+	return int32(len(h.eh.DAH.RowRoots) / 2)
+}
+
+func (h *headerResolver) DAH() *dahResolver {
+	return &dahResolver{dah: h.eh.DAH}
+}
+
+func (h *headerResolver) Blobs(ctx context.Context, args struct{ Namespaces []string }) ([]*blobResolver, error) {
+	return h.root.blobs(ctx, h.eh.Height(), args.Namespaces)
+}
+
+func (h *headerResolver) Namespaces(ctx context.Context) ([]string, error) {
+	eds, err := h.root.share.GetEDS(ctx, h.eh.DAH)
+	if err != nil {
+		return nil, err
+	}
+
+	// the shares of the original data square are ordered by namespace, so the unique ones follow
+	// each other
+	var (
+		namespaces []string
+		last       share.Namespace
+	)
+	odsWidth := eds.Width() / 2
+	for i := uint(0); i < odsWidth; i++ {
+		for _, sh := range eds.Row(i)[:odsWidth] {
+			ns := share.GetNamespace(sh)
+			if ns.ValidateForBlob() != nil || ns.Equals(last) {
+				continue
+			}
+			namespaces = append(namespaces, ns.String())
+			last = ns
+		}
+	}
+	return namespaces, nil
+}
+
+type dahResolver struct {
+	dah *share.Root
+}
+
+func (d *dahResolver) Hash() string {
+	return hex.EncodeToString(d.dah.Hash())
+}
+
+func (d *dahResolver) RowRoots() []string {
+	return hexAll(d.dah.RowRoots)
+}
+
+func (d *dahResolver) ColumnRoots() []string {
+	return hexAll(d.dah.ColumnRoots)
+}
+
+func hexAll(bs [][]byte) []string {
+	out := make([]string, len(bs))
+	for i, b := range bs {
+		out[i] = hex.EncodeToString(b)
+	}
+	return out
+}
+
+type blobResolver struct {
+	blob *blob.Blob
+}
+
+func (b *blobResolver) Namespace() string {
+	return b.blob.Namespace().String()
+}
+
+func (b *blobResolver) Data() string {
+	return base64.StdEncoding.EncodeToString(b.blob.Data)
+}
+
+func (b *blobResolver) Size() int32 {
+	return int32(len(b.blob.Data))
+}
+
+func (b *blobResolver) ShareVersion() int32 {
+	return int32(b.blob.ShareVersion)
+}
+
+func (b *blobResolver) Commitment() string {
+	return hex.EncodeToString(b.blob.Commitment)
+}
+
+type samplingStatsResolver struct {
+	stats das.SamplingStats
+}
+
+func (s *samplingStatsResolver) SampledChainHead() Uint64 {
+	return Uint64(s.stats.SampledChainHead)
+}
+
+func (s *samplingStatsResolver) CatchupHead() Uint64 {
+	return Uint64(s.stats.CatchupHead)
+}
+
+func (s *samplingStatsResolver) NetworkHead() Uint64 {
+	return Uint64(s.stats.NetworkHead)
+}
+
+func (s *samplingStatsResolver) Failed() []*failedHeightResolver {
+	failed := make([]*failedHeightResolver, 0, len(s.stats.Failed))
+	for height, tries := range s.stats.Failed {
+		failed = append(failed, &failedHeightResolver{height: height, tries: tries})
+	}
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].height < failed[j].height
+	})
+	return failed
+}
+
+func (s *samplingStatsResolver) Workers() []*workerStatsResolver {
+	workers := make([]*workerStatsResolver, len(s.stats.Workers))
+	for i := range s.stats.Workers {
+		workers[i] = &workerStatsResolver{stats: s.stats.Workers[i]}
+	}
+	return workers
+}
+
+func (s *samplingStatsResolver) Concurrency() int32 {
+	return int32(s.stats.Concurrency)
+}
+
+func (s *samplingStatsResolver) CatchUpDone() bool {
+	return s.stats.CatchUpDone
+}
+
+func (s *samplingStatsResolver) IsRunning() bool {
+	return s.stats.IsRunning
+}
+
+type failedHeightResolver struct {
+	height uint64
+	tries  int
+}
+
+func (f *failedHeightResolver) Height() Uint64 {
+	return Uint64(f.height)
+}
+
+func (f *failedHeightResolver) Tries() int32 {
+	return int32(f.tries)
+}
+
+type workerStatsResolver struct {
+	stats das.WorkerStats
+}
+
+func (w *workerStatsResolver) JobType() string {
+	return fmt.Sprint(w.stats.JobType)
+}
+
+func (w *workerStatsResolver) Current() Uint64 {
+	return Uint64(w.stats.Curr)
+}
+
+func (w *workerStatsResolver) From() Uint64 {
+	return Uint64(w.stats.From)
+}
+
+func (w *workerStatsResolver) To() Uint64 {
+	return Uint64(w.stats.To)
+}
+
+func (w *workerStatsResolver) Error() *string {
+	if w.stats.ErrMsg == "" {
+		return nil
+	}
+	return &w.stats.ErrMsg
+}
@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Uint64 is the GraphQL scalar of the unsigned 64-bit integers, which do not fit into the Int of
+// GraphQL.
+type Uint64 uint64
+
+func (Uint64) ImplementsGraphQLType(name string) bool {
+	return name == "Uint64"
+}
+
+func (u *Uint64) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		if v < 0 {
+			return fmt.Errorf("negative Uint64 %d", v)
+		}
+		*u = Uint64(v)
+	case float64:
+		if v < 0 || v != float64(uint64(v)) {
+			return fmt.Errorf("invalid Uint64 %v", v)
+		}
+		*u = Uint64(v)
+	case json.Number:
+		return u.UnmarshalGraphQL(v.String())
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Uint64 %q: %w", v, err)
+		}
+		*u = Uint64(n)
+	default:
+		return fmt.Errorf("invalid Uint64 of type %T", input)
+	}
+	return nil
+}
+
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(u), 10), nil
+}
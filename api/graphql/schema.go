@@ -0,0 +1,92 @@
+package graphql
+
+// schema is the GraphQL schema served by the Handler. Binary values are hex encoded, except for
+// the data of the blobs, which is base64 encoded.
+const schema = `
+schema {
+	query: Query
+	subscription: Subscription
+}
+
+"Uint64 is an unsigned 64-bit integer, e.g. a height. Decimal strings are accepted as well."
+scalar Uint64
+
+type Query {
+	"The header at the height or with the hash, or the local head if neither is given."
+	header(height: Uint64, hash: String): ExtendedHeader!
+	"The headers in the inclusive range of heights, of at most 1000 headers."
+	headers(from: Uint64!, to: Uint64!): [ExtendedHeader!]!
+	"The most recent header in the network."
+	networkHead: ExtendedHeader!
+	"The blobs of the namespaces at the height."
+	blobs(height: Uint64!, namespaces: [String!]!): [Blob!]!
+	"The statistics of the data availability sampling."
+	samplingStats: SamplingStats!
+}
+
+type Subscription {
+	"The headers as they are synced."
+	newHeaders: ExtendedHeader!
+}
+
+type ExtendedHeader {
+	height: Uint64!
+	hash: String!
+	chainId: String!
+	"The time of the block in RFC 3339 format."
+	time: String!
+	lastHeaderHash: String!
+	dataHash: String!
+	validatorsHash: String!
+	appHash: String!
+	proposerAddress: String!
+	"The width of the original data square."
+	squareSize: Int!
+	dah: DataAvailabilityHeader!
+	"The blobs of the namespaces in the block."
+	blobs(namespaces: [String!]!): [Blob!]!
+	"The namespaces of the blobs in the block. Resolving them retrieves the whole data square."
+	namespaces: [String!]!
+}
+
+type DataAvailabilityHeader {
+	hash: String!
+	rowRoots: [String!]!
+	columnRoots: [String!]!
+}
+
+type Blob {
+	namespace: String!
+	"The base64 encoded data of the blob."
+	data: String!
+	size: Int!
+	shareVersion: Int!
+	commitment: String!
+}
+
+type SamplingStats {
+	"All the headers before it were sampled."
+	sampledChainHead: Uint64!
+	"All the headers before it were submitted to the sampling workers."
+	catchupHead: Uint64!
+	networkHead: Uint64!
+	failed: [FailedHeight!]!
+	workers: [WorkerStats!]!
+	concurrency: Int!
+	catchUpDone: Boolean!
+	isRunning: Boolean!
+}
+
+type FailedHeight {
+	height: Uint64!
+	tries: Int!
+}
+
+type WorkerStats {
+	jobType: String!
+	current: Uint64!
+	from: Uint64!
+	to: Uint64!
+	error: String
+}
+`
@@ -0,0 +1,113 @@
+package grpcapi
+
+import (
+	"context"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	pb "github.com/celestiaorg/celestia-node/api/grpcapi/pb"
+	"github.com/celestiaorg/celestia-node/blob"
+	blobmod "github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/byzantine"
+)
+
+type blobServer struct {
+	mod blobmod.Module
+}
+
+func (s *blobServer) Submit(ctx context.Context, req *pb.SubmitRequest) (*pb.SubmitResponse, error) {
+	blobs := make([]*blob.Blob, len(req.Blobs))
+	for i, b := range req.Blobs {
+		var err error
+		if blobs[i], err = blobFromProto(b); err != nil {
+			return nil, invalidArgument(err)
+		}
+	}
+	height, err := s.mod.Submit(ctx, blobs)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubmitResponse{Height: height}, nil
+}
+
+func (s *blobServer) Get(ctx context.Context, req *pb.GetBlobRequest) (*tmproto.Blob, error) {
+	namespace, err := share.NamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	b, err := s.mod.Get(ctx, req.Height, namespace, req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	return &b.Blob, nil
+}
+
+func (s *blobServer) GetAll(req *pb.GetAllBlobsRequest, stream pb.Blob_GetAllServer) error {
+	namespaces := make([]share.Namespace, len(req.Namespaces))
+	for i, ns := range req.Namespaces {
+		var err error
+		if namespaces[i], err = share.NamespaceFromBytes(ns); err != nil {
+			return invalidArgument(err)
+		}
+	}
+	blobs, err := s.mod.GetAll(stream.Context(), req.Height, namespaces)
+	if err != nil {
+		return err
+	}
+	for _, b := range blobs {
+		if err = stream.Send(&b.Blob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *blobServer) GetProof(ctx context.Context, req *pb.GetBlobRequest) (*pb.BlobProof, error) {
+	namespace, err := share.NamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	proof, err := s.mod.GetProof(ctx, req.Height, namespace, req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.BlobProof{}
+	if proof != nil {
+		for _, p := range *proof {
+			msg.Proofs = append(msg.Proofs, protoProof(p))
+		}
+	}
+	return msg, nil
+}
+
+func (s *blobServer) Included(ctx context.Context, req *pb.IncludedRequest) (*pb.IncludedResponse, error) {
+	namespace, err := share.NamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	proof := &blob.Proof{}
+	if req.Proof != nil {
+		for _, p := range req.Proof.Proofs {
+			if p == nil {
+				continue
+			}
+			nmtProof := byzantine.ProtoToProof(p)
+			*proof = append(*proof, &nmtProof)
+		}
+	}
+	included, err := s.mod.Included(ctx, req.Height, namespace, proof, req.Commitment)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IncludedResponse{Included: included}, nil
+}
+
+// blobFromProto converts the message into the blob, computing its commitment.
+func blobFromProto(b *tmproto.Blob) (*blob.Blob, error) {
+	namespace, err := share.NamespaceFromBytes(append([]byte{byte(b.NamespaceVersion)}, b.NamespaceId...))
+	if err != nil {
+		return nil, err
+	}
+	return blob.NewBlob(uint8(b.ShareVersion), namespace, b.Data)
+}
@@ -0,0 +1,50 @@
+package grpcapi
+
+import (
+	"context"
+
+	pb "github.com/celestiaorg/celestia-node/api/grpcapi/pb"
+	dasmod "github.com/celestiaorg/celestia-node/nodebuilder/das"
+)
+
+type dasServer struct {
+	mod dasmod.Module
+}
+
+func (s *dasServer) SamplingStats(ctx context.Context, _ *pb.SamplingStatsRequest) (*pb.SamplingStatsResponse, error) {
+	stats, err := s.mod.SamplingStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.SamplingStatsResponse{
+		SampledChainHead: stats.SampledChainHead,
+		CatchupHead:      stats.CatchupHead,
+		NetworkHead:      stats.NetworkHead,
+		Concurrency:      int64(stats.Concurrency),
+		CatchUpDone:      stats.CatchUpDone,
+		IsRunning:        stats.IsRunning,
+	}
+	if len(stats.Failed) != 0 {
+		msg.Failed = make(map[uint64]int64, len(stats.Failed))
+		for height, tries := range stats.Failed {
+			msg.Failed[height] = int64(tries)
+		}
+	}
+	for _, w := range stats.Workers {
+		msg.Workers = append(msg.Workers, &pb.WorkerStats{
+			JobType: string(w.JobType),
+			Current: w.Curr,
+			From:    w.From,
+			To:      w.To,
+			Error:   w.ErrMsg,
+		})
+	}
+	return msg, nil
+}
+
+func (s *dasServer) WaitCatchUp(ctx context.Context, _ *pb.WaitCatchUpRequest) (*pb.WaitCatchUpResponse, error) {
+	if err := s.mod.WaitCatchUp(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.WaitCatchUpResponse{}, nil
+}
@@ -0,0 +1,47 @@
+// Package grpcapi serves the header, share, blob and das modules over gRPC, mirroring their
+// JSON-RPC API with the protobuf messages of the pb package. Shares, proofs and blobs are sent in
+// their binary form, and subscriptions and data-heavy calls are streamed.
+package grpcapi
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/celestiaorg/celestia-node/api/grpcapi/pb"
+	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	"github.com/celestiaorg/celestia-node/nodebuilder/das"
+	"github.com/celestiaorg/celestia-node/nodebuilder/header"
+	"github.com/celestiaorg/celestia-node/nodebuilder/share"
+)
+
+// Register registers the gRPC services of the modules onto the server. The modules are expected
+// to be the APIs registered with rpc.Server.RegisterVersionedService, so that the gRPC calls are
+// checked against the permissions, the scope and the limits of the caller like the JSON-RPC ones.
+func Register(
+	srv *rpc.Server,
+	headerMod header.Module,
+	shareMod share.Module,
+	blobMod blob.Module,
+	dasMod das.Module,
+) {
+	r := registrar(srv.RegisterGRPCService)
+	pb.RegisterHeaderServer(r, &headerServer{mod: headerMod})
+	pb.RegisterShareServer(r, &shareServer{mod: shareMod})
+	pb.RegisterBlobServer(r, &blobServer{mod: blobMod})
+	pb.RegisterDASServer(r, &dasServer{mod: dasMod})
+}
+
+// registrar adapts rpc.Server.RegisterGRPCService to the registrar the generated code expects.
+type registrar func(desc *grpc.ServiceDesc, impl interface{})
+
+func (r registrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	r(desc, impl)
+}
+
+// invalidArgument returns the error of a request that cannot be converted into the arguments of
+// the module method.
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
@@ -0,0 +1,157 @@
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cristalhq/jwt"
+	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/celestiaorg/celestia-node/api/grpcapi/pb"
+	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	daspkg "github.com/celestiaorg/celestia-node/das"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig"
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig/tlstest"
+	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
+	blobMock "github.com/celestiaorg/celestia-node/nodebuilder/blob/mocks"
+	"github.com/celestiaorg/celestia-node/nodebuilder/das"
+	dasMock "github.com/celestiaorg/celestia-node/nodebuilder/das/mocks"
+	headermod "github.com/celestiaorg/celestia-node/nodebuilder/header"
+	headerMock "github.com/celestiaorg/celestia-node/nodebuilder/header/mocks"
+	"github.com/celestiaorg/celestia-node/nodebuilder/share"
+	shareMock "github.com/celestiaorg/celestia-node/nodebuilder/share/mocks"
+)
+
+func TestGRPC(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv, headerMod, dasMod := setupServer(ctx, t, signer, nil)
+
+	conn, err := grpc.DialContext(ctx, srv.ListenAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+	headerClient, dasClient := pb.NewHeaderClient(conn), pb.NewDASClient(conn)
+
+	// public methods are served without a token
+	expected := headertest.RandExtendedHeader(t)
+	headerMod.EXPECT().GetByHeight(gomock.Any(), expected.Height()).Return(expected, nil)
+	resp, err := headerClient.GetByHeight(ctx, &pb.GetByHeightRequest{Height: expected.Height()})
+	require.NoError(t, err)
+	got, err := header.ProtoToExtendedHeader(resp)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), got.Hash())
+
+	// others require the permissions of the token
+	_, err = dasClient.SamplingStats(ctx, &pb.SamplingStatsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	token, err := perms.NewTokenWithPerms(signer, perms.ReadPerms)
+	require.NoError(t, err)
+	authCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+string(token))
+	dasMod.EXPECT().SamplingStats(gomock.Any()).Return(daspkg.SamplingStats{
+		SampledChainHead: 10,
+		Failed:           map[uint64]int{5: 2},
+	}, nil)
+	stats, err := dasClient.SamplingStats(authCtx, &pb.SamplingStatsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, stats.SampledChainHead)
+	require.Equal(t, map[uint64]int64{5: 2}, stats.Failed)
+
+	// subscriptions are streamed
+	headers := make(chan *header.ExtendedHeader, 2)
+	headers <- headertest.RandExtendedHeader(t)
+	headers <- expected
+	close(headers)
+	headerMod.EXPECT().Subscribe(gomock.Any()).Return((<-chan *header.ExtendedHeader)(headers), nil)
+	sub, err := headerClient.Subscribe(ctx, &pb.SubscribeHeadersRequest{})
+	require.NoError(t, err)
+	var received []*header.ExtendedHeader
+	for {
+		msg, err := sub.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		h, err := header.ProtoToExtendedHeader(msg)
+		require.NoError(t, err)
+		received = append(received, h)
+	}
+	require.Len(t, received, 2)
+	require.Equal(t, expected.Hash(), received[1].Hash())
+}
+
+func TestGRPC_TLS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ca := tlstest.NewCA(t)
+	certPath, keyPath, caPath := ca.WriteFiles(t, t.TempDir(), "localhost")
+	cfg := &tlsconfig.Config{CertPath: certPath, KeyPath: keyPath, ClientCAPath: caPath}
+	tlsCfg, err := cfg.ServerConfig()
+	require.NoError(t, err)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv, _, dasMod := setupServer(ctx, t, signer, func(srv *rpc.Server) {
+		srv.EnableTLS(tlsCfg, map[string][]auth.Permission{"operator": perms.ReadPerms})
+	})
+
+	creds := credentials.NewTLS(ca.ClientConfig(t, "operator"))
+	conn, err := grpc.DialContext(ctx, srv.ListenAddr(), grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+
+	// the client certificate is granted its permissions
+	dasMod.EXPECT().SamplingStats(gomock.Any()).Return(daspkg.SamplingStats{CatchUpDone: true}, nil)
+	stats, err := pb.NewDASClient(conn).SamplingStats(ctx, &pb.SamplingStatsRequest{})
+	require.NoError(t, err)
+	require.True(t, stats.CatchUpDone)
+}
+
+// setupServer starts the server with the gRPC services of mocked modules, calling configure
+// before the registration, if set.
+func setupServer(
+	ctx context.Context,
+	t *testing.T,
+	signer jwt.Signer,
+	configure func(*rpc.Server),
+) (*rpc.Server, *headerMock.MockModule, *dasMock.MockModule) {
+	ctrl := gomock.NewController(t)
+	headerMod := headerMock.NewMockModule(ctrl)
+	dasMod := dasMock.NewMockModule(ctrl)
+
+	srv := rpc.NewServer("127.0.0.1", "0", signer, nil)
+	if configure != nil {
+		configure(srv)
+	}
+	headerAPI, shareAPI, blobAPI, dasAPI := &headermod.API{}, &share.API{}, &blob.API{}, &das.API{}
+	srv.RegisterVersionedService("header", headermod.ModuleVersion, headerMod, headerAPI)
+	srv.RegisterVersionedService("share", share.ModuleVersion, shareMock.NewMockModule(ctrl), shareAPI)
+	srv.RegisterVersionedService("blob", blob.ModuleVersion, blobMock.NewMockModule(ctrl), blobAPI)
+	srv.RegisterVersionedService("das", das.ModuleVersion, dasMod, dasAPI)
+	Register(srv, headerAPI, shareAPI, blobAPI, dasAPI)
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(ctx))
+	})
+	return srv, headerMod, dasMod
+}
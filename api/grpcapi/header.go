@@ -0,0 +1,132 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	pb "github.com/celestiaorg/celestia-node/api/grpcapi/pb"
+	"github.com/celestiaorg/celestia-node/header"
+	header_pb "github.com/celestiaorg/celestia-node/header/pb"
+	headermod "github.com/celestiaorg/celestia-node/nodebuilder/header"
+)
+
+type headerServer struct {
+	mod headermod.Module
+}
+
+func (s *headerServer) LocalHead(ctx context.Context, _ *pb.LocalHeadRequest) (*header_pb.ExtendedHeader, error) {
+	return protoHeader(s.mod.LocalHead(ctx))
+}
+
+func (s *headerServer) GetByHash(ctx context.Context, req *pb.GetByHashRequest) (*header_pb.ExtendedHeader, error) {
+	return protoHeader(s.mod.GetByHash(ctx, libhead.Hash(req.Hash)))
+}
+
+func (s *headerServer) GetVerifiedRangeByHeight(
+	req *pb.GetVerifiedRangeByHeightRequest,
+	stream pb.Header_GetVerifiedRangeByHeightServer,
+) error {
+	if req.From == nil {
+		return invalidArgument(errMissingHeader)
+	}
+	from, err := header.ProtoToExtendedHeader(req.From)
+	if err != nil {
+		return invalidArgument(err)
+	}
+	headers, err := s.mod.GetVerifiedRangeByHeight(stream.Context(), from, req.To)
+	if err != nil {
+		return err
+	}
+	for _, h := range headers {
+		if err = sendHeader(stream.Send, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *headerServer) GetByHeight(ctx context.Context, req *pb.GetByHeightRequest) (*header_pb.ExtendedHeader, error) {
+	return protoHeader(s.mod.GetByHeight(ctx, req.Height))
+}
+
+func (s *headerServer) WaitForHeight(
+	ctx context.Context,
+	req *pb.GetByHeightRequest,
+) (*header_pb.ExtendedHeader, error) {
+	return protoHeader(s.mod.WaitForHeight(ctx, req.Height))
+}
+
+func (s *headerServer) SyncState(ctx context.Context, _ *pb.SyncStateRequest) (*pb.SyncStateResponse, error) {
+	state, err := s.mod.SyncState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SyncStateResponse{
+		Id:         state.ID,
+		Height:     state.Height,
+		FromHeight: state.FromHeight,
+		ToHeight:   state.ToHeight,
+		FromHash:   state.FromHash,
+		ToHash:     state.ToHash,
+		Start:      unixNano(state.Start),
+		End:        unixNano(state.End),
+		Error:      state.Error,
+	}, nil
+}
+
+func (s *headerServer) SyncWait(ctx context.Context, _ *pb.SyncWaitRequest) (*pb.SyncWaitResponse, error) {
+	if err := s.mod.SyncWait(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.SyncWaitResponse{}, nil
+}
+
+func (s *headerServer) NetworkHead(ctx context.Context, _ *pb.NetworkHeadRequest) (*header_pb.ExtendedHeader, error) {
+	return protoHeader(s.mod.NetworkHead(ctx))
+}
+
+func (s *headerServer) Subscribe(_ *pb.SubscribeHeadersRequest, stream pb.Header_SubscribeServer) error {
+	ctx := stream.Context()
+	headers, err := s.mod.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case h, ok := <-headers:
+			if !ok {
+				return nil
+			}
+			if err = sendHeader(stream.Send, h); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func protoHeader(h *header.ExtendedHeader, err error) (*header_pb.ExtendedHeader, error) {
+	if err != nil {
+		return nil, err
+	}
+	return header.ExtendedHeaderToProto(h)
+}
+
+func sendHeader(send func(*header_pb.ExtendedHeader) error, h *header.ExtendedHeader) error {
+	msg, err := header.ExtendedHeaderToProto(h)
+	if err != nil {
+		return err
+	}
+	return send(msg)
+}
+
+// unixNano returns the Unix time of t in nanoseconds, or zero for the zero time.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
@@ -0,0 +1,1504 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/grpcapi/pb/das.proto
+
+package api_grpcapi_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SamplingStatsRequest struct {
+}
+
+func (m *SamplingStatsRequest) Reset()         { *m = SamplingStatsRequest{} }
+func (m *SamplingStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*SamplingStatsRequest) ProtoMessage()    {}
+func (*SamplingStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_effc519ddab7f02c, []int{0}
+}
+func (m *SamplingStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SamplingStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SamplingStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SamplingStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SamplingStatsRequest.Merge(m, src)
+}
+func (m *SamplingStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SamplingStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SamplingStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SamplingStatsRequest proto.InternalMessageInfo
+
+type SamplingStatsResponse struct {
+	SampledChainHead uint64 `protobuf:"varint,1,opt,name=sampled_chain_head,json=sampledChainHead,proto3" json:"sampled_chain_head,omitempty"`
+	CatchupHead      uint64 `protobuf:"varint,2,opt,name=catchup_head,json=catchupHead,proto3" json:"catchup_head,omitempty"`
+	NetworkHead      uint64 `protobuf:"varint,3,opt,name=network_head,json=networkHead,proto3" json:"network_head,omitempty"`
+	// failed maps the heights of the failed headers to the number of tries.
+	Failed      map[uint64]int64 `protobuf:"bytes,4,rep,name=failed,proto3" json:"failed,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Workers     []*WorkerStats   `protobuf:"bytes,5,rep,name=workers,proto3" json:"workers,omitempty"`
+	Concurrency int64            `protobuf:"varint,6,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	CatchUpDone bool             `protobuf:"varint,7,opt,name=catch_up_done,json=catchUpDone,proto3" json:"catch_up_done,omitempty"`
+	IsRunning   bool             `protobuf:"varint,8,opt,name=is_running,json=isRunning,proto3" json:"is_running,omitempty"`
+}
+
+func (m *SamplingStatsResponse) Reset()         { *m = SamplingStatsResponse{} }
+func (m *SamplingStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*SamplingStatsResponse) ProtoMessage()    {}
+func (*SamplingStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_effc519ddab7f02c, []int{1}
+}
+func (m *SamplingStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SamplingStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SamplingStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SamplingStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SamplingStatsResponse.Merge(m, src)
+}
+func (m *SamplingStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SamplingStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SamplingStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SamplingStatsResponse proto.InternalMessageInfo
+
+func (m *SamplingStatsResponse) GetSampledChainHead() uint64 {
+	if m != nil {
+		return m.SampledChainHead
+	}
+	return 0
+}
+
+func (m *SamplingStatsResponse) GetCatchupHead() uint64 {
+	if m != nil {
+		return m.CatchupHead
+	}
+	return 0
+}
+
+func (m *SamplingStatsResponse) GetNetworkHead() uint64 {
+	if m != nil {
+		return m.NetworkHead
+	}
+	return 0
+}
+
+func (m *SamplingStatsResponse) GetFailed() map[uint64]int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+func (m *SamplingStatsResponse) GetWorkers() []*WorkerStats {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+func (m *SamplingStatsResponse) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *SamplingStatsResponse) GetCatchUpDone() bool {
+	if m != nil {
+		return m.CatchUpDone
+	}
+	return false
+}
+
+func (m *SamplingStatsResponse) GetIsRunning() bool {
+	if m != nil {
+		return m.IsRunning
+	}
+	return false
+}
+
+type WorkerStats struct {
+	JobType string `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Current uint64 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	From    uint64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To      uint64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	Error   string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *WorkerStats) Reset()         { *m = WorkerStats{} }
+func (m *WorkerStats) String() string { return proto.CompactTextString(m) }
+func (*WorkerStats) ProtoMessage()    {}
+func (*WorkerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_effc519ddab7f02c, []int{2}
+}
+func (m *WorkerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerStats.Merge(m, src)
+}
+func (m *WorkerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerStats proto.InternalMessageInfo
+
+func (m *WorkerStats) GetJobType() string {
+	if m != nil {
+		return m.JobType
+	}
+	return ""
+}
+
+func (m *WorkerStats) GetCurrent() uint64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *WorkerStats) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *WorkerStats) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *WorkerStats) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type WaitCatchUpRequest struct {
+}
+
+func (m *WaitCatchUpRequest) Reset()         { *m = WaitCatchUpRequest{} }
+func (m *WaitCatchUpRequest) String() string { return proto.CompactTextString(m) }
+func (*WaitCatchUpRequest) ProtoMessage()    {}
+func (*WaitCatchUpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_effc519ddab7f02c, []int{3}
+}
+func (m *WaitCatchUpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitCatchUpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WaitCatchUpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WaitCatchUpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitCatchUpRequest.Merge(m, src)
+}
+func (m *WaitCatchUpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitCatchUpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitCatchUpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitCatchUpRequest proto.InternalMessageInfo
+
+type WaitCatchUpResponse struct {
+}
+
+func (m *WaitCatchUpResponse) Reset()         { *m = WaitCatchUpResponse{} }
+func (m *WaitCatchUpResponse) String() string { return proto.CompactTextString(m) }
+func (*WaitCatchUpResponse) ProtoMessage()    {}
+func (*WaitCatchUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_effc519ddab7f02c, []int{4}
+}
+func (m *WaitCatchUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitCatchUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WaitCatchUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WaitCatchUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitCatchUpResponse.Merge(m, src)
+}
+func (m *WaitCatchUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitCatchUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitCatchUpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitCatchUpResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SamplingStatsRequest)(nil), "api.grpcapi.pb.SamplingStatsRequest")
+	proto.RegisterType((*SamplingStatsResponse)(nil), "api.grpcapi.pb.SamplingStatsResponse")
+	proto.RegisterMapType((map[uint64]int64)(nil), "api.grpcapi.pb.SamplingStatsResponse.FailedEntry")
+	proto.RegisterType((*WorkerStats)(nil), "api.grpcapi.pb.WorkerStats")
+	proto.RegisterType((*WaitCatchUpRequest)(nil), "api.grpcapi.pb.WaitCatchUpRequest")
+	proto.RegisterType((*WaitCatchUpResponse)(nil), "api.grpcapi.pb.WaitCatchUpResponse")
+}
+
+func init() { proto.RegisterFile("api/grpcapi/pb/das.proto", fileDescriptor_effc519ddab7f02c) }
+
+var fileDescriptor_effc519ddab7f02c = []byte{
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
+	0x18, 0x6d, 0x9a, 0xfe, 0xed, 0x0b, 0x9b, 0xa6, 0x8f, 0x0e, 0x99, 0x22, 0xa2, 0x12, 0x40, 0xea,
+	0x05, 0xca, 0xc4, 0x10, 0x12, 0x70, 0x07, 0x1b, 0x08, 0x6e, 0x53, 0x60, 0x37, 0x48, 0x91, 0x9b,
+	0x78, 0x6d, 0xb6, 0xce, 0x36, 0x8e, 0x03, 0x8a, 0xc4, 0x43, 0xf0, 0x22, 0x3c, 0x01, 0x2f, 0xc0,
+	0xe5, 0x2e, 0xb9, 0x44, 0xed, 0x8b, 0xa0, 0xd8, 0xa9, 0xd4, 0x6e, 0x08, 0xb8, 0x8a, 0x7d, 0xce,
+	0xf1, 0xc9, 0xf9, 0x7c, 0x12, 0x20, 0x54, 0x66, 0xfb, 0x53, 0x25, 0x93, 0xea, 0x29, 0x27, 0xfb,
+	0x29, 0xcd, 0x43, 0xa9, 0x84, 0x16, 0xb8, 0x43, 0x65, 0x16, 0xd6, 0x4c, 0x28, 0x27, 0xc1, 0x0d,
+	0xe8, 0x8f, 0xe9, 0xb9, 0x9c, 0x67, 0x7c, 0x3a, 0xd6, 0x54, 0xe7, 0x11, 0xfb, 0x58, 0xb0, 0x5c,
+	0x07, 0xdf, 0x5c, 0xd8, 0xbb, 0x44, 0xe4, 0x52, 0xf0, 0x9c, 0xe1, 0x03, 0xc0, 0xbc, 0x22, 0x58,
+	0x1a, 0x27, 0x33, 0x9a, 0xf1, 0x78, 0xc6, 0x68, 0x4a, 0x9c, 0xa1, 0x33, 0x6a, 0x45, 0xbb, 0x35,
+	0x73, 0x58, 0x11, 0xaf, 0x19, 0x4d, 0xf1, 0x0e, 0x5c, 0x4b, 0xa8, 0x4e, 0x66, 0x85, 0xb4, 0xba,
+	0xa6, 0xd1, 0x79, 0x35, 0xb6, 0x92, 0x70, 0xa6, 0x3f, 0x0b, 0x75, 0x66, 0x25, 0xae, 0x95, 0xd4,
+	0x98, 0x91, 0xbc, 0x81, 0xce, 0x09, 0xcd, 0xe6, 0x2c, 0x25, 0xad, 0xa1, 0x3b, 0xf2, 0x0e, 0x1e,
+	0x86, 0x9b, 0x63, 0x84, 0x7f, 0x8c, 0x1a, 0xbe, 0x32, 0x67, 0x5e, 0x72, 0xad, 0xca, 0xa8, 0x36,
+	0xc0, 0xc7, 0xd0, 0xad, 0x6c, 0x99, 0xca, 0x49, 0xdb, 0x78, 0xdd, 0xba, 0xec, 0x75, 0x6c, 0x68,
+	0xeb, 0xb4, 0xd2, 0xe2, 0x10, 0xbc, 0x44, 0xf0, 0xa4, 0x50, 0x8a, 0xf1, 0xa4, 0x24, 0x9d, 0xa1,
+	0x33, 0x72, 0xa3, 0x75, 0x08, 0x03, 0xd8, 0x36, 0x53, 0xc5, 0x85, 0x8c, 0x53, 0xc1, 0x19, 0xe9,
+	0x0e, 0x9d, 0x51, 0xaf, 0x1e, 0xf5, 0x9d, 0x3c, 0x12, 0x9c, 0xe1, 0x6d, 0x80, 0x2c, 0x8f, 0x55,
+	0xc1, 0x79, 0xc6, 0xa7, 0xa4, 0x67, 0x04, 0x5b, 0x59, 0x1e, 0x59, 0x60, 0xf0, 0x14, 0xbc, 0xb5,
+	0xc8, 0xb8, 0x0b, 0xee, 0x19, 0x2b, 0xeb, 0xab, 0xad, 0x96, 0xd8, 0x87, 0xf6, 0x27, 0x3a, 0x2f,
+	0x98, 0xb9, 0x46, 0x37, 0xb2, 0x9b, 0x67, 0xcd, 0x27, 0x4e, 0xf0, 0x05, 0xbc, 0xb5, 0xdc, 0x78,
+	0x13, 0x7a, 0xa7, 0x62, 0x12, 0xeb, 0x52, 0x32, 0x73, 0x7e, 0x2b, 0xea, 0x9e, 0x8a, 0xc9, 0xdb,
+	0x52, 0x32, 0x24, 0xd0, 0xb5, 0x99, 0x75, 0x5d, 0xc6, 0x6a, 0x8b, 0x08, 0xad, 0x13, 0x25, 0xce,
+	0xeb, 0x02, 0xcc, 0x1a, 0x77, 0xa0, 0xa9, 0x05, 0x69, 0x19, 0xa4, 0xa9, 0x45, 0x95, 0x80, 0x29,
+	0x25, 0x14, 0x69, 0x1b, 0x57, 0xbb, 0x09, 0xfa, 0x80, 0xc7, 0x34, 0xd3, 0x87, 0x76, 0xd4, 0xd5,
+	0x37, 0xb4, 0x07, 0xd7, 0x37, 0x50, 0xdb, 0xca, 0xc1, 0x77, 0x07, 0xdc, 0xa3, 0xe7, 0x63, 0xfc,
+	0x00, 0xdb, 0x1b, 0xb5, 0xe1, 0xbd, 0x7f, 0xb4, 0x6a, 0x5c, 0x07, 0xf7, 0xff, 0xab, 0x7b, 0x7c,
+	0x0f, 0xde, 0xda, 0xcb, 0x31, 0xb8, 0xd2, 0xf2, 0x95, 0xbc, 0x83, 0xbb, 0x7f, 0xd5, 0x58, 0xdf,
+	0x17, 0xe4, 0xc7, 0xc2, 0x77, 0x2e, 0x16, 0xbe, 0xf3, 0x6b, 0xe1, 0x3b, 0x5f, 0x97, 0x7e, 0xe3,
+	0x62, 0xe9, 0x37, 0x7e, 0x2e, 0xfd, 0xc6, 0xa4, 0x63, 0xfe, 0xb0, 0x47, 0xbf, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0x85, 0x2e, 0x35, 0xc0, 0x7d, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DASClient is the client API for DAS service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DASClient interface {
+	SamplingStats(ctx context.Context, in *SamplingStatsRequest, opts ...grpc.CallOption) (*SamplingStatsResponse, error)
+	WaitCatchUp(ctx context.Context, in *WaitCatchUpRequest, opts ...grpc.CallOption) (*WaitCatchUpResponse, error)
+}
+
+type dASClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewDASClient(cc grpc1.ClientConn) DASClient {
+	return &dASClient{cc}
+}
+
+func (c *dASClient) SamplingStats(ctx context.Context, in *SamplingStatsRequest, opts ...grpc.CallOption) (*SamplingStatsResponse, error) {
+	out := new(SamplingStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.DAS/SamplingStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dASClient) WaitCatchUp(ctx context.Context, in *WaitCatchUpRequest, opts ...grpc.CallOption) (*WaitCatchUpResponse, error) {
+	out := new(WaitCatchUpResponse)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.DAS/WaitCatchUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DASServer is the server API for DAS service.
+type DASServer interface {
+	SamplingStats(context.Context, *SamplingStatsRequest) (*SamplingStatsResponse, error)
+	WaitCatchUp(context.Context, *WaitCatchUpRequest) (*WaitCatchUpResponse, error)
+}
+
+// UnimplementedDASServer can be embedded to have forward compatible implementations.
+type UnimplementedDASServer struct {
+}
+
+func (*UnimplementedDASServer) SamplingStats(ctx context.Context, req *SamplingStatsRequest) (*SamplingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SamplingStats not implemented")
+}
+func (*UnimplementedDASServer) WaitCatchUp(ctx context.Context, req *WaitCatchUpRequest) (*WaitCatchUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitCatchUp not implemented")
+}
+
+func RegisterDASServer(s grpc1.Server, srv DASServer) {
+	s.RegisterService(&_DAS_serviceDesc, srv)
+}
+
+func _DAS_SamplingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SamplingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).SamplingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.DAS/SamplingStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).SamplingStats(ctx, req.(*SamplingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DAS_WaitCatchUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitCatchUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DASServer).WaitCatchUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.DAS/WaitCatchUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DASServer).WaitCatchUp(ctx, req.(*WaitCatchUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DAS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.grpcapi.pb.DAS",
+	HandlerType: (*DASServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SamplingStats",
+			Handler:    _DAS_SamplingStats_Handler,
+		},
+		{
+			MethodName: "WaitCatchUp",
+			Handler:    _DAS_WaitCatchUp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/grpcapi/pb/das.proto",
+}
+
+func (m *SamplingStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SamplingStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SamplingStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SamplingStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SamplingStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SamplingStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsRunning {
+		i--
+		if m.IsRunning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CatchUpDone {
+		i--
+		if m.CatchUpDone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Concurrency != 0 {
+		i = encodeVarintDas(dAtA, i, uint64(m.Concurrency))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDas(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Failed) > 0 {
+		for k := range m.Failed {
+			v := m.Failed[k]
+			baseI := i
+			i = encodeVarintDas(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintDas(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDas(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NetworkHead != 0 {
+		i = encodeVarintDas(dAtA, i, uint64(m.NetworkHead))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CatchupHead != 0 {
+		i = encodeVarintDas(dAtA, i, uint64(m.CatchupHead))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SampledChainHead != 0 {
+		i = encodeVarintDas(dAtA, i, uint64(m.SampledChainHead))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDas(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.To != 0 {
+		i = encodeVarintDas(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.From != 0 {
+		i = encodeVarintDas(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Current != 0 {
+		i = encodeVarintDas(dAtA, i, uint64(m.Current))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.JobType) > 0 {
+		i -= len(m.JobType)
+		copy(dAtA[i:], m.JobType)
+		i = encodeVarintDas(dAtA, i, uint64(len(m.JobType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitCatchUpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitCatchUpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitCatchUpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitCatchUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitCatchUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitCatchUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDas(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDas(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SamplingStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SamplingStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SampledChainHead != 0 {
+		n += 1 + sovDas(uint64(m.SampledChainHead))
+	}
+	if m.CatchupHead != 0 {
+		n += 1 + sovDas(uint64(m.CatchupHead))
+	}
+	if m.NetworkHead != 0 {
+		n += 1 + sovDas(uint64(m.NetworkHead))
+	}
+	if len(m.Failed) > 0 {
+		for k, v := range m.Failed {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovDas(uint64(k)) + 1 + sovDas(uint64(v))
+			n += mapEntrySize + 1 + sovDas(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovDas(uint64(l))
+		}
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovDas(uint64(m.Concurrency))
+	}
+	if m.CatchUpDone {
+		n += 2
+	}
+	if m.IsRunning {
+		n += 2
+	}
+	return n
+}
+
+func (m *WorkerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobType)
+	if l > 0 {
+		n += 1 + l + sovDas(uint64(l))
+	}
+	if m.Current != 0 {
+		n += 1 + sovDas(uint64(m.Current))
+	}
+	if m.From != 0 {
+		n += 1 + sovDas(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovDas(uint64(m.To))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDas(uint64(l))
+	}
+	return n
+}
+
+func (m *WaitCatchUpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WaitCatchUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovDas(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDas(x uint64) (n int) {
+	return sovDas(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SamplingStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SamplingStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SamplingStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SamplingStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SamplingStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SamplingStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampledChainHead", wireType)
+			}
+			m.SampledChainHead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampledChainHead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchupHead", wireType)
+			}
+			m.CatchupHead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchupHead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkHead", wireType)
+			}
+			m.NetworkHead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkHead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failed == nil {
+				m.Failed = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDas
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDas
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDas
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDas(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDas
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Failed[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &WorkerStats{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpDone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CatchUpDone = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRunning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRunning = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitCatchUpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitCatchUpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitCatchUpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitCatchUpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitCatchUpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitCatchUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDas(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDas
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDas
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDas
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDas
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDas        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDas          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDas = fmt.Errorf("proto: unexpected end of group")
+)
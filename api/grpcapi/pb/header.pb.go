@@ -0,0 +1,2343 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/grpcapi/pb/header.proto
+
+package api_grpcapi_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	pb "github.com/celestiaorg/celestia-node/header/pb"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LocalHeadRequest struct {
+}
+
+func (m *LocalHeadRequest) Reset()         { *m = LocalHeadRequest{} }
+func (m *LocalHeadRequest) String() string { return proto.CompactTextString(m) }
+func (*LocalHeadRequest) ProtoMessage()    {}
+func (*LocalHeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{0}
+}
+func (m *LocalHeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalHeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalHeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalHeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalHeadRequest.Merge(m, src)
+}
+func (m *LocalHeadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalHeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalHeadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalHeadRequest proto.InternalMessageInfo
+
+type GetByHashRequest struct {
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetByHashRequest) Reset()         { *m = GetByHashRequest{} }
+func (m *GetByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetByHashRequest) ProtoMessage()    {}
+func (*GetByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{1}
+}
+func (m *GetByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetByHashRequest.Merge(m, src)
+}
+func (m *GetByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetByHashRequest proto.InternalMessageInfo
+
+func (m *GetByHashRequest) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type GetVerifiedRangeByHeightRequest struct {
+	From *pb.ExtendedHeader `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint64             `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *GetVerifiedRangeByHeightRequest) Reset()         { *m = GetVerifiedRangeByHeightRequest{} }
+func (m *GetVerifiedRangeByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVerifiedRangeByHeightRequest) ProtoMessage()    {}
+func (*GetVerifiedRangeByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{2}
+}
+func (m *GetVerifiedRangeByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetVerifiedRangeByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetVerifiedRangeByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetVerifiedRangeByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVerifiedRangeByHeightRequest.Merge(m, src)
+}
+func (m *GetVerifiedRangeByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetVerifiedRangeByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVerifiedRangeByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVerifiedRangeByHeightRequest proto.InternalMessageInfo
+
+func (m *GetVerifiedRangeByHeightRequest) GetFrom() *pb.ExtendedHeader {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *GetVerifiedRangeByHeightRequest) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type GetByHeightRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetByHeightRequest) Reset()         { *m = GetByHeightRequest{} }
+func (m *GetByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*GetByHeightRequest) ProtoMessage()    {}
+func (*GetByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{3}
+}
+func (m *GetByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetByHeightRequest.Merge(m, src)
+}
+func (m *GetByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetByHeightRequest proto.InternalMessageInfo
+
+func (m *GetByHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type SyncStateRequest struct {
+}
+
+func (m *SyncStateRequest) Reset()         { *m = SyncStateRequest{} }
+func (m *SyncStateRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncStateRequest) ProtoMessage()    {}
+func (*SyncStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{4}
+}
+func (m *SyncStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStateRequest.Merge(m, src)
+}
+func (m *SyncStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStateRequest proto.InternalMessageInfo
+
+type SyncStateResponse struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height     uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	FromHash   []byte `protobuf:"bytes,5,opt,name=from_hash,json=fromHash,proto3" json:"from_hash,omitempty"`
+	ToHash     []byte `protobuf:"bytes,6,opt,name=to_hash,json=toHash,proto3" json:"to_hash,omitempty"`
+	// start and end are Unix times in nanoseconds.
+	Start int64  `protobuf:"varint,7,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64  `protobuf:"varint,8,opt,name=end,proto3" json:"end,omitempty"`
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SyncStateResponse) Reset()         { *m = SyncStateResponse{} }
+func (m *SyncStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncStateResponse) ProtoMessage()    {}
+func (*SyncStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{5}
+}
+func (m *SyncStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStateResponse.Merge(m, src)
+}
+func (m *SyncStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStateResponse proto.InternalMessageInfo
+
+func (m *SyncStateResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetFromHash() []byte {
+	if m != nil {
+		return m.FromHash
+	}
+	return nil
+}
+
+func (m *SyncStateResponse) GetToHash() []byte {
+	if m != nil {
+		return m.ToHash
+	}
+	return nil
+}
+
+func (m *SyncStateResponse) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *SyncStateResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SyncWaitRequest struct {
+}
+
+func (m *SyncWaitRequest) Reset()         { *m = SyncWaitRequest{} }
+func (m *SyncWaitRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncWaitRequest) ProtoMessage()    {}
+func (*SyncWaitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{6}
+}
+func (m *SyncWaitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWaitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWaitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWaitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWaitRequest.Merge(m, src)
+}
+func (m *SyncWaitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWaitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWaitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWaitRequest proto.InternalMessageInfo
+
+type SyncWaitResponse struct {
+}
+
+func (m *SyncWaitResponse) Reset()         { *m = SyncWaitResponse{} }
+func (m *SyncWaitResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncWaitResponse) ProtoMessage()    {}
+func (*SyncWaitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{7}
+}
+func (m *SyncWaitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWaitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWaitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWaitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWaitResponse.Merge(m, src)
+}
+func (m *SyncWaitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWaitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWaitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWaitResponse proto.InternalMessageInfo
+
+type NetworkHeadRequest struct {
+}
+
+func (m *NetworkHeadRequest) Reset()         { *m = NetworkHeadRequest{} }
+func (m *NetworkHeadRequest) String() string { return proto.CompactTextString(m) }
+func (*NetworkHeadRequest) ProtoMessage()    {}
+func (*NetworkHeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{8}
+}
+func (m *NetworkHeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkHeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkHeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkHeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkHeadRequest.Merge(m, src)
+}
+func (m *NetworkHeadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkHeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkHeadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkHeadRequest proto.InternalMessageInfo
+
+type SubscribeHeadersRequest struct {
+}
+
+func (m *SubscribeHeadersRequest) Reset()         { *m = SubscribeHeadersRequest{} }
+func (m *SubscribeHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeHeadersRequest) ProtoMessage()    {}
+func (*SubscribeHeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7156c900e791c01d, []int{9}
+}
+func (m *SubscribeHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeHeadersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeHeadersRequest.Merge(m, src)
+}
+func (m *SubscribeHeadersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeHeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeHeadersRequest proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*LocalHeadRequest)(nil), "api.grpcapi.pb.LocalHeadRequest")
+	proto.RegisterType((*GetByHashRequest)(nil), "api.grpcapi.pb.GetByHashRequest")
+	proto.RegisterType((*GetVerifiedRangeByHeightRequest)(nil), "api.grpcapi.pb.GetVerifiedRangeByHeightRequest")
+	proto.RegisterType((*GetByHeightRequest)(nil), "api.grpcapi.pb.GetByHeightRequest")
+	proto.RegisterType((*SyncStateRequest)(nil), "api.grpcapi.pb.SyncStateRequest")
+	proto.RegisterType((*SyncStateResponse)(nil), "api.grpcapi.pb.SyncStateResponse")
+	proto.RegisterType((*SyncWaitRequest)(nil), "api.grpcapi.pb.SyncWaitRequest")
+	proto.RegisterType((*SyncWaitResponse)(nil), "api.grpcapi.pb.SyncWaitResponse")
+	proto.RegisterType((*NetworkHeadRequest)(nil), "api.grpcapi.pb.NetworkHeadRequest")
+	proto.RegisterType((*SubscribeHeadersRequest)(nil), "api.grpcapi.pb.SubscribeHeadersRequest")
+}
+
+func init() { proto.RegisterFile("api/grpcapi/pb/header.proto", fileDescriptor_7156c900e791c01d) }
+
+var fileDescriptor_7156c900e791c01d = []byte{
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xf3, 0xd7, 0xf8, 0xe6, 0xfb, 0x4a, 0x3a, 0xaa, 0xa8, 0x9b, 0x4a, 0x8e, 0xf1, 0x02,
+	0xb2, 0x00, 0x07, 0x95, 0x37, 0x88, 0x04, 0xcd, 0x22, 0x54, 0xe0, 0x48, 0xb0, 0x2c, 0xe3, 0xf8,
+	0x36, 0xb6, 0x80, 0x8c, 0x19, 0x4f, 0x05, 0x79, 0x0b, 0x1e, 0x8b, 0x65, 0x97, 0x2c, 0x51, 0x22,
+	0xb1, 0xe3, 0x1d, 0xd0, 0xcc, 0xd8, 0xc1, 0xcd, 0x8f, 0xc5, 0x82, 0x55, 0x3c, 0xe7, 0xdc, 0x7b,
+	0xe6, 0xfe, 0x9c, 0x09, 0x9c, 0xd1, 0x24, 0x1e, 0xcc, 0x78, 0x32, 0x95, 0xbf, 0x49, 0x30, 0x88,
+	0x90, 0x86, 0xc8, 0xbd, 0x84, 0x33, 0xc1, 0xc8, 0x21, 0x4d, 0x62, 0x2f, 0x23, 0xbd, 0x24, 0xe8,
+	0xf6, 0x34, 0x2b, 0xe3, 0xf0, 0x8b, 0xc0, 0x79, 0x88, 0xe1, 0x55, 0x31, 0xc1, 0x25, 0xd0, 0x19,
+	0xb3, 0x29, 0xfd, 0x30, 0x42, 0x1a, 0xfa, 0xf8, 0xe9, 0x06, 0x53, 0xe1, 0x3e, 0x84, 0xce, 0x05,
+	0x8a, 0xe1, 0x62, 0x44, 0xd3, 0x28, 0xc3, 0x08, 0x81, 0x7a, 0x44, 0xd3, 0xc8, 0x32, 0x1c, 0xa3,
+	0xff, 0x9f, 0xaf, 0xbe, 0xdd, 0x77, 0xd0, 0xbb, 0x40, 0xf1, 0x06, 0x79, 0x7c, 0x1d, 0x63, 0xe8,
+	0xd3, 0xf9, 0x0c, 0x87, 0x8b, 0x11, 0xc6, 0xb3, 0x48, 0xe4, 0x69, 0x4f, 0xa0, 0x7e, 0xcd, 0xd9,
+	0x47, 0x95, 0xd6, 0x3e, 0x3f, 0xf5, 0xf2, 0xbb, 0x03, 0xef, 0x79, 0x56, 0xce, 0x48, 0x21, 0xbe,
+	0x0a, 0x23, 0x87, 0x50, 0x15, 0xcc, 0xaa, 0x3a, 0x46, 0xbf, 0xee, 0x57, 0x05, 0x73, 0x1f, 0x03,
+	0xd1, 0x95, 0xdc, 0x11, 0xbd, 0x0f, 0xcd, 0x48, 0x01, 0x4a, 0xb6, 0xee, 0x67, 0x27, 0xd9, 0xcb,
+	0x64, 0x31, 0x9f, 0x4e, 0x04, 0x15, 0x98, 0xf7, 0xf2, 0xcb, 0x80, 0xa3, 0x02, 0x98, 0x26, 0x6c,
+	0x9e, 0xa2, 0xbc, 0x27, 0x0e, 0xb3, 0xec, 0x6a, 0x1c, 0x16, 0x14, 0xab, 0x45, 0x45, 0xd2, 0x83,
+	0xb6, 0xac, 0xeb, 0x2a, 0x23, 0x6b, 0x8a, 0x04, 0x09, 0xe9, 0x8a, 0xc8, 0x19, 0x98, 0x82, 0xe5,
+	0x74, 0x5d, 0xd1, 0x2d, 0xc1, 0xfe, 0x90, 0x3a, 0x5b, 0x0e, 0xae, 0xa1, 0x06, 0xd7, 0x52, 0xb9,
+	0x34, 0x8d, 0xc8, 0x09, 0x1c, 0xc8, 0x4c, 0x49, 0x35, 0x15, 0xd5, 0x14, 0x4c, 0x11, 0xc7, 0xd0,
+	0x48, 0x05, 0xe5, 0xc2, 0x3a, 0x70, 0x8c, 0x7e, 0xcd, 0xd7, 0x07, 0xd2, 0x81, 0x1a, 0xce, 0x43,
+	0xab, 0xa5, 0x30, 0xf9, 0x29, 0xe3, 0x90, 0x73, 0xc6, 0x2d, 0xd3, 0x31, 0xfa, 0xa6, 0xaf, 0x0f,
+	0xee, 0x11, 0xdc, 0x93, 0xed, 0xbe, 0xa5, 0x71, 0x3e, 0xae, 0x7c, 0x2c, 0x1a, 0xd2, 0x03, 0x70,
+	0x8f, 0x81, 0x5c, 0xa2, 0xf8, 0xcc, 0xf8, 0xfb, 0xe2, 0xe2, 0x4f, 0xe1, 0x64, 0x72, 0x13, 0xa4,
+	0x53, 0x1e, 0x07, 0xa8, 0xf7, 0x92, 0x66, 0xd4, 0xf9, 0xcf, 0x06, 0x34, 0x35, 0x44, 0x46, 0x60,
+	0xae, 0x2d, 0x43, 0x1c, 0xef, 0xae, 0xe3, 0xbc, 0x4d, 0x37, 0x75, 0xf7, 0x2f, 0x5d, 0x2a, 0xad,
+	0x8d, 0xb6, 0xad, 0xb4, 0xe9, 0xc1, 0x32, 0xa5, 0x08, 0xac, 0x7d, 0x56, 0x24, 0x83, 0x1d, 0xc2,
+	0x65, 0xa6, 0x2d, 0xb9, 0xe7, 0xa9, 0x41, 0xc6, 0xd0, 0x2e, 0x58, 0x92, 0xb8, 0xbb, 0xab, 0xfe,
+	0x4b, 0x3d, 0x72, 0x09, 0xff, 0xcb, 0xbd, 0xbc, 0x60, 0xfc, 0xdf, 0xe8, 0xbd, 0x02, 0x73, 0xed,
+	0xf6, 0xed, 0x89, 0x6e, 0xbe, 0x8e, 0xee, 0x83, 0x92, 0x88, 0xec, 0xa9, 0xbc, 0x84, 0x56, 0xee,
+	0x1e, 0xd2, 0xdb, 0x15, 0x5e, 0xb0, 0x5a, 0xd7, 0xd9, 0x1f, 0x90, 0xc9, 0x8d, 0xa1, 0x5d, 0x30,
+	0xde, 0x76, 0xbb, 0xdb, 0xae, 0x2c, 0x6b, 0xf7, 0x35, 0x98, 0x6b, 0xc3, 0x92, 0x47, 0x5b, 0x97,
+	0xef, 0xf6, 0x72, 0xe9, 0x7e, 0x87, 0xd6, 0xb7, 0xa5, 0x6d, 0xdc, 0x2e, 0x6d, 0xe3, 0xc7, 0xd2,
+	0x36, 0xbe, 0xae, 0xec, 0xca, 0xed, 0xca, 0xae, 0x7c, 0x5f, 0xd9, 0x95, 0xa0, 0xa9, 0xfe, 0x31,
+	0x9f, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x1c, 0x71, 0x60, 0x81, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HeaderClient is the client API for Header service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HeaderClient interface {
+	LocalHead(ctx context.Context, in *LocalHeadRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error)
+	GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error)
+	// GetVerifiedRangeByHeight streams the headers in the range (from, to), verified against from.
+	GetVerifiedRangeByHeight(ctx context.Context, in *GetVerifiedRangeByHeightRequest, opts ...grpc.CallOption) (Header_GetVerifiedRangeByHeightClient, error)
+	GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error)
+	WaitForHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error)
+	SyncState(ctx context.Context, in *SyncStateRequest, opts ...grpc.CallOption) (*SyncStateResponse, error)
+	SyncWait(ctx context.Context, in *SyncWaitRequest, opts ...grpc.CallOption) (*SyncWaitResponse, error)
+	NetworkHead(ctx context.Context, in *NetworkHeadRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error)
+	// Subscribe streams the headers as they are synced, until the call is cancelled.
+	Subscribe(ctx context.Context, in *SubscribeHeadersRequest, opts ...grpc.CallOption) (Header_SubscribeClient, error)
+}
+
+type headerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewHeaderClient(cc grpc1.ClientConn) HeaderClient {
+	return &headerClient{cc}
+}
+
+func (c *headerClient) LocalHead(ctx context.Context, in *LocalHeadRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error) {
+	out := new(pb.ExtendedHeader)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Header/LocalHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) GetByHash(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error) {
+	out := new(pb.ExtendedHeader)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Header/GetByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) GetVerifiedRangeByHeight(ctx context.Context, in *GetVerifiedRangeByHeightRequest, opts ...grpc.CallOption) (Header_GetVerifiedRangeByHeightClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Header_serviceDesc.Streams[0], "/api.grpcapi.pb.Header/GetVerifiedRangeByHeight", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &headerGetVerifiedRangeByHeightClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Header_GetVerifiedRangeByHeightClient interface {
+	Recv() (*pb.ExtendedHeader, error)
+	grpc.ClientStream
+}
+
+type headerGetVerifiedRangeByHeightClient struct {
+	grpc.ClientStream
+}
+
+func (x *headerGetVerifiedRangeByHeightClient) Recv() (*pb.ExtendedHeader, error) {
+	m := new(pb.ExtendedHeader)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *headerClient) GetByHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error) {
+	out := new(pb.ExtendedHeader)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Header/GetByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) WaitForHeight(ctx context.Context, in *GetByHeightRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error) {
+	out := new(pb.ExtendedHeader)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Header/WaitForHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) SyncState(ctx context.Context, in *SyncStateRequest, opts ...grpc.CallOption) (*SyncStateResponse, error) {
+	out := new(SyncStateResponse)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Header/SyncState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) SyncWait(ctx context.Context, in *SyncWaitRequest, opts ...grpc.CallOption) (*SyncWaitResponse, error) {
+	out := new(SyncWaitResponse)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Header/SyncWait", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) NetworkHead(ctx context.Context, in *NetworkHeadRequest, opts ...grpc.CallOption) (*pb.ExtendedHeader, error) {
+	out := new(pb.ExtendedHeader)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Header/NetworkHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headerClient) Subscribe(ctx context.Context, in *SubscribeHeadersRequest, opts ...grpc.CallOption) (Header_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Header_serviceDesc.Streams[1], "/api.grpcapi.pb.Header/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &headerSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Header_SubscribeClient interface {
+	Recv() (*pb.ExtendedHeader, error)
+	grpc.ClientStream
+}
+
+type headerSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *headerSubscribeClient) Recv() (*pb.ExtendedHeader, error) {
+	m := new(pb.ExtendedHeader)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HeaderServer is the server API for Header service.
+type HeaderServer interface {
+	LocalHead(context.Context, *LocalHeadRequest) (*pb.ExtendedHeader, error)
+	GetByHash(context.Context, *GetByHashRequest) (*pb.ExtendedHeader, error)
+	// GetVerifiedRangeByHeight streams the headers in the range (from, to), verified against from.
+	GetVerifiedRangeByHeight(*GetVerifiedRangeByHeightRequest, Header_GetVerifiedRangeByHeightServer) error
+	GetByHeight(context.Context, *GetByHeightRequest) (*pb.ExtendedHeader, error)
+	WaitForHeight(context.Context, *GetByHeightRequest) (*pb.ExtendedHeader, error)
+	SyncState(context.Context, *SyncStateRequest) (*SyncStateResponse, error)
+	SyncWait(context.Context, *SyncWaitRequest) (*SyncWaitResponse, error)
+	NetworkHead(context.Context, *NetworkHeadRequest) (*pb.ExtendedHeader, error)
+	// Subscribe streams the headers as they are synced, until the call is cancelled.
+	Subscribe(*SubscribeHeadersRequest, Header_SubscribeServer) error
+}
+
+// UnimplementedHeaderServer can be embedded to have forward compatible implementations.
+type UnimplementedHeaderServer struct {
+}
+
+func (*UnimplementedHeaderServer) LocalHead(ctx context.Context, req *LocalHeadRequest) (*pb.ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalHead not implemented")
+}
+func (*UnimplementedHeaderServer) GetByHash(ctx context.Context, req *GetByHashRequest) (*pb.ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHash not implemented")
+}
+func (*UnimplementedHeaderServer) GetVerifiedRangeByHeight(req *GetVerifiedRangeByHeightRequest, srv Header_GetVerifiedRangeByHeightServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetVerifiedRangeByHeight not implemented")
+}
+func (*UnimplementedHeaderServer) GetByHeight(ctx context.Context, req *GetByHeightRequest) (*pb.ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByHeight not implemented")
+}
+func (*UnimplementedHeaderServer) WaitForHeight(ctx context.Context, req *GetByHeightRequest) (*pb.ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForHeight not implemented")
+}
+func (*UnimplementedHeaderServer) SyncState(ctx context.Context, req *SyncStateRequest) (*SyncStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncState not implemented")
+}
+func (*UnimplementedHeaderServer) SyncWait(ctx context.Context, req *SyncWaitRequest) (*SyncWaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncWait not implemented")
+}
+func (*UnimplementedHeaderServer) NetworkHead(ctx context.Context, req *NetworkHeadRequest) (*pb.ExtendedHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkHead not implemented")
+}
+func (*UnimplementedHeaderServer) Subscribe(req *SubscribeHeadersRequest, srv Header_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterHeaderServer(s grpc1.Server, srv HeaderServer) {
+	s.RegisterService(&_Header_serviceDesc, srv)
+}
+
+func _Header_LocalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocalHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).LocalHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Header/LocalHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).LocalHead(ctx, req.(*LocalHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_GetByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).GetByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Header/GetByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).GetByHash(ctx, req.(*GetByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_GetVerifiedRangeByHeight_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetVerifiedRangeByHeightRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeaderServer).GetVerifiedRangeByHeight(m, &headerGetVerifiedRangeByHeightServer{stream})
+}
+
+type Header_GetVerifiedRangeByHeightServer interface {
+	Send(*pb.ExtendedHeader) error
+	grpc.ServerStream
+}
+
+type headerGetVerifiedRangeByHeightServer struct {
+	grpc.ServerStream
+}
+
+func (x *headerGetVerifiedRangeByHeightServer) Send(m *pb.ExtendedHeader) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Header_GetByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).GetByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Header/GetByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).GetByHeight(ctx, req.(*GetByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_WaitForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).WaitForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Header/WaitForHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).WaitForHeight(ctx, req.(*GetByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_SyncState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).SyncState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Header/SyncState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).SyncState(ctx, req.(*SyncStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_SyncWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncWaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).SyncWait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Header/SyncWait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).SyncWait(ctx, req.(*SyncWaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_NetworkHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaderServer).NetworkHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Header/NetworkHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaderServer).NetworkHead(ctx, req.(*NetworkHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Header_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHeadersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeaderServer).Subscribe(m, &headerSubscribeServer{stream})
+}
+
+type Header_SubscribeServer interface {
+	Send(*pb.ExtendedHeader) error
+	grpc.ServerStream
+}
+
+type headerSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *headerSubscribeServer) Send(m *pb.ExtendedHeader) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Header_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.grpcapi.pb.Header",
+	HandlerType: (*HeaderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LocalHead",
+			Handler:    _Header_LocalHead_Handler,
+		},
+		{
+			MethodName: "GetByHash",
+			Handler:    _Header_GetByHash_Handler,
+		},
+		{
+			MethodName: "GetByHeight",
+			Handler:    _Header_GetByHeight_Handler,
+		},
+		{
+			MethodName: "WaitForHeight",
+			Handler:    _Header_WaitForHeight_Handler,
+		},
+		{
+			MethodName: "SyncState",
+			Handler:    _Header_SyncState_Handler,
+		},
+		{
+			MethodName: "SyncWait",
+			Handler:    _Header_SyncWait_Handler,
+		},
+		{
+			MethodName: "NetworkHead",
+			Handler:    _Header_NetworkHead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetVerifiedRangeByHeight",
+			Handler:       _Header_GetVerifiedRangeByHeight_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Header_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/grpcapi/pb/header.proto",
+}
+
+func (m *LocalHeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalHeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalHeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintHeader(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVerifiedRangeByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVerifiedRangeByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVerifiedRangeByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHeader(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintHeader(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.End != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Start != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ToHash) > 0 {
+		i -= len(m.ToHash)
+		copy(dAtA[i:], m.ToHash)
+		i = encodeVarintHeader(dAtA, i, uint64(len(m.ToHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FromHash) > 0 {
+		i -= len(m.FromHash)
+		copy(dAtA[i:], m.FromHash)
+		i = encodeVarintHeader(dAtA, i, uint64(len(m.FromHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintHeader(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWaitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWaitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWaitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWaitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWaitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWaitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkHeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkHeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkHeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeHeadersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeHeadersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeHeadersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHeader(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHeader(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LocalHeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovHeader(uint64(l))
+	}
+	return n
+}
+
+func (m *GetVerifiedRangeByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovHeader(uint64(l))
+	}
+	if m.To != 0 {
+		n += 1 + sovHeader(uint64(m.To))
+	}
+	return n
+}
+
+func (m *GetByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHeader(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SyncStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SyncStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHeader(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHeader(uint64(m.Height))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovHeader(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovHeader(uint64(m.ToHeight))
+	}
+	l = len(m.FromHash)
+	if l > 0 {
+		n += 1 + l + sovHeader(uint64(l))
+	}
+	l = len(m.ToHash)
+	if l > 0 {
+		n += 1 + l + sovHeader(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovHeader(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovHeader(uint64(m.End))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHeader(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncWaitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SyncWaitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NetworkHeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SubscribeHeadersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovHeader(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHeader(x uint64) (n int) {
+	return sovHeader(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LocalHeadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocalHeadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocalHeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVerifiedRangeByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVerifiedRangeByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVerifiedRangeByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHeader
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &pb.ExtendedHeader{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromHash = append(m.FromHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromHash == nil {
+				m.FromHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToHash = append(m.ToHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToHash == nil {
+				m.ToHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeader
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWaitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWaitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWaitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWaitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWaitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWaitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkHeadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkHeadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkHeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeHeadersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeHeadersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHeader(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHeader
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeader
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHeader
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHeader
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHeader
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHeader        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHeader          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHeader = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2026 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: api/grpcapi/pb/share.proto
+
+package api_grpcapi_pb
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	da "github.com/celestiaorg/celestia-app/proto/celestia/da"
+	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexnd/pb"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SharesAvailableRequest struct {
+	Root *da.DataAvailabilityHeader `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *SharesAvailableRequest) Reset()         { *m = SharesAvailableRequest{} }
+func (m *SharesAvailableRequest) String() string { return proto.CompactTextString(m) }
+func (*SharesAvailableRequest) ProtoMessage()    {}
+func (*SharesAvailableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{0}
+}
+func (m *SharesAvailableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharesAvailableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharesAvailableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharesAvailableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharesAvailableRequest.Merge(m, src)
+}
+func (m *SharesAvailableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharesAvailableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharesAvailableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharesAvailableRequest proto.InternalMessageInfo
+
+func (m *SharesAvailableRequest) GetRoot() *da.DataAvailabilityHeader {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type SharesAvailableResponse struct {
+}
+
+func (m *SharesAvailableResponse) Reset()         { *m = SharesAvailableResponse{} }
+func (m *SharesAvailableResponse) String() string { return proto.CompactTextString(m) }
+func (*SharesAvailableResponse) ProtoMessage()    {}
+func (*SharesAvailableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{1}
+}
+func (m *SharesAvailableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharesAvailableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharesAvailableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharesAvailableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharesAvailableResponse.Merge(m, src)
+}
+func (m *SharesAvailableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharesAvailableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharesAvailableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharesAvailableResponse proto.InternalMessageInfo
+
+type ProbabilityOfAvailabilityRequest struct {
+}
+
+func (m *ProbabilityOfAvailabilityRequest) Reset()         { *m = ProbabilityOfAvailabilityRequest{} }
+func (m *ProbabilityOfAvailabilityRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbabilityOfAvailabilityRequest) ProtoMessage()    {}
+func (*ProbabilityOfAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{2}
+}
+func (m *ProbabilityOfAvailabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbabilityOfAvailabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProbabilityOfAvailabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProbabilityOfAvailabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbabilityOfAvailabilityRequest.Merge(m, src)
+}
+func (m *ProbabilityOfAvailabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbabilityOfAvailabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbabilityOfAvailabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbabilityOfAvailabilityRequest proto.InternalMessageInfo
+
+type ProbabilityOfAvailabilityResponse struct {
+	Probability float64 `protobuf:"fixed64,1,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (m *ProbabilityOfAvailabilityResponse) Reset()         { *m = ProbabilityOfAvailabilityResponse{} }
+func (m *ProbabilityOfAvailabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*ProbabilityOfAvailabilityResponse) ProtoMessage()    {}
+func (*ProbabilityOfAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{3}
+}
+func (m *ProbabilityOfAvailabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbabilityOfAvailabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProbabilityOfAvailabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProbabilityOfAvailabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbabilityOfAvailabilityResponse.Merge(m, src)
+}
+func (m *ProbabilityOfAvailabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbabilityOfAvailabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbabilityOfAvailabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbabilityOfAvailabilityResponse proto.InternalMessageInfo
+
+func (m *ProbabilityOfAvailabilityResponse) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+type GetShareRequest struct {
+	Root *da.DataAvailabilityHeader `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Row  uint32                     `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Col  uint32                     `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (m *GetShareRequest) Reset()         { *m = GetShareRequest{} }
+func (m *GetShareRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShareRequest) ProtoMessage()    {}
+func (*GetShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{4}
+}
+func (m *GetShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShareRequest.Merge(m, src)
+}
+func (m *GetShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShareRequest proto.InternalMessageInfo
+
+func (m *GetShareRequest) GetRoot() *da.DataAvailabilityHeader {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *GetShareRequest) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *GetShareRequest) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+type GetShareResponse struct {
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (m *GetShareResponse) Reset()         { *m = GetShareResponse{} }
+func (m *GetShareResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShareResponse) ProtoMessage()    {}
+func (*GetShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{5}
+}
+func (m *GetShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShareResponse.Merge(m, src)
+}
+func (m *GetShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShareResponse proto.InternalMessageInfo
+
+func (m *GetShareResponse) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+type GetEDSRequest struct {
+	Root *da.DataAvailabilityHeader `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *GetEDSRequest) Reset()         { *m = GetEDSRequest{} }
+func (m *GetEDSRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEDSRequest) ProtoMessage()    {}
+func (*GetEDSRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{6}
+}
+func (m *GetEDSRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEDSRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEDSRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEDSRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEDSRequest.Merge(m, src)
+}
+func (m *GetEDSRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEDSRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEDSRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEDSRequest proto.InternalMessageInfo
+
+func (m *GetEDSRequest) GetRoot() *da.DataAvailabilityHeader {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type Row struct {
+	Shares [][]byte `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *Row) Reset()         { *m = Row{} }
+func (m *Row) String() string { return proto.CompactTextString(m) }
+func (*Row) ProtoMessage()    {}
+func (*Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{7}
+}
+func (m *Row) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Row.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Row.Merge(m, src)
+}
+func (m *Row) XXX_Size() int {
+	return m.Size()
+}
+func (m *Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_Row.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Row proto.InternalMessageInfo
+
+func (m *Row) GetShares() [][]byte {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+type GetSharesByNamespaceRequest struct {
+	Root      *da.DataAvailabilityHeader `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Namespace []byte                     `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *GetSharesByNamespaceRequest) Reset()         { *m = GetSharesByNamespaceRequest{} }
+func (m *GetSharesByNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSharesByNamespaceRequest) ProtoMessage()    {}
+func (*GetSharesByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d753ecd1c255506, []int{8}
+}
+func (m *GetSharesByNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSharesByNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSharesByNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSharesByNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSharesByNamespaceRequest.Merge(m, src)
+}
+func (m *GetSharesByNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSharesByNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSharesByNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSharesByNamespaceRequest proto.InternalMessageInfo
+
+func (m *GetSharesByNamespaceRequest) GetRoot() *da.DataAvailabilityHeader {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *GetSharesByNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SharesAvailableRequest)(nil), "api.grpcapi.pb.SharesAvailableRequest")
+	proto.RegisterType((*SharesAvailableResponse)(nil), "api.grpcapi.pb.SharesAvailableResponse")
+	proto.RegisterType((*ProbabilityOfAvailabilityRequest)(nil), "api.grpcapi.pb.ProbabilityOfAvailabilityRequest")
+	proto.RegisterType((*ProbabilityOfAvailabilityResponse)(nil), "api.grpcapi.pb.ProbabilityOfAvailabilityResponse")
+	proto.RegisterType((*GetShareRequest)(nil), "api.grpcapi.pb.GetShareRequest")
+	proto.RegisterType((*GetShareResponse)(nil), "api.grpcapi.pb.GetShareResponse")
+	proto.RegisterType((*GetEDSRequest)(nil), "api.grpcapi.pb.GetEDSRequest")
+	proto.RegisterType((*Row)(nil), "api.grpcapi.pb.Row")
+	proto.RegisterType((*GetSharesByNamespaceRequest)(nil), "api.grpcapi.pb.GetSharesByNamespaceRequest")
+}
+
+func init() { proto.RegisterFile("api/grpcapi/pb/share.proto", fileDescriptor_7d753ecd1c255506) }
+
+var fileDescriptor_7d753ecd1c255506 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0xad, 0x09, 0xab, 0xe0, 0xae, 0x63, 0x93, 0x99, 0x46, 0x16, 0x58, 0x08, 0x41, 0x82, 0x08,
+	0x24, 0xa7, 0x94, 0x07, 0xde, 0x90, 0x36, 0x6d, 0xda, 0x5e, 0xf8, 0xca, 0x7e, 0xc0, 0xe4, 0x24,
+	0x86, 0x46, 0x0a, 0xb1, 0x89, 0x0d, 0x65, 0x6f, 0xfc, 0x04, 0x7e, 0x16, 0x8f, 0x7b, 0xe4, 0x11,
+	0xb5, 0xef, 0xfc, 0x06, 0x14, 0xc7, 0x51, 0x4a, 0x68, 0x99, 0xa6, 0x4a, 0x95, 0x1a, 0x5f, 0xdf,
+	0x7b, 0xce, 0xc9, 0xc9, 0xd1, 0x05, 0x87, 0x8a, 0x2c, 0xfc, 0x50, 0x8a, 0xa4, 0xfa, 0x17, 0x71,
+	0x28, 0xc7, 0xb4, 0x64, 0x44, 0x94, 0x5c, 0x71, 0x7c, 0x8b, 0x8a, 0x8c, 0x98, 0x3b, 0x22, 0x62,
+	0xe7, 0x49, 0xc2, 0x72, 0x26, 0x55, 0x46, 0xc3, 0xb4, 0xfa, 0x29, 0x7a, 0x46, 0xbf, 0xd0, 0x2c,
+	0xa7, 0x71, 0x96, 0x67, 0xea, 0xfc, 0x6c, 0xcc, 0x68, 0xca, 0xca, 0x7a, 0xd6, 0xf1, 0x34, 0x50,
+	0x28, 0x46, 0x22, 0x94, 0xe3, 0x92, 0x7d, 0x2d, 0xd2, 0x0e, 0xba, 0xff, 0x0e, 0x76, 0x4e, 0xab,
+	0xa3, 0xdc, 0xaf, 0x41, 0x72, 0x16, 0xb1, 0x4f, 0x9f, 0x99, 0x54, 0xf8, 0x05, 0x5c, 0x2f, 0x39,
+	0x57, 0x36, 0xf2, 0x50, 0xb0, 0x3e, 0x7a, 0x48, 0x1a, 0x5a, 0x92, 0x52, 0x72, 0x48, 0x15, 0xdd,
+	0x9f, 0x63, 0x3d, 0xd1, 0xa4, 0x91, 0x1e, 0xf0, 0x77, 0xe1, 0xce, 0x3f, 0x90, 0x52, 0xf0, 0x42,
+	0x32, 0xdf, 0x07, 0xef, 0x6d, 0xc9, 0x63, 0x33, 0xf5, 0xe6, 0xfd, 0x3c, 0x86, 0xe1, 0xf5, 0x8f,
+	0xe0, 0xc1, 0x7f, 0x7a, 0x6a, 0x20, 0xec, 0xc1, 0xba, 0x68, 0x9b, 0xb4, 0x46, 0x14, 0xcd, 0x97,
+	0xfc, 0x02, 0x36, 0x8f, 0x99, 0xd2, 0x42, 0x56, 0x7d, 0x23, 0xbc, 0x05, 0x56, 0xc9, 0x27, 0xf6,
+	0x35, 0x0f, 0x05, 0x1b, 0x51, 0xf5, 0x58, 0x55, 0x12, 0x9e, 0xdb, 0x56, 0x5d, 0x49, 0x78, 0xee,
+	0x07, 0xb0, 0xd5, 0xf2, 0x19, 0x95, 0xdb, 0xb0, 0xa6, 0xbd, 0xd6, 0x8c, 0x83, 0xa8, 0x3e, 0xf8,
+	0x27, 0xb0, 0x71, 0xcc, 0xd4, 0xd1, 0xe1, 0xe9, 0xca, 0x4e, 0xef, 0x81, 0x15, 0xf1, 0x09, 0xde,
+	0x81, 0xbe, 0x46, 0x96, 0x36, 0xf2, 0xac, 0x60, 0x10, 0x99, 0x93, 0xaf, 0xe0, 0x6e, 0x23, 0x49,
+	0x1e, 0x9c, 0xbf, 0xa6, 0x1f, 0x99, 0x14, 0x34, 0x59, 0xdd, 0x8e, 0x7b, 0x70, 0xb3, 0x68, 0xc0,
+	0xb4, 0x29, 0x83, 0xa8, 0x2d, 0x8c, 0x7e, 0x5b, 0xb0, 0xa6, 0x39, 0x71, 0x0c, 0x9b, 0x9d, 0x20,
+	0xe0, 0x47, 0xe4, 0xef, 0x34, 0x93, 0xc5, 0xe1, 0x73, 0x1e, 0x5f, 0xda, 0x67, 0x2c, 0xfe, 0x86,
+	0x60, 0x77, 0x69, 0x5c, 0xf0, 0xb0, 0x0b, 0x73, 0x59, 0xfa, 0x9c, 0x67, 0x57, 0x98, 0x30, 0x12,
+	0x5e, 0xc1, 0x8d, 0xc6, 0x66, 0x7c, 0xbf, 0x3b, 0xde, 0xc9, 0xa0, 0xe3, 0x2d, 0x6f, 0x30, 0x70,
+	0x2f, 0xa1, 0x5f, 0xc7, 0x03, 0xef, 0x2d, 0xe8, 0x6d, 0x63, 0xe3, 0xdc, 0xee, 0x5e, 0x47, 0x7c,
+	0x32, 0x44, 0x98, 0xc3, 0xf6, 0xa2, 0xaf, 0x8e, 0x9f, 0x2e, 0x63, 0x5e, 0x90, 0x0d, 0x27, 0x20,
+	0x66, 0x49, 0x8c, 0x04, 0xd1, 0x9b, 0x83, 0x14, 0x29, 0x69, 0xbb, 0xf8, 0xa4, 0x91, 0x3b, 0x44,
+	0x07, 0xf6, 0x8f, 0xa9, 0x8b, 0x2e, 0xa6, 0x2e, 0xfa, 0x35, 0x75, 0xd1, 0xf7, 0x99, 0xdb, 0xbb,
+	0x98, 0xb9, 0xbd, 0x9f, 0x33, 0xb7, 0x17, 0xf7, 0xf5, 0x8e, 0x79, 0xfe, 0x27, 0x00, 0x00, 0xff,
+	0xff, 0xc5, 0x84, 0x65, 0x27, 0xdf, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ShareClient is the client API for Share service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ShareClient interface {
+	SharesAvailable(ctx context.Context, in *SharesAvailableRequest, opts ...grpc.CallOption) (*SharesAvailableResponse, error)
+	ProbabilityOfAvailability(ctx context.Context, in *ProbabilityOfAvailabilityRequest, opts ...grpc.CallOption) (*ProbabilityOfAvailabilityResponse, error)
+	GetShare(ctx context.Context, in *GetShareRequest, opts ...grpc.CallOption) (*GetShareResponse, error)
+	// GetEDS streams the rows of the original data square, which the clients extend into the EDS.
+	GetEDS(ctx context.Context, in *GetEDSRequest, opts ...grpc.CallOption) (Share_GetEDSClient, error)
+	// GetSharesByNamespace streams the rows holding the shares of the namespace with their proofs.
+	GetSharesByNamespace(ctx context.Context, in *GetSharesByNamespaceRequest, opts ...grpc.CallOption) (Share_GetSharesByNamespaceClient, error)
+}
+
+type shareClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewShareClient(cc grpc1.ClientConn) ShareClient {
+	return &shareClient{cc}
+}
+
+func (c *shareClient) SharesAvailable(ctx context.Context, in *SharesAvailableRequest, opts ...grpc.CallOption) (*SharesAvailableResponse, error) {
+	out := new(SharesAvailableResponse)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Share/SharesAvailable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) ProbabilityOfAvailability(ctx context.Context, in *ProbabilityOfAvailabilityRequest, opts ...grpc.CallOption) (*ProbabilityOfAvailabilityResponse, error) {
+	out := new(ProbabilityOfAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Share/ProbabilityOfAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) GetShare(ctx context.Context, in *GetShareRequest, opts ...grpc.CallOption) (*GetShareResponse, error) {
+	out := new(GetShareResponse)
+	err := c.cc.Invoke(ctx, "/api.grpcapi.pb.Share/GetShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) GetEDS(ctx context.Context, in *GetEDSRequest, opts ...grpc.CallOption) (Share_GetEDSClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Share_serviceDesc.Streams[0], "/api.grpcapi.pb.Share/GetEDS", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shareGetEDSClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Share_GetEDSClient interface {
+	Recv() (*Row, error)
+	grpc.ClientStream
+}
+
+type shareGetEDSClient struct {
+	grpc.ClientStream
+}
+
+func (x *shareGetEDSClient) Recv() (*Row, error) {
+	m := new(Row)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *shareClient) GetSharesByNamespace(ctx context.Context, in *GetSharesByNamespaceRequest, opts ...grpc.CallOption) (Share_GetSharesByNamespaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Share_serviceDesc.Streams[1], "/api.grpcapi.pb.Share/GetSharesByNamespace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shareGetSharesByNamespaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Share_GetSharesByNamespaceClient interface {
+	Recv() (*pb.NamespaceRowResponse, error)
+	grpc.ClientStream
+}
+
+type shareGetSharesByNamespaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *shareGetSharesByNamespaceClient) Recv() (*pb.NamespaceRowResponse, error) {
+	m := new(pb.NamespaceRowResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ShareServer is the server API for Share service.
+type ShareServer interface {
+	SharesAvailable(context.Context, *SharesAvailableRequest) (*SharesAvailableResponse, error)
+	ProbabilityOfAvailability(context.Context, *ProbabilityOfAvailabilityRequest) (*ProbabilityOfAvailabilityResponse, error)
+	GetShare(context.Context, *GetShareRequest) (*GetShareResponse, error)
+	// GetEDS streams the rows of the original data square, which the clients extend into the EDS.
+	GetEDS(*GetEDSRequest, Share_GetEDSServer) error
+	// GetSharesByNamespace streams the rows holding the shares of the namespace with their proofs.
+	GetSharesByNamespace(*GetSharesByNamespaceRequest, Share_GetSharesByNamespaceServer) error
+}
+
+// UnimplementedShareServer can be embedded to have forward compatible implementations.
+type UnimplementedShareServer struct {
+}
+
+func (*UnimplementedShareServer) SharesAvailable(ctx context.Context, req *SharesAvailableRequest) (*SharesAvailableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharesAvailable not implemented")
+}
+func (*UnimplementedShareServer) ProbabilityOfAvailability(ctx context.Context, req *ProbabilityOfAvailabilityRequest) (*ProbabilityOfAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProbabilityOfAvailability not implemented")
+}
+func (*UnimplementedShareServer) GetShare(ctx context.Context, req *GetShareRequest) (*GetShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShare not implemented")
+}
+func (*UnimplementedShareServer) GetEDS(req *GetEDSRequest, srv Share_GetEDSServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetEDS not implemented")
+}
+func (*UnimplementedShareServer) GetSharesByNamespace(req *GetSharesByNamespaceRequest, srv Share_GetSharesByNamespaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetSharesByNamespace not implemented")
+}
+
+func RegisterShareServer(s grpc1.Server, srv ShareServer) {
+	s.RegisterService(&_Share_serviceDesc, srv)
+}
+
+func _Share_SharesAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharesAvailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).SharesAvailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Share/SharesAvailable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).SharesAvailable(ctx, req.(*SharesAvailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_ProbabilityOfAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbabilityOfAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).ProbabilityOfAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Share/ProbabilityOfAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).ProbabilityOfAvailability(ctx, req.(*ProbabilityOfAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_GetShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).GetShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.grpcapi.pb.Share/GetShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).GetShare(ctx, req.(*GetShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_GetEDS_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEDSRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShareServer).GetEDS(m, &shareGetEDSServer{stream})
+}
+
+type Share_GetEDSServer interface {
+	Send(*Row) error
+	grpc.ServerStream
+}
+
+type shareGetEDSServer struct {
+	grpc.ServerStream
+}
+
+func (x *shareGetEDSServer) Send(m *Row) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Share_GetSharesByNamespace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSharesByNamespaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShareServer).GetSharesByNamespace(m, &shareGetSharesByNamespaceServer{stream})
+}
+
+type Share_GetSharesByNamespaceServer interface {
+	Send(*pb.NamespaceRowResponse) error
+	grpc.ServerStream
+}
+
+type shareGetSharesByNamespaceServer struct {
+	grpc.ServerStream
+}
+
+func (x *shareGetSharesByNamespaceServer) Send(m *pb.NamespaceRowResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Share_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.grpcapi.pb.Share",
+	HandlerType: (*ShareServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SharesAvailable",
+			Handler:    _Share_SharesAvailable_Handler,
+		},
+		{
+			MethodName: "ProbabilityOfAvailability",
+			Handler:    _Share_ProbabilityOfAvailability_Handler,
+		},
+		{
+			MethodName: "GetShare",
+			Handler:    _Share_GetShare_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetEDS",
+			Handler:       _Share_GetEDS_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetSharesByNamespace",
+			Handler:       _Share_GetSharesByNamespace_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/grpcapi/pb/share.proto",
+}
+
+func (m *SharesAvailableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharesAvailableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharesAvailableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Root != nil {
+		{
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintShare(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SharesAvailableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharesAvailableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharesAvailableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ProbabilityOfAvailabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbabilityOfAvailabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbabilityOfAvailabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ProbabilityOfAvailabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbabilityOfAvailabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbabilityOfAvailabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Probability != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Probability))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Col != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Row != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Root != nil {
+		{
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintShare(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEDSRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEDSRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEDSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Root != nil {
+		{
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintShare(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Row) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Row) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Row) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintShare(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSharesByNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSharesByNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSharesByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintShare(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Root != nil {
+		{
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintShare(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintShare(dAtA []byte, offset int, v uint64) int {
+	offset -= sovShare(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SharesAvailableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func (m *SharesAvailableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ProbabilityOfAvailabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ProbabilityOfAvailabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Probability != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GetShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovShare(uint64(l))
+	}
+	if m.Row != 0 {
+		n += 1 + sovShare(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovShare(uint64(m.Col))
+	}
+	return n
+}
+
+func (m *GetShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func (m *GetEDSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func (m *Row) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovShare(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetSharesByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovShare(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovShare(uint64(l))
+	}
+	return n
+}
+
+func sovShare(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozShare(x uint64) (n int) {
+	return sovShare(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SharesAvailableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharesAvailableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharesAvailableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &da.DataAvailabilityHeader{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SharesAvailableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharesAvailableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharesAvailableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbabilityOfAvailabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbabilityOfAvailabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbabilityOfAvailabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProbabilityOfAvailabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbabilityOfAvailabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbabilityOfAvailabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probability", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Probability = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &da.DataAvailabilityHeader{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEDSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEDSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEDSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &da.DataAvailabilityHeader{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Row) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Row: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Row: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, make([]byte, postIndex-iNdEx))
+			copy(m.Shares[len(m.Shares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSharesByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSharesByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSharesByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &da.DataAvailabilityHeader{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShare(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShare
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipShare(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowShare
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthShare
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupShare
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthShare
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthShare        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowShare          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupShare = fmt.Errorf("proto: unexpected end of group")
+)
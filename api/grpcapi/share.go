@@ -0,0 +1,140 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	daproto "github.com/celestiaorg/celestia-app/proto/celestia/da"
+	"github.com/celestiaorg/nmt"
+	nmt_pb "github.com/celestiaorg/nmt/pb"
+
+	pb "github.com/celestiaorg/celestia-node/api/grpcapi/pb"
+	sharemod "github.com/celestiaorg/celestia-node/nodebuilder/share"
+	"github.com/celestiaorg/celestia-node/share"
+	shrexnd_pb "github.com/celestiaorg/celestia-node/share/p2p/shrexnd/pb"
+)
+
+var (
+	errMissingHeader = errors.New("missing header")
+	errMissingRoot   = errors.New("missing root")
+)
+
+type shareServer struct {
+	mod sharemod.Module
+}
+
+func (s *shareServer) SharesAvailable(
+	ctx context.Context,
+	req *pb.SharesAvailableRequest,
+) (*pb.SharesAvailableResponse, error) {
+	root, err := rootFromProto(req.Root)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.mod.SharesAvailable(ctx, root); err != nil {
+		return nil, err
+	}
+	return &pb.SharesAvailableResponse{}, nil
+}
+
+func (s *shareServer) ProbabilityOfAvailability(
+	ctx context.Context,
+	_ *pb.ProbabilityOfAvailabilityRequest,
+) (*pb.ProbabilityOfAvailabilityResponse, error) {
+	return &pb.ProbabilityOfAvailabilityResponse{Probability: s.mod.ProbabilityOfAvailability(ctx)}, nil
+}
+
+func (s *shareServer) GetShare(ctx context.Context, req *pb.GetShareRequest) (*pb.GetShareResponse, error) {
+	root, err := rootFromProto(req.Root)
+	if err != nil {
+		return nil, err
+	}
+	sh, err := s.mod.GetShare(ctx, root, int(req.Row), int(req.Col))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetShareResponse{Share: sh}, nil
+}
+
+func (s *shareServer) GetEDS(req *pb.GetEDSRequest, stream pb.Share_GetEDSServer) error {
+	root, err := rootFromProto(req.Root)
+	if err != nil {
+		return err
+	}
+	eds, err := s.mod.GetEDS(stream.Context(), root)
+	if err != nil {
+		return err
+	}
+	// only the original data square is sent, as the rest of the EDS is computed out of it
+	odsWidth := eds.Width() / 2
+	for i := uint(0); i < odsWidth; i++ {
+		if err = stream.Send(&pb.Row{Shares: eds.Row(i)[:odsWidth]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *shareServer) GetSharesByNamespace(
+	req *pb.GetSharesByNamespaceRequest,
+	stream pb.Share_GetSharesByNamespaceServer,
+) error {
+	root, err := rootFromProto(req.Root)
+	if err != nil {
+		return err
+	}
+	namespace, err := share.NamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return invalidArgument(err)
+	}
+	rows, err := s.mod.GetSharesByNamespace(stream.Context(), root, namespace)
+	if err != nil {
+		return err
+	}
+
+	// the rows are the ones of the root within the namespace range, in order
+	var rowIdxs []int
+	for i, row := range root.RowRoots {
+		if !namespace.IsOutsideRange(row, row) {
+			rowIdxs = append(rowIdxs, i)
+		}
+	}
+	for i, row := range rows {
+		msg := &shrexnd_pb.NamespaceRowResponse{
+			Shares: row.Shares,
+			Proof:  protoProof(row.Proof),
+		}
+		if i < len(rowIdxs) {
+			msg.RowIndex = uint32(rowIdxs[i])
+		}
+		if err = stream.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rootFromProto(root *daproto.DataAvailabilityHeader) (*share.Root, error) {
+	if root == nil {
+		return nil, invalidArgument(errMissingRoot)
+	}
+	dah, err := da.DataAvailabilityHeaderFromProto(root)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	return dah, nil
+}
+
+func protoProof(proof *nmt.Proof) *nmt_pb.Proof {
+	if proof == nil {
+		return nil
+	}
+	return &nmt_pb.Proof{
+		Start:                 int64(proof.Start()),
+		End:                   int64(proof.End()),
+		Nodes:                 proof.Nodes(),
+		LeafHash:              proof.LeafHash(),
+		IsMaxNamespaceIgnored: proof.IsMaxNamespaceIDIgnored(),
+	}
+}
@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+var (
+	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+	headerType = reflect.TypeOf((*header.ExtendedHeader)(nil))
+	blobType   = reflect.TypeOf((*blob.Blob)(nil))
+)
+
+// EnableAudit makes the server record every call into the audit.Log. It must be called before the
+// server is started.
+func (s *Server) EnableAudit(log audit.Log) {
+	s.audit = log
+}
+
+// recordCall records the call of the method with the given arguments and results into the audit log.
+func (s *Server) recordCall(ctx context.Context, method string, args, results []reflect.Value, start time.Time) {
+	entry := &audit.Entry{
+		Time:    start,
+		Method:  method,
+		Params:  summarizeParams(method, args),
+		Status:  audit.StatusOK,
+		Latency: time.Since(start),
+	}
+	if c, ok := ctx.Value(callerKey{}).(caller); ok {
+		entry.Caller, entry.Subject = c.id, c.subject
+	}
+	if n := len(results); n != 0 && results[n-1].Type() == errorType && !results[n-1].IsNil() {
+		err := results[n-1].Interface().(error)
+		entry.Status, entry.Error = audit.StatusError, err.Error()
+		var limitErr *LimitError
+		if errors.Is(err, perms.ErrOutOfScope) || errors.As(err, &limitErr) {
+			entry.Status = audit.StatusDenied
+		}
+	}
+
+	// the entry is recorded even if the caller has gone already
+	if err := s.audit.Record(context.WithoutCancel(ctx), entry); err != nil {
+		log.Errorw("recording audit log entry", "method", method, "err", err)
+	}
+}
+
+// summarizeParams returns the summary of the arguments of the method call for the audit log. Only
+// namespaces, heights, blob and transaction sizes and amounts are summarized, so that the data and
+// secrets passed to the node never end up in the log.
+func summarizeParams(method string, args []reflect.Value) map[string]string {
+	params := make(map[string]string)
+	add := func(key, value string) {
+		if prev, ok := params[key]; ok {
+			value = prev + "," + value
+		}
+		params[key] = value
+	}
+
+	// uint64 arguments are heights in every module except state, where they are gas limits
+	uintKey := "height"
+	if strings.HasPrefix(method, "state.") {
+		uintKey = "gas_limit"
+	}
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		_ = visitNamespaces(arg, func(ns share.Namespace) error {
+			if id := ns.String(); !seen[id] {
+				seen[id] = true
+				add("namespace", id)
+			}
+			return nil
+		})
+
+		switch {
+		case arg.Kind() == reflect.Uint64:
+			add(uintKey, strconv.FormatUint(arg.Uint(), 10))
+		case arg.Type() == headerType && !arg.IsNil():
+			add("height", strconv.FormatUint(arg.Interface().(*header.ExtendedHeader).Height(), 10))
+		case arg.Type() == blobType && !arg.IsNil():
+			add("blob_sizes", strconv.Itoa(len(arg.Interface().(*blob.Blob).Data)))
+		case arg.Kind() == reflect.Slice && arg.Type().Elem() == blobType:
+			for i := 0; i < arg.Len(); i++ {
+				if b := arg.Index(i).Interface().(*blob.Blob); b != nil {
+					add("blob_sizes", strconv.Itoa(len(b.Data)))
+				}
+			}
+		case arg.Type() == txType:
+			add("tx_size", strconv.Itoa(arg.Len()))
+		case arg.Type() == intType:
+			if amount := arg.Interface().(math.Int); !amount.IsNil() {
+				add("amounts", amount.String())
+			}
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	return params
+}
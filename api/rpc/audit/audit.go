@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var log = logging.Logger("rpc/audit")
+
+// DefaultQueryLimit is the number of entries returned by queries without a limit.
+const DefaultQueryLimit = 1000
+
+// ErrDisabled is returned when querying a node without the audit log.
+var ErrDisabled = errors.New("audit: audit log is disabled")
+
+// Status is the result status of an audited call.
+type Status string
+
+const (
+	// StatusOK marks the calls that succeeded.
+	StatusOK Status = "ok"
+	// StatusError marks the calls that failed.
+	StatusError Status = "error"
+	// StatusDenied marks the calls rejected by the scope or the limits of the caller.
+	StatusDenied Status = "denied"
+)
+
+// Entry is the record of a single RPC call.
+type Entry struct {
+	Time time.Time `json:"time"`
+	// Subject is the subject of the caller's token or the common name of its client certificate.
+	Subject string `json:"subject,omitempty"`
+	// Caller identifies the caller, e.g. by its token ID or its IP.
+	Caller string `json:"caller"`
+	Method string `json:"method"`
+	// Params summarizes the parameters of the call, like namespaces, heights, blob sizes and
+	// amounts. Other parameters, e.g. tokens, keys and blob data, are never recorded.
+	Params  map[string]string `json:"params,omitempty"`
+	Status  Status            `json:"status"`
+	Error   string            `json:"error,omitempty"`
+	Latency time.Duration     `json:"latency"`
+}
+
+// Query filters the audit log entries. Zero fields match every entry.
+type Query struct {
+	Since   time.Time
+	Until   time.Time
+	Subject string
+	Method  string
+	Status  Status
+	// Limit is the maximum number of the most recent matching entries returned.
+	// Defaults to DefaultQueryLimit.
+	Limit int
+}
+
+// Match reports whether the entry matches the Query.
+func (q *Query) Match(e *Entry) bool {
+	switch {
+	case !q.Since.IsZero() && e.Time.Before(q.Since):
+		return false
+	case !q.Until.IsZero() && e.Time.After(q.Until):
+		return false
+	case q.Subject != "" && e.Subject != q.Subject:
+		return false
+	case q.Method != "" && e.Method != q.Method:
+		return false
+	case q.Status != "" && e.Status != q.Status:
+		return false
+	}
+	return true
+}
+
+func (q *Query) limit() int {
+	if q.Limit <= 0 {
+		return DefaultQueryLimit
+	}
+	return q.Limit
+}
+
+// Log records the audit log entries and queries them.
+type Log interface {
+	// Record appends the entry to the log.
+	Record(context.Context, *Entry) error
+	// Query returns the most recent entries matching the Query in chronological order.
+	Query(context.Context, Query) ([]*Entry, error)
+	// Close flushes and closes the log.
+	Close() error
+}
+
+// tail keeps the last entries appended to it up to the limit.
+type tail struct {
+	limit   int
+	entries []*Entry
+}
+
+func (t *tail) append(e *Entry) {
+	t.entries = append(t.entries, e)
+	if len(t.entries) > 2*t.limit {
+		t.entries = append(t.entries[:0], t.entries[len(t.entries)-t.limit:]...)
+	}
+}
+
+func (t *tail) result() []*Entry {
+	if len(t.entries) > t.limit {
+		return t.entries[len(t.entries)-t.limit:]
+	}
+	return t.entries
+}
@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLog(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit", "rpc.log")
+	// every entry takes about 100 bytes, so the file is rotated on every third one
+	l, err := NewFileLog(path, 300, 2)
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.Record(ctx, testEntry(start, i)))
+	}
+	_, err = os.Stat(path + ".2")
+	require.NoError(t, err)
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+
+	// the entries of the removed files are gone, while the rest are returned in order
+	entries, err := l.Query(ctx, Query{})
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	require.Less(t, len(entries), 10)
+	require.Equal(t, start.Add(9*time.Second).UnixNano(), entries[len(entries)-1].Time.UnixNano())
+	for i := 1; i < len(entries); i++ {
+		require.True(t, entries[i-1].Time.Before(entries[i].Time))
+	}
+
+	// entries persist across reopening
+	require.NoError(t, l.Close())
+	l, err = NewFileLog(path, 300, 2)
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	reopened, err := l.Query(ctx, Query{Method: "blob.Submit", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, reopened, 1)
+	require.Equal(t, start.Add(9*time.Second).UnixNano(), reopened[0].Time.UnixNano())
+}
+
+func TestDatastoreLog(t *testing.T) {
+	ctx := context.Background()
+	l := NewDatastoreLog(dssync.MutexWrap(datastore.NewMapDatastore()), time.Hour)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.Record(ctx, testEntry(start, i)))
+	}
+
+	entries, err := l.Query(ctx, Query{})
+	require.NoError(t, err)
+	require.Len(t, entries, 10)
+
+	entries, err = l.Query(ctx, Query{Subject: "alice", Status: StatusError})
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	for _, e := range entries {
+		require.Equal(t, "alice", e.Subject)
+		require.Equal(t, StatusError, e.Status)
+	}
+
+	entries, err = l.Query(ctx, Query{Since: start.Add(5 * time.Second), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, start.Add(8*time.Second).UnixNano(), entries[0].Time.UnixNano())
+	require.Equal(t, start.Add(9*time.Second).UnixNano(), entries[1].Time.UnixNano())
+
+	// entries over the retention are pruned
+	require.NoError(t, l.Record(ctx, testEntry(start.Add(time.Hour), 5)))
+	entries, err = l.Query(ctx, Query{})
+	require.NoError(t, err)
+	require.Len(t, entries, 6)
+	require.Equal(t, start.Add(5*time.Second).UnixNano(), entries[0].Time.UnixNano())
+}
+
+func testEntry(start time.Time, i int) *Entry {
+	e := &Entry{
+		Time:    start.Add(time.Duration(i) * time.Second),
+		Subject: "bob",
+		Caller:  "token:1",
+		Method:  "blob.Submit",
+		Params:  map[string]string{"blob_sizes": "42"},
+		Status:  StatusOK,
+		Latency: time.Millisecond,
+	}
+	if i%3 == 0 {
+		e.Subject, e.Status, e.Error = "alice", StatusError, "failed"
+	}
+	return e
+}
@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+)
+
+var auditKey = datastore.NewKey("rpc_audit")
+
+// pruneInterval limits how often the entries over the retention are removed.
+const pruneInterval = time.Minute
+
+// DatastoreLog keeps the entries in the datastore, keyed by their time, removing the ones older than
+// the retention period.
+type DatastoreLog struct {
+	ds        datastore.Datastore
+	retention time.Duration
+
+	lk       sync.Mutex
+	seq      uint64
+	prunedAt time.Time
+}
+
+// NewDatastoreLog creates a new DatastoreLog. Zero retention keeps the entries forever.
+func NewDatastoreLog(ds datastore.Datastore, retention time.Duration) *DatastoreLog {
+	return &DatastoreLog{
+		ds:        namespace.Wrap(ds, auditKey),
+		retention: retention,
+	}
+}
+
+func (l *DatastoreLog) Record(ctx context.Context, e *Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	l.lk.Lock()
+	l.seq++
+	key := entryKey(e.Time, l.seq)
+	prune := l.retention > 0 && e.Time.Sub(l.prunedAt) >= pruneInterval
+	if prune {
+		l.prunedAt = e.Time
+	}
+	l.lk.Unlock()
+
+	if err = l.ds.Put(ctx, key, data); err != nil {
+		return fmt.Errorf("audit: writing entry: %w", err)
+	}
+	if prune {
+		return l.prune(ctx, e.Time.Add(-l.retention))
+	}
+	return nil
+}
+
+func (l *DatastoreLog) Query(ctx context.Context, q Query) ([]*Entry, error) {
+	results, err := l.ds.Query(ctx, query.Query{Orders: []query.Order{query.OrderByKeyDescending{}}})
+	if err != nil {
+		return nil, fmt.Errorf("audit: querying entries: %w", err)
+	}
+	defer results.Close()
+
+	limit := q.limit()
+	entries := make([]*Entry, 0)
+	for res := range results.Next() {
+		if res.Error != nil {
+			return nil, fmt.Errorf("audit: iterating entries: %w", res.Error)
+		}
+		e := &Entry{}
+		if err = json.Unmarshal(res.Value, e); err != nil {
+			log.Warnw("skipping invalid entry", "key", res.Key, "err", err)
+			continue
+		}
+		// entries are iterated from the newest one, so none of the rest is recent enough
+		if !q.Since.IsZero() && e.Time.Before(q.Since) {
+			break
+		}
+		if !q.Match(e) {
+			continue
+		}
+		entries = append(entries, e)
+		if len(entries) == limit {
+			break
+		}
+	}
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries, nil
+}
+
+func (l *DatastoreLog) Close() error {
+	return nil
+}
+
+// prune removes the entries recorded before the given time.
+func (l *DatastoreLog) prune(ctx context.Context, before time.Time) error {
+	results, err := l.ds.Query(ctx, query.Query{KeysOnly: true, Orders: []query.Order{query.OrderByKey{}}})
+	if err != nil {
+		return fmt.Errorf("audit: querying entries: %w", err)
+	}
+	defer results.Close()
+
+	for res := range results.Next() {
+		if res.Error != nil {
+			return fmt.Errorf("audit: iterating entries: %w", res.Error)
+		}
+		key := datastore.NewKey(res.Key)
+		at, ok := entryTime(key)
+		if ok && !at.Before(before) {
+			return nil
+		}
+		if err = l.ds.Delete(ctx, key); err != nil {
+			return fmt.Errorf("audit: removing entry: %w", err)
+		}
+	}
+	return nil
+}
+
+// entryKey returns the key of the entry sorting in the order the entries were recorded.
+func entryKey(at time.Time, seq uint64) datastore.Key {
+	return datastore.NewKey(fmt.Sprintf("%020d-%020d", at.UnixNano(), seq))
+}
+
+func entryTime(key datastore.Key) (time.Time, bool) {
+	nanos, _, ok := strings.Cut(key.BaseNamespace(), "-")
+	if !ok {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, n), true
+}
@@ -0,0 +1,168 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileLog writes the entries as JSON lines into a file, which is rotated once it grows over the
+// maximum size. The rotated files are suffixed with their number, starting from the newest one,
+// and the oldest ones are removed over the maximum number of files.
+type FileLog struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	lk   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileLog opens the FileLog at the given path, appending to the existing file. Zero maxSize
+// disables rotation.
+func NewFileLog(path string, maxSize int64, maxFiles int) (*FileLog, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("audit: creating directory: %w", err)
+	}
+	l := &FileLog{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *FileLog) Record(_ context.Context, e *Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	if l.file == nil {
+		return fs.ErrClosed
+	}
+	if l.maxSize > 0 && l.size > 0 && l.size+int64(len(data)) > l.maxSize {
+		if err = l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.file.Write(data)
+	l.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("audit: writing entry: %w", err)
+	}
+	return nil
+}
+
+func (l *FileLog) Query(ctx context.Context, q Query) ([]*Entry, error) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	t := &tail{limit: q.limit()}
+	for i := l.maxFiles; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		err := l.scan(l.rotatedPath(i), func(e *Entry) {
+			if q.Match(e) {
+				t.append(e)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t.result(), nil
+}
+
+func (l *FileLog) Close() error {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// scan calls fn for every entry in the file at the path, if it exists.
+func (l *FileLog) scan(path string, fn func(*Entry)) error {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("audit: opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		e := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), e); err != nil {
+			log.Warnw("skipping invalid entry", "path", path, "err", err)
+			continue
+		}
+		fn(e)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("audit: reading %s: %w", path, err)
+	}
+	return nil
+}
+
+// rotate moves the current file into the first rotated one, shifting the others.
+func (l *FileLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("audit: closing file: %w", err)
+	}
+	l.file = nil
+	if err := os.Remove(l.rotatedPath(l.maxFiles)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("audit: removing oldest file: %w", err)
+	}
+	for i := l.maxFiles - 1; i >= 0; i-- {
+		err := os.Rename(l.rotatedPath(i), l.rotatedPath(i+1))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("audit: rotating file: %w", err)
+		}
+	}
+	return l.open()
+}
+
+func (l *FileLog) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("audit: opening file: %w", err)
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("audit: opening file: %w", err)
+	}
+	l.file, l.size = f, st.Size()
+	return nil
+}
+
+// rotatedPath returns the path of the rotated file with the given number, where zero is the current
+// file.
+func (l *FileLog) rotatedPath(i int) string {
+	if i == 0 {
+		return l.path
+	}
+	return fmt.Sprintf("%s.%d", l.path, i)
+}
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"reflect"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+func TestSummarizeParams(t *testing.T) {
+	ns, err := share.NewBlobNamespaceV0([]byte("audit"))
+	require.NoError(t, err)
+	first, err := blob.NewBlobV0(ns, make([]byte, 10))
+	require.NoError(t, err)
+	second, err := blob.NewBlobV0(ns, make([]byte, 20))
+	require.NoError(t, err)
+
+	args := func(vals ...interface{}) []reflect.Value {
+		out := make([]reflect.Value, len(vals))
+		for i, v := range vals {
+			out[i] = reflect.ValueOf(v)
+		}
+		return out
+	}
+
+	params := summarizeParams("blob.Submit", args([]*blob.Blob{first, second}))
+	require.Equal(t, map[string]string{"namespace": ns.String(), "blob_sizes": "10,20"}, params)
+
+	params = summarizeParams("blob.GetAll", args(uint64(42), []share.Namespace{ns}))
+	require.Equal(t, map[string]string{"namespace": ns.String(), "height": "42"}, params)
+
+	params = summarizeParams("state.Transfer", args(state.AccAddress{}, math.NewInt(60), math.NewInt(40), uint64(100)))
+	require.Equal(t, map[string]string{"amounts": "60,40", "gas_limit": "100"}, params)
+
+	params = summarizeParams("state.SubmitTx", args(state.Tx("signed tx")))
+	require.Equal(t, map[string]string{"tx_size": "9"}, params)
+
+	// tokens and other strings are redacted
+	require.Nil(t, summarizeParams("node.AuthVerify", args("secret token")))
+}
@@ -3,9 +3,13 @@ package client
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/filecoin-project/go-jsonrpc"
+	logging "github.com/ipfs/go-log/v2"
 
 	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
@@ -18,6 +22,8 @@ import (
 	"github.com/celestiaorg/celestia-node/nodebuilder/state"
 )
 
+var log = logging.Logger("rpc/client")
+
 var (
 	// staticClient is used for generating the OpenRPC spec.
 	staticClient Client
@@ -72,19 +78,140 @@ func NewClient(ctx context.Context, addr string, token string) (*Client, error)
 }
 
 func newClient(ctx context.Context, addr string, authHeader http.Header) (*Client, error) {
-	var multiCloser multiClientCloser
+	var opts []jsonrpc.Option
+	if strings.HasPrefix(addr, UnixScheme) {
+		var httpClient *http.Client
+		addr, httpClient = NewHTTPClient(addr)
+		opts = append(opts, jsonrpc.WithHTTPClient(httpClient))
+	}
+	namespaces, err := negotiate(ctx, addr, authHeader, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	var client Client
 	for name, module := range moduleMap(&client) {
-		closer, err := jsonrpc.NewClient(ctx, addr, name, module, authHeader)
+		closer, err := jsonrpc.NewMergeClient(
+			ctx,
+			addr,
+			namespaces[name],
+			[]interface{}{module},
+			authHeader,
+			opts...,
+		)
 		if err != nil {
+			client.Close()
 			return nil, err
 		}
-		multiCloser.register(closer)
+		client.closer.register(closer)
 	}
 
 	return &client, nil
 }
 
+// moduleVersions are the versions of the modules the client is built against.
+var moduleVersions = map[string]int{
+	"share":  share.ModuleVersion,
+	"state":  state.ModuleVersion,
+	"header": header.ModuleVersion,
+	"fraud":  fraud.ModuleVersion,
+	"das":    das.ModuleVersion,
+	"p2p":    p2p.ModuleVersion,
+	"node":   node.ModuleVersion,
+	"blob":   blob.ModuleVersion,
+}
+
+// moduleVersionsInfo mirrors rpc.ModuleVersions, which cannot be imported by the client.
+type moduleVersionsInfo struct {
+	Current   int   `json:"current"`
+	Supported []int `json:"supported"`
+}
+
+// negotiate checks the versions of the modules served by the node at the address against the
+// versions of the client and returns the namespaces to call the modules under, keyed by the module
+// names. Nodes predating the versioning are called under the unversioned namespaces.
+func negotiate(
+	ctx context.Context,
+	addr string,
+	authHeader http.Header,
+	opts []jsonrpc.Option,
+) (map[string]string, error) {
+	var handshake struct {
+		Versions func(context.Context) (map[string]moduleVersionsInfo, error)
+	}
+	closer, err := jsonrpc.NewMergeClient(ctx, addr, "rpc", []interface{}{&handshake}, authHeader, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	namespaces := make(map[string]string, len(moduleVersions))
+	served, err := handshake.Versions(ctx)
+	if err != nil {
+		if !strings.Contains(err.Error(), "method 'rpc.Versions' not found") {
+			return nil, fmt.Errorf("negotiating API versions: %w", err)
+		}
+		log.Warn("node does not support API versioning, calling unversioned namespaces")
+		for module := range moduleVersions {
+			namespaces[module] = module
+		}
+		return namespaces, nil
+	}
+
+	for module, version := range moduleVersions {
+		namespaces[module] = module + ".v" + strconv.Itoa(version)
+		info, ok := served[module]
+		if !ok {
+			return nil, fmt.Errorf("node does not serve the %s module", module)
+		}
+		switch {
+		case version > info.Current:
+			return nil, fmt.Errorf(
+				"%s v%d is not supported by the node serving v%d: upgrade the node",
+				module, version, info.Current,
+			)
+		case !containsVersion(info.Supported, version):
+			return nil, fmt.Errorf(
+				"%s v%d is no longer supported by the node serving %v: upgrade the client",
+				module, version, info.Supported,
+			)
+		case version < info.Current:
+			log.Warnw("API version is deprecated, the client should be upgraded",
+				"module", module, "version", version, "current", info.Current)
+		}
+	}
+	return namespaces, nil
+}
+
+func containsVersion(versions []int, version int) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// UnixScheme prefixes the addresses of the node's unix socket, e.g. unix:///path/to/node.sock.
+const UnixScheme = "unix://"
+
+// NewHTTPClient returns the HTTP address and the http.Client to reach the node at the given
+// address. Addresses of unix sockets are dialed over the socket.
+func NewHTTPClient(addr string) (string, *http.Client) {
+	path, ok := strings.CutPrefix(addr, UnixScheme)
+	if !ok {
+		return addr, http.DefaultClient
+	}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", path)
+		},
+	}
+	// the host is ignored by the dialer
+	return "http://unix", &http.Client{Transport: transport}
+}
+
 func moduleMap(client *Client) map[string]interface{} {
 	// TODO: this duplication of strings many times across the codebase can be avoided with issue #1176
 	return map[string]interface{}{
@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+// RegisterGRPCService registers the gRPC service onto the server. The gRPC requests are served on
+// the same address as the JSON-RPC ones, over HTTP/2, and authenticated the same way. It must be
+// called before the server is started.
+func (s *Server) RegisterGRPCService(desc *grpc.ServiceDesc, impl interface{}) {
+	if s.grpc == nil {
+		s.grpc = grpc.NewServer(
+			grpc.ForceServerCodec(codec{}),
+			grpc.ChainUnaryInterceptor(unaryStatusInterceptor),
+			grpc.ChainStreamInterceptor(streamStatusInterceptor),
+		)
+		// gRPC clients without TLS speak HTTP/2 over cleartext
+		s.srv.Handler = h2c.NewHandler(s.srv.Handler, &http2.Server{})
+	}
+	s.grpc.RegisterService(desc, impl)
+}
+
+// isGRPCRequest reports whether the request is a gRPC call.
+func isGRPCRequest(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc")
+}
+
+// enableHTTP2 makes the TLS listener negotiate HTTP/2, which gRPC requires.
+func (s *Server) enableHTTP2() error {
+	cfg := s.srv.TLSConfig
+	for _, proto := range cfg.NextProtos {
+		if proto == http2.NextProtoTLS {
+			return nil
+		}
+	}
+
+	cfg = cfg.Clone()
+	cfg.NextProtos = []string{http2.NextProtoTLS, "http/1.1"}
+	// the protocols of the configs returned per client override the ones of the base config
+	if getConfig := cfg.GetConfigForClient; getConfig != nil {
+		cfg.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			clientCfg, err := getConfig(hello)
+			if clientCfg == nil || err != nil {
+				return clientCfg, err
+			}
+			clientCfg = clientCfg.Clone()
+			clientCfg.NextProtos = cfg.NextProtos
+			return clientCfg, nil
+		}
+	}
+	s.srv.TLSConfig = cfg
+	return http2.ConfigureServer(s.srv, &http2.Server{})
+}
+
+// codec marshals the gogoproto messages the gRPC services are generated with.
+type codec struct{}
+
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	return proto.Marshal(v.(proto.Message))
+}
+
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	return proto.Unmarshal(data, v.(proto.Message))
+}
+
+func (codec) Name() string {
+	return "proto"
+}
+
+func unaryStatusInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, grpcStatus(err)
+}
+
+func streamStatusInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return grpcStatus(handler(srv, stream))
+}
+
+// grpcStatus converts the errors of the module calls into the gRPC status errors with the codes
+// matching them.
+func grpcStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Unknown
+	var limitErr *LimitError
+	switch {
+	case errors.As(err, &limitErr):
+		code = codes.ResourceExhausted
+	// the permission errors of the auth proxy are not typed
+	case errors.Is(err, perms.ErrOutOfScope), strings.Contains(err.Error(), "missing permission"):
+		code = codes.PermissionDenied
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,227 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+// limitErrorCode is the JSON-RPC error code of the calls over the limits of the caller.
+const limitErrorCode = -32005
+
+// limiterIdleTimeout is the time after which the state of an idle caller is dropped.
+const limiterIdleTimeout = 10 * time.Minute
+
+// RateLimit limits the calls of a single caller.
+type RateLimit struct {
+	// Rate is the total weight of the calls a caller may make per second. Zero disables rate
+	// limiting.
+	Rate float64
+	// Burst is the total weight of the calls a caller may make at once. Defaults to the Rate and
+	// is never less than the weight of the heaviest method.
+	Burst int
+	// MaxConcurrent is the number of calls a caller may have in flight. Zero disables the cap.
+	MaxConcurrent int
+}
+
+// Limits defines the limits of the calls to the server. Callers with a token or a client
+// certificate are limited per identity, the others per IP. Callers over the unix socket are never
+// limited.
+type Limits struct {
+	PerToken RateLimit
+	PerIP    RateLimit
+	// MethodWeights maps methods as "module.Method" to their weight in rate limits.
+	// Other methods weigh 1.
+	MethodWeights map[string]int
+}
+
+// DefaultLimits returns the default Limits, which weigh the methods, but do not limit the calls.
+func DefaultLimits() Limits {
+	return Limits{
+		MethodWeights: map[string]int{
+			"share.GetEDS":               64,
+			"share.GetSharesByNamespace": 8,
+			"blob.GetAll":                8,
+			"blob.Submit":                4,
+		},
+	}
+}
+
+// Validate checks the Limits for negative values.
+func (l *Limits) Validate() error {
+	for name, rl := range map[string]RateLimit{"per token": l.PerToken, "per IP": l.PerIP} {
+		if rl.Rate < 0 || rl.Burst < 0 || rl.MaxConcurrent < 0 {
+			return fmt.Errorf("rpc: negative %s limit", name)
+		}
+	}
+	for method, weight := range l.MethodWeights {
+		if weight < 1 {
+			return fmt.Errorf("rpc: weight of %s must be positive", method)
+		}
+	}
+	return nil
+}
+
+// LimitError is returned for the calls over the limits of the caller.
+type LimitError struct {
+	Reason string
+}
+
+func (e *LimitError) Error() string {
+	return "rpc: " + e.Reason
+}
+
+type callerKey struct{}
+
+// caller identifies the client the request limits and audit log entries are accounted to.
+type caller struct {
+	id      string
+	kind    string
+	subject string
+	limited bool
+}
+
+// identifyCaller returns the context of the request with the caller the limits are accounted to.
+func identifyCaller(ctx context.Context, r *http.Request, token string, payload *perms.JWTPayload) context.Context {
+	c := caller{limited: true}
+	switch {
+	case token != "" && payload != nil && payload.ID != "":
+		c.id, c.kind, c.subject = "token:"+payload.ID, "token", payload.Subject
+	case token != "":
+		hash := sha256.Sum256([]byte(token))
+		c.id, c.kind = "token:"+hex.EncodeToString(hash[:8]), "token"
+		if payload != nil {
+			c.subject = payload.Subject
+		}
+	case isSocketConn(ctx):
+		c.id, c.kind, c.limited = "socket", "socket", false
+	case r.TLS != nil && len(r.TLS.VerifiedChains) != 0 && len(r.TLS.VerifiedChains[0]) != 0:
+		commonName := r.TLS.VerifiedChains[0][0].Subject.CommonName
+		c.id, c.kind, c.subject = "cert:"+commonName, "cert", commonName
+	default:
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		c.id, c.kind = "ip:"+host, "ip"
+	}
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// limiter enforces the Limits on the callers.
+type limiter struct {
+	limits  Limits
+	metrics *metrics
+
+	// maxWeight is the weight of the heaviest method, which must always fit into a burst.
+	maxWeight int
+
+	lk       sync.Mutex
+	callers  map[string]*callerState
+	prunedAt time.Time
+	nowFn    func() time.Time
+}
+
+type callerState struct {
+	rate     *rate.Limiter
+	inflight int
+	seenAt   time.Time
+}
+
+func newLimiter(limits Limits) *limiter {
+	maxWeight := 1
+	for _, w := range limits.MethodWeights {
+		if w > maxWeight {
+			maxWeight = w
+		}
+	}
+	return &limiter{
+		limits:    limits,
+		maxWeight: maxWeight,
+		callers:   make(map[string]*callerState),
+		nowFn:     time.Now,
+	}
+}
+
+// acquire accounts the call of the method by the caller in the context. The returned release must
+// be called once the call is done.
+func (l *limiter) acquire(ctx context.Context, method string) (release func(), err error) {
+	c, ok := ctx.Value(callerKey{}).(caller)
+	if !ok || !c.limited {
+		return func() {}, nil
+	}
+	rl := l.limits.PerToken
+	if c.kind == "ip" {
+		rl = l.limits.PerIP
+	}
+	if rl.Rate == 0 && rl.MaxConcurrent == 0 {
+		return func() {}, nil
+	}
+
+	weight := 1
+	if w, ok := l.limits.MethodWeights[method]; ok {
+		weight = w
+	}
+
+	l.lk.Lock()
+	defer l.lk.Unlock()
+	now := l.nowFn()
+	l.prune(now)
+	st, ok := l.callers[c.id]
+	if !ok {
+		st = &callerState{}
+		if rl.Rate != 0 {
+			burst := rl.Burst
+			if burst == 0 {
+				burst = int(rl.Rate)
+			}
+			if burst < l.maxWeight {
+				burst = l.maxWeight
+			}
+			st.rate = rate.NewLimiter(rate.Limit(rl.Rate), burst)
+		}
+		l.callers[c.id] = st
+	}
+	st.seenAt = now
+
+	if rl.MaxConcurrent != 0 && st.inflight >= rl.MaxConcurrent {
+		l.metrics.observeLimited(ctx, method, c.kind, "concurrency")
+		return nil, &LimitError{Reason: fmt.Sprintf("too many concurrent calls, limit is %d", rl.MaxConcurrent)}
+	}
+	if st.rate != nil && !st.rate.AllowN(now, weight) {
+		l.metrics.observeLimited(ctx, method, c.kind, "rate")
+		return nil, &LimitError{Reason: fmt.Sprintf("rate limit exceeded by %s", method)}
+	}
+
+	st.inflight++
+	l.metrics.observeInflight(ctx, c.kind, 1)
+	return func() {
+		l.lk.Lock()
+		st.inflight--
+		st.seenAt = l.nowFn()
+		l.lk.Unlock()
+		l.metrics.observeInflight(ctx, c.kind, -1)
+	}, nil
+}
+
+// prune drops the state of the callers idle for long enough.
+func (l *limiter) prune(now time.Time) {
+	if now.Sub(l.prunedAt) < time.Minute {
+		return
+	}
+	l.prunedAt = now
+	for id, st := range l.callers {
+		if st.inflight == 0 && now.Sub(st.seenAt) > limiterIdleTimeout {
+			delete(l.callers, id)
+		}
+	}
+}
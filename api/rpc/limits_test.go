@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Now()
+	l := newLimiter(Limits{
+		PerToken:      RateLimit{Rate: 1, MaxConcurrent: 2},
+		MethodWeights: map[string]int{"share.GetEDS": 4},
+	})
+	l.nowFn = func() time.Time { return now }
+
+	ctx := context.WithValue(context.Background(), callerKey{}, caller{id: "token:a", kind: "token", limited: true})
+	other := context.WithValue(context.Background(), callerKey{}, caller{id: "token:b", kind: "token", limited: true})
+
+	// the burst fits the heaviest method
+	release, err := l.acquire(ctx, "share.GetEDS")
+	require.NoError(t, err)
+	release()
+	_, err = l.acquire(ctx, "header.GetByHeight")
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr))
+
+	// the tokens refill over time
+	now = now.Add(2 * time.Second)
+	first, err := l.acquire(ctx, "header.GetByHeight")
+	require.NoError(t, err)
+	second, err := l.acquire(ctx, "header.GetByHeight")
+	require.NoError(t, err)
+
+	// the calls in flight are capped
+	now = now.Add(time.Minute)
+	_, err = l.acquire(ctx, "header.GetByHeight")
+	require.ErrorContains(t, err, "too many concurrent calls")
+	first()
+	release, err = l.acquire(ctx, "header.GetByHeight")
+	require.NoError(t, err)
+	release()
+	second()
+
+	// callers are limited independently
+	release, err = l.acquire(other, "share.GetEDS")
+	require.NoError(t, err)
+	release()
+
+	// idle callers are pruned
+	now = now.Add(2 * limiterIdleTimeout)
+	release, err = l.acquire(other, "header.GetByHeight")
+	require.NoError(t, err)
+	release()
+	require.Len(t, l.callers, 1)
+}
+
+func TestLimits_Validate(t *testing.T) {
+	require.NoError(t, (&Limits{}).Validate())
+	limits := DefaultLimits()
+	require.NoError(t, limits.Validate())
+	require.Error(t, (&Limits{PerIP: RateLimit{Rate: -1}}).Validate())
+	require.Error(t, (&Limits{MethodWeights: map[string]int{"share.GetEDS": 0}}).Validate())
+}
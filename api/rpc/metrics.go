@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var meter = otel.Meter("rpc")
+
+type metrics struct {
+	limitedCalls  metric.Int64Counter
+	inflightCalls metric.Int64UpDownCounter
+}
+
+// WithMetrics enables the metrics of the calls limited by the server.
+func (s *Server) WithMetrics() error {
+	limitedCalls, err := meter.Int64Counter(
+		"rpc_limited_calls",
+		metric.WithDescription("Total count of RPC calls rejected over the limits of the caller"),
+	)
+	if err != nil {
+		return err
+	}
+	inflightCalls, err := meter.Int64UpDownCounter(
+		"rpc_inflight_calls",
+		metric.WithDescription("Number of limited RPC calls in flight"),
+	)
+	if err != nil {
+		return err
+	}
+	s.limiter.metrics = &metrics{
+		limitedCalls:  limitedCalls,
+		inflightCalls: inflightCalls,
+	}
+	return nil
+}
+
+func (m *metrics) observeLimited(ctx context.Context, method, caller, reason string) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+	m.limitedCalls.Add(ctx, 1,
+		metric.WithAttributes(
+			attribute.String("method", method),
+			attribute.String("caller", caller),
+			attribute.String("reason", reason),
+		))
+}
+
+func (m *metrics) observeInflight(ctx context.Context, caller string, delta int64) {
+	if m == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+	m.inflightCalls.Add(ctx, delta,
+		metric.WithAttributes(
+			attribute.String("caller", caller),
+		))
+}
@@ -14,12 +14,29 @@ var (
 	AllPerms       = []auth.Permission{"public", "read", "write", "admin"}
 )
 
+// Levels maps the names of the permission levels to the permissions granted by them.
+var Levels = map[string][]auth.Permission{
+	"public": DefaultPerms,
+	"read":   ReadPerms,
+	"write":  ReadWritePerms,
+	"admin":  AllPerms,
+}
+
 var AuthKey = "Authorization"
 
 // JWTPayload is a utility struct for marshaling/unmarshalling
 // permissions into for token signing/verifying.
 type JWTPayload struct {
 	Allow []auth.Permission
+	// Expiry is the unix time in seconds after which the token is no longer valid.
+	// Tokens without expiry are valid until revoked.
+	Expiry int64 `json:"exp,omitempty"`
+	// Subject is the human-readable label of the token holder.
+	Subject string `json:"sub,omitempty"`
+	// ID uniquely identifies the token, so that it can be revoked.
+	ID string `json:"jti,omitempty"`
+	// Scope optionally restricts the methods, namespaces and spending of the token.
+	Scope *Scope `json:"scope,omitempty"`
 }
 
 func (j *JWTPayload) MarshalBinary() (data []byte, err error) {
@@ -0,0 +1,93 @@
+package perms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// Scope narrows down what a token may do on top of the permissions it is granted.
+// Empty fields impose no restrictions.
+type Scope struct {
+	// Methods lists the methods the token may call as "module.Method",
+	// or "module.*" for all the methods of a module.
+	Methods []string `json:"methods,omitempty"`
+	// Namespaces lists the namespaces the token may submit to or read from. Methods that take no
+	// namespace are not restricted by it, so it is best combined with Methods.
+	Namespaces []share.Namespace `json:"namespaces,omitempty"`
+	// SpendLimit caps the sum of amounts and fees in utia passed to a single call.
+	// Fees estimated by the node, e.g. for blob.Submit, are checked against it once estimated.
+	// Raw transactions are denied to tokens with a limit, as their spending is unknown.
+	SpendLimit uint64 `json:"spend_limit,omitempty"`
+}
+
+// Validate checks the Scope for malformed methods and namespaces.
+func (s *Scope) Validate() error {
+	for _, m := range s.Methods {
+		module, method, ok := strings.Cut(m, ".")
+		if !ok || module == "" || method == "" {
+			return fmt.Errorf("scope: invalid method %q: must be module.Method or module.*", m)
+		}
+	}
+	for _, ns := range s.Namespaces {
+		if err := ns.Validate(); err != nil {
+			return fmt.Errorf("scope: invalid namespace %s: %w", ns, err)
+		}
+	}
+	return nil
+}
+
+// IsEmpty reports whether the Scope imposes no restrictions.
+func (s *Scope) IsEmpty() bool {
+	return s == nil || (len(s.Methods) == 0 && len(s.Namespaces) == 0 && s.SpendLimit == 0)
+}
+
+// AllowsMethod reports whether the method of the given module may be called.
+func (s *Scope) AllowsMethod(module, method string) bool {
+	if len(s.Methods) == 0 {
+		return true
+	}
+	for _, m := range s.Methods {
+		if m == module+"."+method || m == module+".*" {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsNamespace reports whether the namespace may be submitted to or read from.
+func (s *Scope) AllowsNamespace(ns share.Namespace) bool {
+	if len(s.Namespaces) == 0 {
+		return true
+	}
+	for _, allowed := range s.Namespaces {
+		if allowed.Equals(ns) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsSpend reports whether the given amount in utia may be spent in a single call.
+func (s *Scope) AllowsSpend(amount uint64) bool {
+	return s.SpendLimit == 0 || amount <= s.SpendLimit
+}
+
+// ErrOutOfScope is returned for calls beyond the Scope of the token.
+var ErrOutOfScope = errors.New("call is out of the token scope")
+
+type scopeKey struct{}
+
+// WithScope attaches the Scope of the caller's token to the context.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFromContext returns the Scope of the caller's token, if any.
+func ScopeFromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(scopeKey{}).(*Scope)
+	return scope
+}
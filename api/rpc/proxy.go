@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+// guardedProxy wraps every method of the given internal struct of the module with the checks of
+// the perms.Scope of the caller's token and of the Limits of the caller, recording the calls into
+// the audit log if enabled.
+func (s *Server) guardedProxy(module string, internal interface{}) {
+	rint := reflect.ValueOf(internal).Elem()
+	for f := 0; f < rint.NumField(); f++ {
+		field := rint.Type().Field(f)
+		fn := rint.Field(f)
+		if fn.IsNil() {
+			continue
+		}
+		call := reflect.ValueOf(fn.Interface())
+		method := module + "." + field.Name
+		rint.Field(f).Set(reflect.MakeFunc(field.Type, func(args []reflect.Value) (results []reflect.Value) {
+			ctx := args[0].Interface().(context.Context)
+			if s.audit != nil {
+				start := time.Now()
+				defer func() {
+					s.recordCall(ctx, method, args[1:], results, start)
+				}()
+			}
+
+			if scope := perms.ScopeFromContext(ctx); !scope.IsEmpty() {
+				if err := checkScope(scope, module, field.Name, args[1:]); err != nil {
+					return errorResults(field.Type, err)
+				}
+				// fees estimated by the node are checked against the limit once estimated
+				if scope.SpendLimit != 0 {
+					args[0] = reflect.ValueOf(state.WithSpendLimit(ctx, scope.SpendLimit))
+				}
+			}
+
+			release, err := s.limiter.acquire(ctx, method)
+			if err != nil {
+				return errorResults(field.Type, err)
+			}
+			defer release()
+			return call.Call(args)
+		}))
+	}
+}
+
+// errorResults returns the results of the function of the given type failing with the error.
+func errorResults(typ reflect.Type, err error) []reflect.Value {
+	rerr := reflect.ValueOf(&err).Elem()
+	if typ.NumOut() == 2 {
+		return []reflect.Value{
+			reflect.Zero(typ.Out(0)),
+			rerr,
+		}
+	}
+	return []reflect.Value{rerr}
+}
@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"fmt"
+	"reflect"
+
+	"cosmossdk.io/math"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+var (
+	intType        = reflect.TypeOf(math.Int{})
+	txType         = reflect.TypeOf(state.Tx{})
+	namespaceType  = reflect.TypeOf(share.Namespace{})
+	namespacedType = reflect.TypeOf((*namespaced)(nil)).Elem()
+)
+
+// namespaced is implemented by the arguments bound to a namespace, e.g. blobs.
+type namespaced interface {
+	Namespace() share.Namespace
+}
+
+// checkScope ensures the call of the module method with the given arguments is within the scope.
+func checkScope(scope *perms.Scope, module, method string, args []reflect.Value) error {
+	if !scope.AllowsMethod(module, method) {
+		return fmt.Errorf("%w: method %s.%s is not allowed", perms.ErrOutOfScope, module, method)
+	}
+
+	spend := math.ZeroInt()
+	for _, arg := range args {
+		err := visitNamespaces(arg, func(ns share.Namespace) error {
+			if !scope.AllowsNamespace(ns) {
+				return fmt.Errorf("%w: namespace %s is not allowed", perms.ErrOutOfScope, ns)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// the amounts spent by raw transactions are unknown, so they are denied to tokens with a limit
+		if arg.Type() == txType && scope.SpendLimit != 0 {
+			return fmt.Errorf("%w: raw transactions are not allowed with a spend limit", perms.ErrOutOfScope)
+		}
+		// negative amounts are fees to be estimated by the node, which checks them against the limit
+		if arg.Type() == intType {
+			amount := arg.Interface().(math.Int)
+			if !amount.IsNil() && amount.IsPositive() {
+				spend = spend.Add(amount)
+			}
+		}
+	}
+	if !spend.IsUint64() || !scope.AllowsSpend(spend.Uint64()) {
+		return fmt.Errorf("%w: spending %s exceeds the limit of %d", perms.ErrOutOfScope, spend, scope.SpendLimit)
+	}
+	return nil
+}
+
+// visitNamespaces calls visit for every namespace the argument is bound to.
+func visitNamespaces(arg reflect.Value, visit func(share.Namespace) error) error {
+	switch {
+	case arg.Type() == namespaceType:
+		return visit(arg.Interface().(share.Namespace))
+	case arg.Type().Implements(namespacedType):
+		if arg.Kind() == reflect.Ptr && arg.IsNil() {
+			return nil
+		}
+		return visit(arg.Interface().(namespaced).Namespace())
+	case arg.Kind() == reflect.Slice && arg.Type().Elem().Kind() != reflect.Uint8:
+		for i := 0; i < arg.Len(); i++ {
+			if err := visitNamespaces(arg.Index(i), visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
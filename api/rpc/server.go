@@ -2,9 +2,16 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/fs"
 	"net"
 	"net/http"
+	"os"
 	"reflect"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -12,7 +19,9 @@ import (
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/go-jsonrpc/auth"
 	logging "github.com/ipfs/go-log/v2"
+	"google.golang.org/grpc"
 
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
 	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
@@ -26,32 +35,131 @@ type Server struct {
 
 	started atomic.Bool
 
-	auth jwt.Signer
+	auth   jwt.Signer
+	tokens *authtoken.Registry
+	// clientCertPerms maps the common names of verified client certificates to their permissions.
+	clientCertPerms map[string][]auth.Permission
+
+	socketPath     string
+	socketMode     fs.FileMode
+	socketPerms    []auth.Permission
+	socketListener net.Listener
+
+	limiter *limiter
+	audit   audit.Log
+
+	versionsLk sync.Mutex
+	versions   map[string]ModuleVersions
+
+	grpc *grpc.Server
+	// handlers maps the paths of the HTTP handlers served next to the RPC.
+	handlers map[string]http.Handler
 }
 
-func NewServer(address, port string, secret jwt.Signer) *Server {
-	rpc := jsonrpc.NewServer()
+// NewServer creates a new RPC Server verifying the tokens with the given secret. Tokens revoked in
+// the given Registry are rejected. Registry is optional.
+func NewServer(address, port string, secret jwt.Signer, tokens *authtoken.Registry) *Server {
+	errs := jsonrpc.NewErrors()
+	errs.Register(limitErrorCode, new(*LimitError))
+	rpc := jsonrpc.NewServer(jsonrpc.WithServerErrors(errs))
 	srv := &Server{
 		rpc: rpc,
 		srv: &http.Server{
 			Addr: address + ":" + port,
 			// the amount of time allowed to read request headers. set to the default 2 seconds
 			ReadHeaderTimeout: 2 * time.Second,
+			ConnContext:       socketConnContext,
 		},
-		auth: secret,
-	}
-	srv.srv.Handler = &auth.Handler{
-		Verify: srv.verifyAuth,
-		Next:   rpc.ServeHTTP,
+		auth:     secret,
+		tokens:   tokens,
+		limiter:  newLimiter(Limits{}),
+		versions: make(map[string]ModuleVersions),
 	}
+	rpc.Register("rpc", versionsHandler{s: srv})
+	srv.srv.Handler = http.HandlerFunc(srv.authHandler)
 	return srv
 }
 
+// authHandler attaches the permissions and the scope of the token in the header of the request
+// to the request context. Requests without a token are granted the default permissions.
+// It mirrors auth.Handler, which can only attach the permissions.
+func (s *Server) authHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := r.Header.Get(perms.AuthKey)
+	if token == "" {
+		token = r.FormValue("token")
+		if token != "" {
+			token = "Bearer " + token
+		}
+	}
+	var payload *perms.JWTPayload
+	if token != "" {
+		if !strings.HasPrefix(token, "Bearer ") {
+			log.Warn("missing Bearer prefix in auth header")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		token = strings.TrimPrefix(token, "Bearer ")
+		var err error
+		payload, err = s.verifyAuth(ctx, token)
+		if err != nil {
+			log.Warnf("JWT Verification failed (originating from %s): %s", r.RemoteAddr, err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		ctx = auth.WithPerm(ctx, payload.Allow)
+		ctx = perms.WithScope(ctx, payload.Scope)
+	} else if isSocketConn(ctx) {
+		ctx = auth.WithPerm(ctx, s.socketPerms)
+	} else if permissions, ok := s.verifyClientCert(r); ok {
+		ctx = auth.WithPerm(ctx, permissions)
+	}
+	ctx = identifyCaller(ctx, r, token, payload)
+	if h, ok := s.handlers[r.URL.Path]; ok {
+		h.ServeHTTP(w, r.WithContext(ctx))
+		return
+	}
+	if s.grpc != nil && isGRPCRequest(r) {
+		s.grpc.ServeHTTP(w, r.WithContext(ctx))
+		return
+	}
+	s.rpc.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// verifyClientCert returns the permissions mapped to the client certificate verified during the
+// TLS handshake, if any.
+func (s *Server) verifyClientCert(r *http.Request) ([]auth.Permission, bool) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	permissions, ok := s.clientCertPerms[r.TLS.VerifiedChains[0][0].Subject.CommonName]
+	return permissions, ok
+}
+
+// SetLimits sets the Limits of the calls to the server. It must be called before the server is
+// started.
+func (s *Server) SetLimits(limits Limits) {
+	metrics := s.limiter.metrics
+	s.limiter = newLimiter(limits)
+	s.limiter.metrics = metrics
+}
+
+// EnableTLS makes the server accept TLS connections only. The permissions mapped to the common
+// names of verified client certificates are granted to the requests without a token. It must be
+// called before the server is started.
+func (s *Server) EnableTLS(cfg *tls.Config, clientCertPerms map[string][]auth.Permission) {
+	s.srv.TLSConfig = cfg
+	s.clientCertPerms = clientCertPerms
+}
+
 // verifyAuth is the RPC server's auth middleware. This middleware is only
 // reached if a token is provided in the header of the request, otherwise only
 // methods with `read` permissions are accessible.
-func (s *Server) verifyAuth(_ context.Context, token string) ([]auth.Permission, error) {
-	return authtoken.ExtractSignedPermissions(s.auth, token)
+func (s *Server) verifyAuth(_ context.Context, token string) (*perms.JWTPayload, error) {
+	if s.tokens == nil {
+		return authtoken.ExtractSignedPayload(s.auth, token)
+	}
+	return s.tokens.Verify(s.auth, token)
 }
 
 // RegisterService registers a service onto the RPC server. All methods on the service will then be
@@ -61,10 +169,20 @@ func (s *Server) RegisterService(namespace string, service interface{}) {
 }
 
 // RegisterAuthedService registers a service onto the RPC server. All methods on the service will
-// then be exposed over the RPC.
+// then be exposed over the RPC. Calls are checked against the permissions and the scope of the
+// caller's token.
 func (s *Server) RegisterAuthedService(namespace string, service interface{}, out interface{}) {
-	auth.PermissionedProxy(perms.AllPerms, perms.DefaultPerms, service, getInternalStruct(out))
-	s.RegisterService(namespace, out)
+	s.registerProxy(namespace, namespace, service, out, false)
+}
+
+// RegisterHTTPHandler registers the HTTP handler onto the server at the given path. The requests
+// to the path are authenticated like the RPC calls, with their permissions and scope attached to
+// the request context. It must be called before the server is started.
+func (s *Server) RegisterHTTPHandler(path string, h http.Handler) {
+	if s.handlers == nil {
+		s.handlers = make(map[string]http.Handler)
+	}
+	s.handlers[path] = h
 }
 
 func getInternalStruct(api interface{}) interface{} {
@@ -82,7 +200,28 @@ func (s *Server) Start(context.Context) error {
 	if err != nil {
 		return err
 	}
+	if s.srv.TLSConfig != nil {
+		if s.grpc != nil {
+			if err = s.enableHTTP2(); err != nil {
+				listener.Close()
+				return fmt.Errorf("rpc: enabling HTTP/2: %w", err)
+			}
+		}
+		listener = tls.NewListener(listener, s.srv.TLSConfig)
+	}
 	s.listener = listener
+
+	if s.socketPath != "" {
+		s.socketListener, err = listenUnix(s.socketPath, s.socketMode)
+		if err != nil {
+			listener.Close()
+			return fmt.Errorf("rpc: listening on unix socket: %w", err)
+		}
+		log.Infow("server listening on unix socket", "path", s.socketPath)
+		//nolint:errcheck
+		go s.srv.Serve(s.socketListener)
+	}
+
 	log.Infow("server started", "listening on", s.srv.Addr)
 	//nolint:errcheck
 	go s.srv.Serve(listener)
@@ -96,10 +235,20 @@ func (s *Server) Stop(ctx context.Context) error {
 		log.Warn("cannot stop server: already stopped")
 		return nil
 	}
+	if s.grpc != nil {
+		// cancels the gRPC streams, e.g. subscriptions, which would keep the server from shutting down
+		s.grpc.Stop()
+	}
 	err := s.srv.Shutdown(ctx)
 	if err != nil {
 		return err
 	}
+	if s.socketListener != nil {
+		if err = os.Remove(s.socketPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Warnw("removing unix socket", "path", s.socketPath, "err", err)
+		}
+		s.socketListener = nil
+	}
 	s.listener = nil
 	log.Info("server stopped")
 	return nil
@@ -0,0 +1,357 @@
+package rpc
+
+import (
+	"context"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cristalhq/jwt"
+	"github.com/filecoin-project/go-jsonrpc"
+	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
+	"github.com/celestiaorg/celestia-node/api/rpc/client"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig"
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig/tlstest"
+)
+
+type testService struct{}
+
+func (testService) Public(context.Context) (string, error) { return "public", nil }
+func (testService) Admin(context.Context) (string, error)  { return "admin", nil }
+
+type testAPI struct {
+	Internal struct {
+		Public func(context.Context) (string, error) `perm:"public"`
+		Admin  func(context.Context) (string, error) `perm:"admin"`
+	}
+}
+
+func (api *testAPI) Public(ctx context.Context) (string, error) { return api.Internal.Public(ctx) }
+func (api *testAPI) Admin(ctx context.Context) (string, error)  { return api.Internal.Admin(ctx) }
+
+func TestServer_ClientCertPerms(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ca := tlstest.NewCA(t)
+	certPath, keyPath, caPath := ca.WriteFiles(t, t.TempDir(), "localhost")
+	cfg := &tlsconfig.Config{CertPath: certPath, KeyPath: keyPath, ClientCAPath: caPath}
+	tlsCfg, err := cfg.ServerConfig()
+	require.NoError(t, err)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv := NewServer("127.0.0.1", "0", signer, nil)
+	srv.EnableTLS(tlsCfg, map[string][]auth.Permission{"operator": perms.AllPerms})
+	srv.RegisterAuthedService("test", testService{}, &testAPI{})
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(ctx))
+	})
+
+	newClient := func(commonName string, header http.Header) *testAPI {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: ca.ClientConfig(t, commonName)}}
+		api := &testAPI{}
+		closer, err := jsonrpc.NewMergeClient(
+			ctx,
+			"https://"+srv.ListenAddr(),
+			"test",
+			[]interface{}{&api.Internal},
+			header,
+			jsonrpc.WithHTTPClient(client),
+		)
+		require.NoError(t, err)
+		t.Cleanup(closer)
+		return api
+	}
+
+	// the mapped certificate is granted its permissions
+	operator := newClient("operator", nil)
+	resp, err := operator.Internal.Admin(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "admin", resp)
+
+	// unknown certificates are granted the default permissions
+	unknown := newClient("unknown", nil)
+	resp, err = unknown.Internal.Public(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "public", resp)
+	_, err = unknown.Internal.Admin(ctx)
+	require.ErrorContains(t, err, "missing permission")
+
+	// tokens take precedence over certificates
+	token, err := perms.NewTokenWithPerms(signer, perms.ReadPerms)
+	require.NoError(t, err)
+	reader := newClient("operator", http.Header{perms.AuthKey: []string{"Bearer " + string(token)}})
+	_, err = reader.Internal.Admin(ctx)
+	require.ErrorContains(t, err, "missing permission")
+
+	// clients without a certificate are rejected
+	noCert := newClient("", nil)
+	_, err = noCert.Internal.Public(ctx)
+	require.Error(t, err)
+}
+
+func TestServer_UnixSocket(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// a stale socket left by a crashed server is replaced
+	path := filepath.Join(t.TempDir(), "node.sock")
+	stale, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv := NewServer("127.0.0.1", "0", signer, nil)
+	srv.EnableUnixSocket(path, 0600, perms.AllPerms)
+	srv.RegisterAuthedService("test", testService{}, &testAPI{})
+	require.NoError(t, srv.Start(ctx))
+
+	st, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, fs.FileMode(0600), st.Mode().Perm())
+
+	newClient := func(addr string) *testAPI {
+		addr, httpClient := client.NewHTTPClient(addr)
+		api := &testAPI{}
+		closer, err := jsonrpc.NewMergeClient(
+			ctx,
+			addr,
+			"test",
+			[]interface{}{&api.Internal},
+			nil,
+			jsonrpc.WithHTTPClient(httpClient),
+		)
+		require.NoError(t, err)
+		t.Cleanup(closer)
+		return api
+	}
+
+	// requests over the socket are granted its permissions
+	resp, err := newClient(client.UnixScheme + path).Internal.Admin(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "admin", resp)
+
+	// while requests over TCP are not
+	_, err = newClient("http://" + srv.ListenAddr()).Internal.Admin(ctx)
+	require.ErrorContains(t, err, "missing permission")
+
+	require.NoError(t, srv.Stop(ctx))
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	// other files are never replaced
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+	srv = NewServer("127.0.0.1", "0", signer, nil)
+	srv.EnableUnixSocket(path, 0600, perms.AllPerms)
+	require.Error(t, srv.Start(ctx))
+}
+
+func TestServer_Limits(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv := NewServer("127.0.0.1", "0", signer, nil)
+	srv.SetLimits(Limits{
+		PerIP:         RateLimit{Rate: 0.001, Burst: 3},
+		MethodWeights: map[string]int{"test.Public": 2},
+	})
+	path := filepath.Join(t.TempDir(), "node.sock")
+	srv.EnableUnixSocket(path, 0600, perms.AllPerms)
+	srv.RegisterAuthedService("test", testService{}, &testAPI{})
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(ctx))
+	})
+
+	newClient := func(addr string, header http.Header) *testAPI {
+		addr, httpClient := client.NewHTTPClient(addr)
+		api := &testAPI{}
+		closer, err := jsonrpc.NewMergeClient(
+			ctx,
+			addr,
+			"test",
+			[]interface{}{&api.Internal},
+			header,
+			jsonrpc.WithHTTPClient(httpClient),
+		)
+		require.NoError(t, err)
+		t.Cleanup(closer)
+		return api
+	}
+
+	// the weight of the call is taken from the burst
+	anonymous := newClient("http://"+srv.ListenAddr(), nil)
+	_, err = anonymous.Internal.Public(ctx)
+	require.NoError(t, err)
+	_, err = anonymous.Internal.Public(ctx)
+	require.ErrorContains(t, err, "rate limit exceeded by test.Public")
+
+	// tokens are not limited per IP
+	token, err := perms.NewTokenWithPerms(signer, perms.ReadPerms)
+	require.NoError(t, err)
+	reader := newClient("http://"+srv.ListenAddr(), http.Header{perms.AuthKey: []string{"Bearer " + string(token)}})
+	for i := 0; i < 3; i++ {
+		_, err = reader.Internal.Public(ctx)
+		require.NoError(t, err)
+	}
+
+	// neither are the calls over the socket
+	local := newClient(client.UnixScheme+path, nil)
+	for i := 0; i < 3; i++ {
+		_, err = local.Internal.Public(ctx)
+		require.NoError(t, err)
+	}
+}
+
+func TestServer_Audit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	auditLog := audit.NewDatastoreLog(dssync.MutexWrap(datastore.NewMapDatastore()), 0)
+	srv := NewServer("127.0.0.1", "0", signer, nil)
+	srv.SetLimits(Limits{PerToken: RateLimit{Rate: 0.001, Burst: 2}})
+	srv.EnableAudit(auditLog)
+	srv.RegisterAuthedService("test", testService{}, &testAPI{})
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(ctx))
+	})
+
+	token, err := jwt.NewTokenBuilder(signer).BuildBytes(&perms.JWTPayload{
+		Allow:   perms.ReadPerms,
+		Subject: "alice",
+		ID:      "1",
+	})
+	require.NoError(t, err)
+	api := &testAPI{}
+	closer, err := jsonrpc.NewMergeClient(
+		ctx,
+		"http://"+srv.ListenAddr(),
+		"test",
+		[]interface{}{&api.Internal},
+		http.Header{perms.AuthKey: []string{"Bearer " + string(token)}},
+	)
+	require.NoError(t, err)
+	t.Cleanup(closer)
+
+	_, err = api.Internal.Public(ctx)
+	require.NoError(t, err)
+	_, err = api.Internal.Admin(ctx)
+	require.Error(t, err)
+	_, err = api.Internal.Public(ctx)
+	require.Error(t, err)
+
+	entries, err := auditLog.Query(ctx, audit.Query{Subject: "alice"})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for _, e := range entries {
+		require.Equal(t, "token:1", e.Caller)
+	}
+	require.Equal(t, "test.Public", entries[0].Method)
+	require.Equal(t, audit.StatusOK, entries[0].Status)
+	require.Equal(t, "test.Admin", entries[1].Method)
+	require.Equal(t, audit.StatusError, entries[1].Status)
+	require.Contains(t, entries[1].Error, "missing permission")
+	require.Equal(t, "test.Public", entries[2].Method)
+	require.Equal(t, audit.StatusDenied, entries[2].Status)
+}
+
+func TestServer_Versions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv := NewServer("127.0.0.1", "0", signer, nil)
+	srv.RegisterVersionedService("test", 1, testService{}, &testAPI{})
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(ctx))
+	})
+	addr := "http://" + srv.ListenAddr()
+
+	var handshake struct {
+		Versions func(context.Context) (map[string]ModuleVersions, error)
+	}
+	closer, err := jsonrpc.NewMergeClient(ctx, addr, "rpc", []interface{}{&handshake}, nil)
+	require.NoError(t, err)
+	t.Cleanup(closer)
+	versions, err := handshake.Versions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]ModuleVersions{"test": {Current: 1, Supported: []int{1}}}, versions)
+
+	// the first version is served under both the versioned and the legacy namespaces
+	for _, namespace := range []string{"test.v1", "test"} {
+		api := &testAPI{}
+		closer, err := jsonrpc.NewMergeClient(ctx, addr, namespace, []interface{}{&api.Internal}, nil)
+		require.NoError(t, err)
+		t.Cleanup(closer)
+		out, err := api.Public(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "public", out)
+		_, err = api.Admin(ctx)
+		require.Error(t, err)
+	}
+}
+
+func TestClient_Negotiate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	srv := NewServer("127.0.0.1", "0", signer, nil)
+	require.NoError(t, srv.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop(ctx))
+	})
+	addr := "http://" + srv.ListenAddr()
+
+	setVersions := func(blob ModuleVersions) {
+		srv.versionsLk.Lock()
+		defer srv.versionsLk.Unlock()
+		for _, module := range []string{"share", "state", "header", "fraud", "das", "p2p", "node"} {
+			srv.versions[module] = ModuleVersions{Current: 1, Supported: []int{1}}
+		}
+		srv.versions["blob"] = blob
+	}
+
+	// the same version
+	setVersions(ModuleVersions{Current: 1, Supported: []int{1}})
+	c, err := client.NewPublicClient(ctx, addr)
+	require.NoError(t, err)
+	c.Close()
+
+	// a deprecated version still supported by the node
+	setVersions(ModuleVersions{Current: 2, Supported: []int{1, 2}})
+	c, err = client.NewPublicClient(ctx, addr)
+	require.NoError(t, err)
+	c.Close()
+
+	// a version no longer supported by the node
+	setVersions(ModuleVersions{Current: 2, Supported: []int{2}})
+	_, err = client.NewPublicClient(ctx, addr)
+	require.ErrorContains(t, err, "upgrade the client")
+
+	// a version newer than the node's one
+	setVersions(ModuleVersions{Current: 0})
+	_, err = client.NewPublicClient(ctx, addr)
+	require.ErrorContains(t, err, "upgrade the node")
+}
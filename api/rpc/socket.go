@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/filecoin-project/go-jsonrpc/auth"
+)
+
+type socketConnKey struct{}
+
+// socketConnContext marks the contexts of the connections accepted over the unix socket.
+func socketConnContext(ctx context.Context, conn net.Conn) context.Context {
+	if conn.LocalAddr().Network() == "unix" {
+		return context.WithValue(ctx, socketConnKey{}, true)
+	}
+	return ctx
+}
+
+func isSocketConn(ctx context.Context) bool {
+	ok, _ := ctx.Value(socketConnKey{}).(bool)
+	return ok
+}
+
+// EnableUnixSocket makes the server additionally listen on the unix socket at the given path,
+// created with the given file mode. Access to the socket is controlled by its file permissions,
+// so requests over it without a token are granted the given permissions. It must be called before
+// the server is started.
+func (s *Server) EnableUnixSocket(path string, mode fs.FileMode, permissions []auth.Permission) {
+	s.socketPath = path
+	s.socketMode = mode
+	s.socketPerms = permissions
+}
+
+// listenUnix listens on the unix socket at the given path with the given file mode. The socket is
+// created under a temporary name and moved into place only after its mode is set, so that it is
+// never reachable with broader permissions.
+func listenUnix(path string, mode fs.FileMode) (*net.UnixListener, error) {
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	tmpPath := filepath.Join(filepath.Dir(path), fmt.Sprintf(".%s.%d", filepath.Base(path), os.Getpid()))
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	// the socket is removed by the path it is moved to on close
+	listener.SetUnlinkOnClose(false)
+	if err = os.Chmod(tmpPath, mode); err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		listener.Close()
+		os.Remove(tmpPath) //nolint:errcheck
+		return nil, err
+	}
+	return listener, nil
+}
+
+// removeStaleSocket removes the socket left at the path by a server that was not stopped
+// gracefully. Other files are never removed.
+func removeStaleSocket(path string) error {
+	st, err := os.Lstat(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	case st.Mode().Type() != fs.ModeSocket:
+		return fmt.Errorf("rpc: %s exists and is not a socket", path)
+	}
+	return os.Remove(path)
+}
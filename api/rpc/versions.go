@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+)
+
+// VersionsMethod is the method the clients negotiate the versions of the modules with.
+const VersionsMethod = "rpc.Versions"
+
+// ModuleVersions are the versions of a module served by the node.
+type ModuleVersions struct {
+	// Current is the latest version of the module.
+	Current int `json:"current"`
+	// Supported are all the versions of the module the node serves, including the current one.
+	Supported []int `json:"supported"`
+}
+
+// Namespace returns the RPC namespace the given version of the module is served under,
+// e.g. blob.v1.
+func Namespace(module string, version int) string {
+	return module + ".v" + strconv.Itoa(version)
+}
+
+// RegisterVersionedService registers the given version of the module onto the RPC server under its
+// versioned namespace, like RegisterAuthedService. The first version of a module is also served
+// under the unversioned namespace of the module for the clients predating the versioning, which
+// are warned about in the logs.
+func (s *Server) RegisterVersionedService(module string, version int, service interface{}, out interface{}) {
+	s.registerProxy(module, Namespace(module, version), service, out, false)
+	if version == 1 {
+		// the legacy namespace needs its own proxy, as the registered one is bound to its namespace
+		legacy := reflect.New(reflect.TypeOf(out).Elem()).Interface()
+		s.registerProxy(module, module, service, legacy, true)
+	}
+
+	s.versionsLk.Lock()
+	defer s.versionsLk.Unlock()
+	v := s.versions[module]
+	if version > v.Current {
+		v.Current = version
+	}
+	v.Supported = appendVersion(v.Supported, version)
+	s.versions[module] = v
+}
+
+// registerProxy registers the service under the namespace behind the permission and guarded
+// proxies of the module. Calls into deprecated namespaces are warned about once per method.
+func (s *Server) registerProxy(module, namespace string, service, out interface{}, deprecated bool) {
+	internal := getInternalStruct(out)
+	auth.PermissionedProxy(perms.AllPerms, perms.DefaultPerms, service, internal)
+	s.guardedProxy(module, internal)
+	if deprecated {
+		s.deprecatedProxy(namespace, internal)
+	}
+	s.RegisterService(namespace, out)
+}
+
+// deprecatedProxy wraps every method of the given internal struct with a warning logged on its
+// first call.
+func (s *Server) deprecatedProxy(namespace string, internal interface{}) {
+	rint := reflect.ValueOf(internal).Elem()
+	for f := 0; f < rint.NumField(); f++ {
+		field := rint.Type().Field(f)
+		fn := rint.Field(f)
+		if fn.IsNil() {
+			continue
+		}
+		call := reflect.ValueOf(fn.Interface())
+		method := namespace + "." + field.Name
+		var once sync.Once
+		rint.Field(f).Set(reflect.MakeFunc(field.Type, func(args []reflect.Value) []reflect.Value {
+			once.Do(func() {
+				log.Warnw("deprecated unversioned RPC namespace called, clients should be upgraded",
+					"method", method)
+			})
+			return call.Call(args)
+		}))
+	}
+}
+
+// versionsHandler serves the versions of the registered modules.
+type versionsHandler struct {
+	s *Server
+}
+
+// Versions returns the versions of the modules served by the node, keyed by the module names.
+func (h versionsHandler) Versions(context.Context) (map[string]ModuleVersions, error) {
+	h.s.versionsLk.Lock()
+	defer h.s.versionsLk.Unlock()
+	versions := make(map[string]ModuleVersions, len(h.s.versions))
+	for module, v := range h.s.versions {
+		v.Supported = append([]int(nil), v.Supported...)
+		versions[module] = v
+	}
+	return versions, nil
+}
+
+// appendVersion inserts the version into the sorted versions, unless already present.
+func appendVersion(versions []int, version int) []int {
+	for i, v := range versions {
+		switch {
+		case v == version:
+			return versions
+		case v > version:
+			return append(versions[:i], append([]int{version}, versions[i:]...)...)
+		}
+	}
+	return append(versions, version)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/celestiaorg/celestia-node/api/rpc"
 	"github.com/celestiaorg/celestia-node/api/rpc/client"
 	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	blobpkg "github.com/celestiaorg/celestia-node/blob"
 	daspkg "github.com/celestiaorg/celestia-node/das"
 	headerpkg "github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder"
@@ -37,6 +38,7 @@ import (
 	shareMock "github.com/celestiaorg/celestia-node/nodebuilder/share/mocks"
 	statemod "github.com/celestiaorg/celestia-node/nodebuilder/state"
 	stateMock "github.com/celestiaorg/celestia-node/nodebuilder/state/mocks"
+	sharepkg "github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/state"
 )
 
@@ -262,6 +264,83 @@ func TestPublicClient(t *testing.T) {
 	require.ErrorContains(t, err, "missing permission")
 }
 
+// TestScopedRPC tests that tokens with a scope can only call the allowed methods
+// with the allowed namespaces and within the spend limit.
+func TestScopedRPC(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+
+	nd, server := setupNodeWithAuthedRPC(t, signer)
+	url := nd.RPCServer.ListenAddr()
+
+	allowedNs, err := sharepkg.NewBlobNamespaceV0([]byte("allowed"))
+	require.NoError(t, err)
+	deniedNs, err := sharepkg.NewBlobNamespaceV0([]byte("denied"))
+	require.NoError(t, err)
+	token, err := jwt.NewTokenBuilder(signer).BuildBytes(&perms.JWTPayload{
+		Allow: perms.AllPerms,
+		Scope: &perms.Scope{
+			Methods:    []string{"blob.Submit", "state.*"},
+			Namespaces: []sharepkg.Namespace{allowedNs},
+			SpendLimit: 100,
+		},
+	})
+	require.NoError(t, err)
+
+	var rpcClient *client.Client
+	for i := 0; i < 3; i++ {
+		time.Sleep(time.Second * 1)
+		rpcClient, err = client.NewClient(ctx, "http://"+url, string(token))
+		if err == nil {
+			t.Cleanup(rpcClient.Close)
+			break
+		}
+	}
+	require.NotNil(t, rpcClient)
+	require.NoError(t, err)
+
+	// 1. Test methods out of scope
+	_, err = rpcClient.Header.NetworkHead(ctx)
+	require.ErrorContains(t, err, perms.ErrOutOfScope.Error())
+
+	// 2. Test namespaces in and out of scope
+	allowedBlob, err := blobpkg.NewBlobV0(allowedNs, []byte("allowed"))
+	require.NoError(t, err)
+	server.Blob.EXPECT().Submit(gomock.Any(), gomock.Any()).Return(uint64(42), nil)
+	height, err := rpcClient.Blob.Submit(ctx, []*blobpkg.Blob{allowedBlob})
+	require.NoError(t, err)
+	require.EqualValues(t, 42, height)
+
+	deniedBlob, err := blobpkg.NewBlobV0(deniedNs, []byte("denied"))
+	require.NoError(t, err)
+	_, err = rpcClient.Blob.Submit(ctx, []*blobpkg.Blob{allowedBlob, deniedBlob})
+	require.ErrorContains(t, err, perms.ErrOutOfScope.Error())
+
+	// 3. Test spending in and out of scope
+	expectedResp := &state.TxResponse{}
+	server.State.EXPECT().
+		Transfer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(expectedResp, nil)
+	txResp, err := rpcClient.State.Transfer(ctx, sdk.AccAddress{}, sdk.NewInt(60), sdk.NewInt(40), 100)
+	require.NoError(t, err)
+	require.Equal(t, expectedResp, txResp)
+
+	_, err = rpcClient.State.Transfer(ctx, sdk.AccAddress{}, sdk.NewInt(60), sdk.NewInt(41), 100)
+	require.ErrorContains(t, err, perms.ErrOutOfScope.Error())
+	_, err = rpcClient.State.SubmitTx(ctx, []byte{})
+	require.ErrorContains(t, err, perms.ErrOutOfScope.Error())
+
+	// fees to be estimated by the node are checked by the node once estimated
+	server.State.EXPECT().
+		SubmitPayForBlob(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(expectedResp, nil)
+	_, err = rpcClient.State.SubmitPayForBlob(ctx, sdk.NewInt(-1), 0, []*blobpkg.Blob{allowedBlob})
+	require.NoError(t, err)
+}
+
 func TestAllReturnValuesAreMarshalable(t *testing.T) {
 	ra := reflect.TypeOf(new(api)).Elem()
 	for i := 0; i < ra.NumMethod(); i++ {
@@ -345,14 +424,14 @@ func setupNodeWithModifiedRPC(t *testing.T) (*nodebuilder.Node, *mockAPI) {
 	// given the behavior of fx.Invoke, this invoke will be called last as it is added at the root
 	// level module. For further information, check the documentation on fx.Invoke.
 	invokeRPC := fx.Invoke(func(srv *rpc.Server) {
-		srv.RegisterService("state", mockAPI.State)
-		srv.RegisterService("share", mockAPI.Share)
-		srv.RegisterService("fraud", mockAPI.Fraud)
-		srv.RegisterService("header", mockAPI.Header)
-		srv.RegisterService("das", mockAPI.Das)
-		srv.RegisterService("p2p", mockAPI.P2P)
-		srv.RegisterService("node", mockAPI.Node)
-		srv.RegisterService("blob", mockAPI.Blob)
+		srv.RegisterService(rpc.Namespace("state", statemod.ModuleVersion), mockAPI.State)
+		srv.RegisterService(rpc.Namespace("share", share.ModuleVersion), mockAPI.Share)
+		srv.RegisterService(rpc.Namespace("fraud", fraud.ModuleVersion), mockAPI.Fraud)
+		srv.RegisterService(rpc.Namespace("header", header.ModuleVersion), mockAPI.Header)
+		srv.RegisterService(rpc.Namespace("das", das.ModuleVersion), mockAPI.Das)
+		srv.RegisterService(rpc.Namespace("p2p", p2p.ModuleVersion), mockAPI.P2P)
+		srv.RegisterService(rpc.Namespace("node", node.ModuleVersion), mockAPI.Node)
+		srv.RegisterService(rpc.Namespace("blob", blob.ModuleVersion), mockAPI.Blob)
 	})
 	nd := nodebuilder.TestNode(t, node.Full, invokeRPC)
 	// start node
@@ -387,14 +466,14 @@ func setupNodeWithAuthedRPC(t *testing.T, auth jwt.Signer) (*nodebuilder.Node, *
 	// given the behavior of fx.Invoke, this invoke will be called last as it is added at the root
 	// level module. For further information, check the documentation on fx.Invoke.
 	invokeRPC := fx.Invoke(func(srv *rpc.Server) {
-		srv.RegisterAuthedService("state", mockAPI.State, &statemod.API{})
-		srv.RegisterAuthedService("share", mockAPI.Share, &share.API{})
-		srv.RegisterAuthedService("fraud", mockAPI.Fraud, &fraud.API{})
-		srv.RegisterAuthedService("header", mockAPI.Header, &header.API{})
-		srv.RegisterAuthedService("das", mockAPI.Das, &das.API{})
-		srv.RegisterAuthedService("p2p", mockAPI.P2P, &p2p.API{})
-		srv.RegisterAuthedService("node", mockAPI.Node, &node.API{})
-		srv.RegisterAuthedService("blob", mockAPI.Blob, &blob.API{})
+		srv.RegisterVersionedService("state", statemod.ModuleVersion, mockAPI.State, &statemod.API{})
+		srv.RegisterVersionedService("share", share.ModuleVersion, mockAPI.Share, &share.API{})
+		srv.RegisterVersionedService("fraud", fraud.ModuleVersion, mockAPI.Fraud, &fraud.API{})
+		srv.RegisterVersionedService("header", header.ModuleVersion, mockAPI.Header, &header.API{})
+		srv.RegisterVersionedService("das", das.ModuleVersion, mockAPI.Das, &das.API{})
+		srv.RegisterVersionedService("p2p", p2p.ModuleVersion, mockAPI.P2P, &p2p.API{})
+		srv.RegisterVersionedService("node", node.ModuleVersion, mockAPI.Node, &node.API{})
+		srv.RegisterVersionedService("blob", blob.ModuleVersion, mockAPI.Blob, &blob.API{})
 	})
 	// fx.Replace does not work here, but fx.Decorate does
 	nd := nodebuilder.TestNode(t, node.Full, invokeRPC, fx.Decorate(func() (jwt.Signer, error) {
@@ -100,22 +100,53 @@ func (s *Service) GetAll(ctx context.Context, height uint64, namespaces []share.
 	var (
 		resultBlobs = make([][]*Blob, len(namespaces))
 		resultErr   = make([]error, len(namespaces))
+		valid       = make([]int, 0, len(namespaces))
+		requested   = make([]share.Namespace, 0, len(namespaces))
 	)
-
-	wg := sync.WaitGroup{}
 	for i, namespace := range namespaces {
-		wg.Add(1)
-		go func(i int, namespace share.Namespace) {
-			defer wg.Done()
-			blobs, err := s.getBlobs(ctx, namespace, header.DAH)
+		if err := namespace.ValidateForData(); err != nil {
+			resultErr[i] = fmt.Errorf("getting blobs for namespace(%s): %s", namespace.String(), err)
+			continue
+		}
+		valid = append(valid, i)
+		requested = append(requested, namespace)
+	}
+
+	// all the namespaces are requested at once to make a single round trip
+	var namespacedShares []share.NamespacedShares
+	if len(requested) > 0 {
+		namespacedShares, err = s.shareGetter.GetSharesByNamespaces(ctx, header.DAH, requested)
+	}
+	switch {
+	case err == nil:
+		for j, i := range valid {
+			blobs, err := SharesToBlobs(namespacedShares[j].Flatten())
 			if err != nil {
-				resultErr[i] = fmt.Errorf("getting blobs for namespace(%s): %s", namespace.String(), err)
-				return
+				resultErr[i] = fmt.Errorf("getting blobs for namespace(%s): %s", namespaces[i].String(), err)
+				continue
 			}
 			resultBlobs[i] = blobs
-		}(i, namespace)
+		}
+	case ctx.Err() != nil:
+		return nil, fmt.Errorf("getting shares for namespaces: %w", err)
+	default:
+		// a failing namespace must not fail the others, so they are requested one by one
+		log.Debugw("getting shares for namespaces at once failed, falling back to one by one", "err", err)
+		wg := sync.WaitGroup{}
+		for _, i := range valid {
+			wg.Add(1)
+			go func(i int, namespace share.Namespace) {
+				defer wg.Done()
+				blobs, err := s.getBlobs(ctx, namespace, header.DAH)
+				if err != nil {
+					resultErr[i] = fmt.Errorf("getting blobs for namespace(%s): %s", namespace.String(), err)
+					return
+				}
+				resultBlobs[i] = blobs
+			}(i, namespaces[i])
+		}
+		wg.Wait()
 	}
-	wg.Wait()
 
 	blobs := make([]*Blob, 0)
 	for _, resBlobs := range resultBlobs {
@@ -2,10 +2,12 @@ package cmd
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
+	"strings"
 
 	"github.com/cristalhq/jwt"
 	"github.com/filecoin-project/go-jsonrpc/auth"
@@ -17,6 +19,7 @@ import (
 	"github.com/celestiaorg/celestia-node/libs/authtoken"
 	"github.com/celestiaorg/celestia-node/libs/keystore"
 	nodemod "github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/share"
 )
 
 func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
@@ -31,9 +34,30 @@ func AuthCmd(fsets ...*flag.FlagSet) *cobra.Command {
 	for _, set := range fsets {
 		cmd.Flags().AddFlagSet(set)
 	}
+	cmd.Flags().Duration(ttlFlag, 0, "Time after which the token expires. Zero means the token never expires.")
+	cmd.Flags().String(labelFlag, "", "Human-readable label identifying the token holder.")
+	cmd.Flags().StringSlice(
+		methodsFlag,
+		nil,
+		"Comma-separated methods the token may call as module.Method or module.* (e.g. blob.Submit,blob.Get).",
+	)
+	cmd.Flags().StringSlice(
+		namespacesFlag,
+		nil,
+		"Comma-separated hex-encoded v0 namespace IDs the token may submit to or read from.",
+	)
+	cmd.Flags().Uint64(spendLimitFlag, 0, "Maximum amount plus fee in utia the token may spend in a single call.")
 	return cmd
 }
 
+const (
+	ttlFlag        = "ttl"
+	labelFlag      = "label"
+	methodsFlag    = "methods"
+	namespacesFlag = "namespaces"
+	spendLimitFlag = "spend-limit"
+)
+
 func newToken(cmd *cobra.Command, args []string) error {
 	if len(args) != 1 {
 		return fmt.Errorf("must specify permissions")
@@ -71,7 +95,29 @@ func newToken(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	token, err := authtoken.NewSignedJWT(signer, permissions)
+	ttl, err := cmd.Flags().GetDuration(ttlFlag)
+	if err != nil {
+		return err
+	}
+	if ttl < 0 {
+		return fmt.Errorf("invalid ttl: %s", ttl)
+	}
+	label, err := cmd.Flags().GetString(labelFlag)
+	if err != nil {
+		return err
+	}
+
+	scope, err := parseScope(cmd)
+	if err != nil {
+		return err
+	}
+
+	// the token is recorded in the keystore, so that the node can list and revoke it
+	tokens, err := authtoken.NewRegistry(ks)
+	if err != nil {
+		return err
+	}
+	token, err := tokens.Issue(signer, permissions, ttl, label, scope)
 	if err != nil {
 		return err
 	}
@@ -80,6 +126,38 @@ func newToken(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func parseScope(cmd *cobra.Command) (*perms.Scope, error) {
+	methods, err := cmd.Flags().GetStringSlice(methodsFlag)
+	if err != nil {
+		return nil, err
+	}
+	nsIDs, err := cmd.Flags().GetStringSlice(namespacesFlag)
+	if err != nil {
+		return nil, err
+	}
+	spendLimit, err := cmd.Flags().GetUint64(spendLimitFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &perms.Scope{
+		Methods:    methods,
+		Namespaces: make([]share.Namespace, len(nsIDs)),
+		SpendLimit: spendLimit,
+	}
+	for i, id := range nsIDs {
+		b, err := hex.DecodeString(strings.TrimPrefix(id, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("decoding namespace %s: %w", id, err)
+		}
+		scope.Namespaces[i], err = share.NewBlobNamespaceV0(b)
+		if err != nil {
+			return nil, fmt.Errorf("parsing namespace %s: %w", id, err)
+		}
+	}
+	return scope, scope.Validate()
+}
+
 func generateNewKey(ks keystore.Keystore) (keystore.PrivKey, error) {
 	sk, err := io.ReadAll(io.LimitReader(rand.Reader, 32))
 	if err != nil {
@@ -95,16 +173,9 @@ func generateNewKey(ks keystore.Keystore) (keystore.PrivKey, error) {
 }
 
 func convertToPerms(perm string) ([]auth.Permission, error) {
-	perms, ok := stringsToPerms[perm]
+	permissions, ok := perms.Levels[perm]
 	if !ok {
 		return nil, fmt.Errorf("invalid permission specified: %s", perm)
 	}
-	return perms, nil
-}
-
-var stringsToPerms = map[string][]auth.Permission{
-	"public": perms.DefaultPerms,
-	"read":   perms.ReadPerms,
-	"write":  perms.ReadWritePerms,
-	"admin":  perms.AllPerms,
+	return permissions, nil
 }
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder"
+	"github.com/celestiaorg/celestia-node/nodebuilder/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+)
+
+const (
+	edsToFlag     = "to"
+	edsRemoveFlag = "remove"
+)
+
+func init() {
+	edsStoreCmd.AddCommand(edsStoreMigrate)
+
+	edsStoreMigrate.Flags().String(edsToFlag, string(share.ODSBackend),
+		"The EDS store backend to migrate to: 'dagstore' or 'ods'.")
+	edsStoreMigrate.Flags().Bool(edsRemoveFlag, false,
+		"Removes EDSes from the current backend once they are migrated. Disabled by default.")
+}
+
+var edsStoreMigrate = &cobra.Command{
+	Use: "migrate [node-store-path]",
+	Short: "Migrates EDSes of a stopped bridge or full node from its current EDS store backend to another one " +
+		"and switches the node config to the new backend.",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		path, err := homedir.Expand(args[0])
+		if err != nil {
+			return err
+		}
+
+		to, _ := cmd.Flags().GetString(edsToFlag)
+		remove, _ := cmd.Flags().GetBool(edsRemoveFlag)
+
+		nodestore, err := nodebuilder.OpenStore(path, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, nodestore.Close())
+		}()
+
+		cfg, err := nodestore.Config()
+		if err != nil {
+			return err
+		}
+		from := cfg.Share.EDSStoreBackend
+		if from == "" {
+			from = share.DAGStoreBackend
+		}
+		if from == share.EDSStoreBackend(to) {
+			return fmt.Errorf("node already uses the %s backend", to)
+		}
+
+		ds, err := nodestore.Datastore()
+		if err != nil {
+			return err
+		}
+
+		src, err := openEDSStore(cmd.Context(), from, path, ds, cfg)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, src.Stop(cmd.Context()))
+		}()
+		dst, err := openEDSStore(cmd.Context(), share.EDSStoreBackend(to), path, ds, cfg)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, dst.Stop(cmd.Context()))
+		}()
+
+		report, err := eds.Migrate(cmd.Context(), src, dst, remove)
+		if report != nil {
+			fmt.Printf("migrated: %d, skipped: %d, removed: %d\n", report.Migrated, report.Skipped, report.Removed)
+		}
+		if err != nil {
+			return err
+		}
+
+		cfg.Share.EDSStoreBackend = share.EDSStoreBackend(to)
+		return nodestore.PutConfig(cfg)
+	},
+}
+
+type startedEDSStore interface {
+	eds.EDSStore
+	Stop(context.Context) error
+}
+
+func openEDSStore(
+	ctx context.Context,
+	backend share.EDSStoreBackend,
+	path string,
+	ds datastore.Batching,
+	cfg *nodebuilder.Config,
+) (startedEDSStore, error) {
+	var (
+		store interface {
+			startedEDSStore
+			Start(context.Context) error
+		}
+		err error
+	)
+	switch backend {
+	case share.DAGStoreBackend:
+		store, err = eds.NewStore(path, ds, eds.WithODSOnly(cfg.Share.EDSStoreParams.ODSOnly))
+	case share.ODSBackend:
+		store, err = eds.NewODSStore(path, ds)
+	default:
+		return nil, fmt.Errorf("unknown EDS store backend %q", backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = store.Start(ctx); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
@@ -61,8 +61,9 @@ func init() {
 }
 
 var edsStoreCmd = &cobra.Command{
-	Use:   "eds-store [subcommand]",
-	Short: "Collection of eds-store related utilities",
+	Use:     "eds-store [subcommand]",
+	Aliases: []string{"eds"},
+	Short:   "Collection of eds-store related utilities",
 }
 
 var edsStoreStress = &cobra.Command{
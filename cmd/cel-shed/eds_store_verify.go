@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder"
+	"github.com/celestiaorg/celestia-node/share/eds"
+)
+
+const (
+	edsRebuildIndicesFlag = "rebuild-indices"
+	edsInvertedIndexFlag  = "inverted-index"
+	edsPruneFlag          = "prune"
+	edsJSONFlag           = "json"
+)
+
+func init() {
+	edsStoreCmd.AddCommand(edsStoreVerify)
+
+	edsStoreVerify.Flags().Bool(edsRebuildIndicesFlag, false,
+		"Re-registers EDSes with valid CAR files, but errored shards or missing indices. Disabled by default.")
+	edsStoreVerify.Flags().Bool(edsInvertedIndexFlag, false,
+		"Looks for inverted index entries pointing to unknown shards. Disabled by default.")
+	edsStoreVerify.Flags().Bool(edsPruneFlag, false,
+		"Removes inverted index entries pointing to unknown shards. Requires --inverted-index.")
+	edsStoreVerify.Flags().Bool(edsJSONFlag, false, "Prints the report as JSON.")
+}
+
+var edsStoreVerify = &cobra.Command{
+	Use: "verify [node-store-path]",
+	Short: "Verifies the integrity of the eds.Store of a stopped bridge or full node and optionally repairs it. " +
+		"EDSes with missing or corrupted CAR files can only be refetched by the node's background verifier.",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		path, err := homedir.Expand(args[0])
+		if err != nil {
+			return err
+		}
+
+		rebuild, _ := cmd.Flags().GetBool(edsRebuildIndicesFlag)
+		invertedIdx, _ := cmd.Flags().GetBool(edsInvertedIndexFlag)
+		prune, _ := cmd.Flags().GetBool(edsPruneFlag)
+		if prune && !invertedIdx {
+			return fmt.Errorf("--%s requires --%s", edsPruneFlag, edsInvertedIndexFlag)
+		}
+
+		nodestore, err := nodebuilder.OpenStore(path, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, nodestore.Close())
+		}()
+
+		cfg, err := nodestore.Config()
+		if err != nil {
+			return err
+		}
+		datastore, err := nodestore.Datastore()
+		if err != nil {
+			return err
+		}
+
+		// repaired EDSes are stored in the same way as by the node
+		store, err := eds.NewStore(path, datastore, eds.WithODSOnly(cfg.Share.EDSStoreParams.ODSOnly))
+		if err != nil {
+			return err
+		}
+		if err = store.Start(cmd.Context()); err != nil {
+			return err
+		}
+		defer func() {
+			err = errors.Join(err, store.Stop(cmd.Context()))
+		}()
+
+		report, err := store.Verify(cmd.Context(), eds.VerifyOptions{
+			RebuildIndices:     rebuild,
+			CheckInvertedIndex: invertedIdx,
+			PruneInvertedIndex: prune,
+		})
+		if report == nil {
+			return err
+		}
+
+		if asJSON, _ := cmd.Flags().GetBool(edsJSONFlag); asJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return errors.Join(err, enc.Encode(report))
+		}
+
+		for _, res := range report.Broken {
+			fmt.Printf("%s: issues: %v, repaired: %t\n", res.Hash, res.Issues, res.Repaired)
+			for _, e := range res.Errors {
+				fmt.Printf("\t%s\n", e)
+			}
+			if res.RepairError != "" {
+				fmt.Printf("\trepair failed: %s\n", res.RepairError)
+			}
+		}
+		fmt.Printf("checked: %d, broken: %d, repaired: %d\n", report.Checked, len(report.Broken), report.Repaired)
+		if invertedIdx {
+			fmt.Printf("dangling inverted index entries: %d, pruned: %d\n",
+				report.DanglingIndexEntries, report.PrunedIndexEntries)
+		}
+		fmt.Printf("took: %v\n", report.Duration)
+		return err
+	},
+}
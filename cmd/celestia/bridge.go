@@ -32,6 +32,7 @@ func init() {
 		cmdnode.Start(flags...),
 		cmdnode.AuthCmd(flags...),
 		cmdnode.ResetStore(flags...),
+		cmdnode.RestoreSnapshot(flags...),
 		cmdnode.RemoveConfigCmd(flags...),
 		cmdnode.UpdateConfigCmd(flags...),
 	)
@@ -46,7 +46,7 @@ func init() {
 		&requestURL,
 		"url",
 		"http://localhost:26658",
-		"Request URL",
+		"Request URL. Use unix:///path/to/socket to reach the node over its unix socket",
 	)
 	rpcCmd.PersistentFlags().StringVar(
 		&authTokenFlag,
@@ -61,7 +61,7 @@ func init() {
 		"Print JSON-RPC request along with the response",
 	)
 	rpcCmd.AddCommand(logCmd, logModuleCmd)
-	rpcCmd.AddCommand(blobCmd)
+	rpcCmd.AddCommand(blobCmd, shareCmd, snapshotCmd)
 	rootCmd.AddCommand(rpcCmd)
 }
 
@@ -256,7 +256,7 @@ func parseParams(method string, params []string) []interface{} {
 }
 
 func sendJSONRPCRequest(namespace, method string, params []interface{}) {
-	url := requestURL
+	url, httpClient := client.NewHTTPClient(requestURL)
 	request := jsonRPCRequest{
 		ID:      1,
 		JSONRPC: "2.0",
@@ -284,8 +284,7 @@ func sendJSONRPCRequest(namespace, method string, params []interface{}) {
 		req.Header.Set("Authorization", "Bearer "+authToken)
 	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		log.Fatalf("Error sending JSON-RPC request: %v", err)
 	}
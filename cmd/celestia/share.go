@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	heightFlag = "height"
+	outputFlag = "output"
+)
+
+func init() {
+	shareCmd.AddCommand(exportCmd, importCmd)
+
+	exportCmd.Flags().Uint64(heightFlag, 0, "Height of the header committing to the EDS")
+	exportCmd.Flags().String(outputFlag, "", "Path of the CARv1 file to write. Defaults to <height>.car")
+	_ = exportCmd.MarkFlagRequired(heightFlag)
+
+	importCmd.Flags().Uint64(heightFlag, 0, "Height of the header the EDS is validated against")
+	_ = importCmd.MarkFlagRequired(heightFlag)
+}
+
+var shareCmd = &cobra.Command{
+	Use:   "share [command]",
+	Short: "Allows to interact with the Share Service via JSON-RPC",
+	Args:  cobra.NoArgs,
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export --height <height> [--output <path>]",
+	Args:  cobra.NoArgs,
+	Short: "Exports the EDS at the given height into a CARv1 file.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := rpcClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		height, _ := cmd.Flags().GetUint64(heightFlag)
+		path, _ := cmd.Flags().GetString(outputFlag)
+		if path == "" {
+			path = fmt.Sprintf("%d.car", height)
+		}
+
+		car, err := client.Share.ExportEDS(cmd.Context(), height)
+		if err != nil {
+			return fmt.Errorf("error exporting EDS at height %d: %w", height, err)
+		}
+
+		// existing files are never overwritten
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return fmt.Errorf("error creating CAR file: %w", err)
+		}
+		_, err = f.Write(car)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(path)
+			return fmt.Errorf("error writing CAR file: %w", err)
+		}
+
+		printOutput(struct {
+			Height uint64 `json:"height"`
+			Path   string `json:"path"`
+		}{
+			Height: height,
+			Path:   path,
+		}, nil)
+		return nil
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import --height <height> [path]",
+	Args:  cobra.ExactArgs(1),
+	Short: "Imports the EDS from the given CARv1 file, validating it against the header at the given height.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := rpcClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		height, _ := cmd.Flags().GetUint64(heightFlag)
+		car, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("error reading CAR file: %w", err)
+		}
+
+		err = client.Share.ImportEDS(cmd.Context(), height, car)
+
+		printOutput(struct {
+			Height uint64 `json:"height"`
+		}{
+			Height: height,
+		}, err)
+		return nil
+	},
+}
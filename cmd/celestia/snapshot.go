@@ -0,0 +1,35 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot [command]",
+	Short: "Allows to manage node snapshots via JSON-RPC",
+	Args:  cobra.NoArgs,
+}
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create [path]",
+	Args:  cobra.ExactArgs(1),
+	Short: "Creates a snapshot of the running node in the given directory on the node's machine.",
+	Long: "Creates a snapshot of the EDS store, header store and DAS checkpoint of the running node in the " +
+		"given directory on the node's machine. Running it again over the directory of an interrupted snapshot " +
+		"resumes it. The snapshot can be restored with `celestia <node-type> restore-snapshot`.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := rpcClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		manifest, err := client.Node.SnapshotCreate(cmd.Context(), args[0])
+
+		printOutput(manifest, err)
+		return nil
+	},
+}
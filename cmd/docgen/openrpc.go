@@ -15,8 +15,8 @@ var rootCmd = &cobra.Command{
 	Use:   "docgen [packages]",
 	Short: "docgen generates the openrpc documentation for Celestia Node packages",
 	RunE: func(cmd *cobra.Command, moduleNames []string) error {
-		// 1. Open the respective nodebuilder/X/service.go files for AST parsing
-		nodeComments, permComments := docgen.ParseCommentsFromNodebuilderModules(moduleNames...)
+		// 1. Get the comments and permissions of the methods generated out of the Module interfaces
+		nodeComments, permComments := docgen.ModuleComments(moduleNames...)
 
 		// 2. Create an OpenRPC document from the map of comments + hardcoded metadata
 		doc := docgen.NewOpenRPCDocument(nodeComments, permComments)
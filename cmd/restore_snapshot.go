@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/celestiaorg/celestia-node/nodebuilder"
+)
+
+const forceFlag = "force"
+
+// RestoreSnapshot constructs a CLI command to restore a snapshot into the store of Celestia Node.
+func RestoreSnapshot(fsets ...*flag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "restore-snapshot [path]",
+		Short: "Restores the node's store from the snapshot in the given directory. " +
+			"Snapshots are created with `celestia rpc snapshot create`. Requires the node being stopped " +
+			"and its store being empty, unless forced.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			force, err := cmd.Flags().GetBool(forceFlag)
+			if err != nil {
+				return err
+			}
+			return nodebuilder.RestoreSnapshot(ctx, StorePath(ctx), NodeType(ctx), args[0], force)
+		},
+	}
+	cmd.Flags().Bool(forceFlag, false, "Merge the snapshot into a store that already contains data.")
+	for _, set := range fsets {
+		cmd.Flags().AddFlagSet(set)
+	}
+	return cmd
+}
@@ -20,13 +20,13 @@ const concurrencyLimit = 4
 
 type Exchange struct {
 	fetcher   *BlockFetcher
-	store     *eds.Store
+	store     eds.EDSStore
 	construct header.ConstructFn
 }
 
 func NewExchange(
 	fetcher *BlockFetcher,
-	store *eds.Store,
+	store eds.EDSStore,
 	construct header.ConstructFn,
 ) *Exchange {
 	return &Exchange{
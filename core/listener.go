@@ -33,7 +33,7 @@ type Listener struct {
 	fetcher *BlockFetcher
 
 	construct header.ConstructFn
-	store     *eds.Store
+	store     eds.EDSStore
 
 	headerBroadcaster libhead.Broadcaster[*header.ExtendedHeader]
 	hashBroadcaster   shrexsub.BroadcastFn
@@ -48,7 +48,7 @@ func NewListener(
 	fetcher *BlockFetcher,
 	hashBroadcaster shrexsub.BroadcastFn,
 	construct header.ConstructFn,
-	store *eds.Store,
+	store eds.EDSStore,
 	blocktime time.Duration,
 ) *Listener {
 	return &Listener{
@@ -179,10 +179,7 @@ func (cl *Listener) handleNewSignedBlock(ctx context.Context, b types.EventDataS
 
 	// notify network of new EDS hash only if core is already synced
 	if !syncing {
-		err = cl.hashBroadcaster(ctx, shrexsub.Notification{
-			DataHash: eh.DataHash.Bytes(),
-			Height:   eh.Height(),
-		})
+		err = cl.hashBroadcaster(ctx, shrexsub.NewNotification(eh.Height(), eh.DAH))
 		if err != nil && !errors.Is(err, context.Canceled) {
 			log.Errorw("listener: broadcasting data hash",
 				"height", b.Header.Height,
@@ -133,10 +133,7 @@ func (w *worker) sample(ctx context.Context, timeout time.Duration, height uint6
 
 	// notify network about availability of new block data (note: only full nodes can notify)
 	if w.state.job.jobType == recentJob {
-		err = w.broadcast(ctx, shrexsub.Notification{
-			DataHash: h.DataHash.Bytes(),
-			Height:   h.Height(),
-		})
+		err = w.broadcast(ctx, shrexsub.NewNotification(h.Height(), h.DAH))
 		if err != nil {
 			log.Warn("failed to broadcast availability message",
 				"height", h.Height(), "hash", h.Hash(), "err", err)
@@ -1,7 +1,11 @@
 package authtoken
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/cristalhq/jwt"
 	"github.com/filecoin-project/go-jsonrpc/auth"
@@ -9,9 +13,26 @@ import (
 	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 )
 
+var (
+	// ErrExpired is returned for tokens past their expiry.
+	ErrExpired = errors.New("authtoken: token expired")
+	// ErrRevoked is returned for tokens revoked by the node.
+	ErrRevoked = errors.New("authtoken: token revoked")
+)
+
 // ExtractSignedPermissions returns the permissions granted to the token by the passed signer.
-// If the token isn't signed by the signer, it will not pass verification.
+// If the token isn't signed by the signer or is expired, it will not pass verification.
 func ExtractSignedPermissions(signer jwt.Signer, token string) ([]auth.Permission, error) {
+	p, err := ExtractSignedPayload(signer, token)
+	if err != nil {
+		return nil, err
+	}
+	return p.Allow, nil
+}
+
+// ExtractSignedPayload returns the payload of the token signed by the passed signer.
+// If the token isn't signed by the signer or is expired, it will not pass verification.
+func ExtractSignedPayload(signer jwt.Signer, token string) (*perms.JWTPayload, error) {
 	tk, err := jwt.ParseAndVerifyString(token, signer)
 	if err != nil {
 		return nil, err
@@ -21,14 +42,52 @@ func ExtractSignedPermissions(signer jwt.Signer, token string) ([]auth.Permissio
 	if err != nil {
 		return nil, err
 	}
-	return p.Allow, nil
+	if p.Expiry != 0 && time.Now().Unix() >= p.Expiry {
+		return nil, ErrExpired
+	}
+	return p, nil
 }
 
 // NewSignedJWT returns a signed JWT token with the passed permissions and signer.
 func NewSignedJWT(signer jwt.Signer, permissions []auth.Permission) (string, error) {
-	token, err := jwt.NewTokenBuilder(signer).Build(&perms.JWTPayload{
+	return signPayload(signer, &perms.JWTPayload{
 		Allow: permissions,
 	})
+}
+
+// newPayload creates the payload of a uniquely identified token with the given permissions,
+// label and optional scope, expiring after the given ttl. Zero ttl means the token never expires.
+func newPayload(
+	permissions []auth.Permission,
+	ttl time.Duration,
+	label string,
+	scope *perms.Scope,
+) (*perms.JWTPayload, error) {
+	if !scope.IsEmpty() {
+		if err := scope.Validate(); err != nil {
+			return nil, err
+		}
+	} else {
+		scope = nil
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	p := &perms.JWTPayload{
+		Allow:   permissions,
+		Subject: label,
+		ID:      hex.EncodeToString(id),
+		Scope:   scope,
+	}
+	if ttl > 0 {
+		p.Expiry = time.Now().Add(ttl).Unix()
+	}
+	return p, nil
+}
+
+func signPayload(signer jwt.Signer, p *perms.JWTPayload) (string, error) {
+	token, err := jwt.NewTokenBuilder(signer).Build(p)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,32 @@
+//go:build darwin || freebsd || linux
+
+package authtoken
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive lock on the file under the given path, waiting until other
+// processes release it. Unlike fslock, the file is never removed, so that all the processes
+// always lock the same file.
+func lockFile(path string) (unlock func() error, err error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("authtoken: opening lock file: %w", err)
+	}
+	for {
+		err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+		if !errors.Is(err, syscall.EINTR) {
+			break
+		}
+	}
+	if err != nil {
+		file.Close() //nolint:errcheck
+		return nil, fmt.Errorf("authtoken: locking %s: %w", path, err)
+	}
+	// closing the file releases the lock
+	return file.Close, nil
+}
@@ -0,0 +1,306 @@
+package authtoken
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cristalhq/jwt"
+	"github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+)
+
+var (
+	// IssuedName is the keystore entry listing the tokens issued by the node.
+	IssuedName = keystore.KeyName("jwt-issued.json")
+	// RevokedName is the keystore entry listing the revoked tokens.
+	RevokedName = keystore.KeyName("jwt-revoked.json")
+)
+
+// ErrNotFound is returned when revoking a token that was not issued by the node.
+var ErrNotFound = errors.New("authtoken: token not found")
+
+// TokenInfo describes a token issued by the node. The token itself is never stored.
+type TokenInfo struct {
+	ID          string            `json:"id"`
+	Label       string            `json:"label,omitempty"`
+	Permissions []auth.Permission `json:"permissions"`
+	Scope       *perms.Scope      `json:"scope,omitempty"`
+	IssuedAt    time.Time         `json:"issued_at"`
+	// ExpiresAt is zero for tokens that never expire.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	Revoked   bool      `json:"revoked"`
+}
+
+func (ti TokenInfo) expired(now time.Time) bool {
+	return !ti.ExpiresAt.IsZero() && !now.Before(ti.ExpiresAt)
+}
+
+// Registry issues identifiable tokens and keeps track of them and of the revocation list in the
+// keystore. The revocation list is kept in memory, so that verification does not touch the
+// disk, while the issued tokens are always read from the keystore, as they can also be issued
+// by the `auth` command while the node runs. The keystore entries are guarded by a lock file
+// next to the keystore, so that both processes never change them at the same time.
+type Registry struct {
+	lk       sync.RWMutex
+	ks       keystore.Keystore
+	lockPath string
+	revoked  map[string]time.Time
+}
+
+// NewRegistry loads the revocation list from the keystore.
+func NewRegistry(ks keystore.Keystore) (*Registry, error) {
+	r := &Registry{ks: ks, revoked: make(map[string]time.Time)}
+	if path := ks.Path(); path != "" {
+		r.lockPath = filepath.Join(filepath.Dir(path), "jwt.lock")
+	}
+
+	unlock, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if err = r.loadRevoked(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Issue signs and records a new token with the given permissions, label and optional scope,
+// expiring after the given ttl. Zero ttl means the token never expires.
+func (r *Registry) Issue(
+	signer jwt.Signer,
+	permissions []auth.Permission,
+	ttl time.Duration,
+	label string,
+	scope *perms.Scope,
+) (string, error) {
+	p, err := newPayload(permissions, ttl, label, scope)
+	if err != nil {
+		return "", err
+	}
+	token, err := signPayload(signer, p)
+	if err != nil {
+		return "", err
+	}
+
+	ti := TokenInfo{
+		ID:          p.ID,
+		Label:       label,
+		Permissions: permissions,
+		Scope:       p.Scope,
+		IssuedAt:    time.Now().UTC(),
+	}
+	if p.Expiry != 0 {
+		ti.ExpiresAt = time.Unix(p.Expiry, 0).UTC()
+	}
+
+	unlock, err := r.lock()
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+	issued, err := r.issued()
+	if err != nil {
+		return "", err
+	}
+	if err = r.store(IssuedName, append(issued, ti)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Verify returns the payload of the token signed by the passed signer, unless the token is
+// expired or revoked.
+func (r *Registry) Verify(signer jwt.Signer, token string) (*perms.JWTPayload, error) {
+	p, err := ExtractSignedPayload(signer, token)
+	if err != nil {
+		return nil, err
+	}
+	if p.ID != "" && r.IsRevoked(p.ID) {
+		return nil, ErrRevoked
+	}
+	return p, nil
+}
+
+// IsRevoked reports whether the token with the given ID is revoked.
+func (r *Registry) IsRevoked(id string) bool {
+	r.lk.RLock()
+	defer r.lk.RUnlock()
+	_, ok := r.revoked[id]
+	return ok
+}
+
+// List returns the issued tokens that are not expired yet.
+func (r *Registry) List() ([]TokenInfo, error) {
+	unlock, err := r.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	issued, err := r.issued()
+	if err != nil {
+		return nil, err
+	}
+	for i := range issued {
+		_, issued[i].Revoked = r.revoked[issued[i].ID]
+	}
+	return issued, nil
+}
+
+// Revoke adds the issued token with the given ID to the revocation list.
+func (r *Registry) Revoke(id string) error {
+	unlock, err := r.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	// the list could have been changed by another process since it was loaded
+	if err = r.loadRevoked(); err != nil {
+		return err
+	}
+	if _, ok := r.revoked[id]; ok {
+		return nil
+	}
+
+	issued, err := r.issued()
+	if err != nil {
+		return err
+	}
+	var (
+		found   bool
+		revoked = make([]TokenInfo, 0, len(r.revoked)+1)
+		now     = time.Now()
+	)
+	for _, ti := range issued {
+		if ti.ID == id {
+			revoked, found = append(revoked, ti), true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	// expired tokens are rejected anyway, so there is no need to keep them on the list
+	for rid, exp := range r.revoked {
+		ti := TokenInfo{ID: rid, ExpiresAt: exp}
+		if !ti.expired(now) {
+			revoked = append(revoked, ti)
+		}
+	}
+	if err = r.store(RevokedName, revoked); err != nil {
+		return err
+	}
+
+	r.revoked = make(map[string]time.Time, len(revoked))
+	for _, ti := range revoked {
+		r.revoked[ti.ID] = ti.ExpiresAt
+	}
+	return nil
+}
+
+// lock guards the keystore entries from the concurrent changes by both the node and other
+// processes. The returned function releases the lock.
+func (r *Registry) lock() (func(), error) {
+	r.lk.Lock()
+	if r.lockPath == "" {
+		return r.lk.Unlock, nil
+	}
+	unlockFile, err := lockFile(r.lockPath)
+	if err != nil {
+		r.lk.Unlock()
+		return nil, err
+	}
+	return func() {
+		unlockFile() //nolint:errcheck
+		r.lk.Unlock()
+	}, nil
+}
+
+// loadRevoked reads the revocation list into memory.
+func (r *Registry) loadRevoked() error {
+	var revoked []TokenInfo
+	if err := r.load(RevokedName, &revoked); err != nil {
+		return err
+	}
+	r.revoked = make(map[string]time.Time, len(revoked))
+	for _, ti := range revoked {
+		r.revoked[ti.ID] = ti.ExpiresAt
+	}
+	return nil
+}
+
+// issued reads the records of issued tokens, leaving out the expired ones.
+func (r *Registry) issued() ([]TokenInfo, error) {
+	var issued []TokenInfo
+	if err := r.load(IssuedName, &issued); err != nil {
+		return nil, err
+	}
+	now, valid := time.Now(), issued[:0]
+	for _, ti := range issued {
+		if !ti.expired(now) {
+			valid = append(valid, ti)
+		}
+	}
+	return valid, nil
+}
+
+// load reads the keystore entry, recovering it from the temporary one written by store if the
+// process crashed while swapping them.
+func (r *Registry) load(name keystore.KeyName, v interface{}) error {
+	key, err := r.ks.Get(name)
+	if errors.Is(err, keystore.ErrNotFound) {
+		key, err = r.ks.Get(tmpName(name))
+	}
+	if errors.Is(err, keystore.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(key.Body, v); err != nil {
+		return fmt.Errorf("authtoken: decoding %s: %w", name, err)
+	}
+	return nil
+}
+
+// store replaces the keystore entry. As the keystore does not allow updating existing keys, the
+// entry is written under the temporary key first, so that either of them always keeps the complete
+// list, even if the process crashes in between.
+func (r *Registry) store(name keystore.KeyName, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	tmp := tmpName(name)
+	if err = r.overwrite(tmp, body); err != nil {
+		return err
+	}
+	if err = r.overwrite(name, body); err != nil {
+		return err
+	}
+	return r.ks.Delete(tmp)
+}
+
+// overwrite puts the keystore entry, deleting the existing one.
+func (r *Registry) overwrite(name keystore.KeyName, body []byte) error {
+	_, err := r.ks.Get(name)
+	switch {
+	case err == nil:
+		if err = r.ks.Delete(name); err != nil {
+			return err
+		}
+	case !errors.Is(err, keystore.ErrNotFound):
+		return err
+	}
+	return r.ks.Put(name, keystore.PrivKey{Body: body})
+}
+
+func tmpName(name keystore.KeyName) keystore.KeyName {
+	return name + ".tmp"
+}
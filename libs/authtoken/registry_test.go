@@ -0,0 +1,144 @@
+package authtoken
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cristalhq/jwt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+)
+
+func TestRegistry(t *testing.T) {
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	ks := keystore.NewMapKeystore()
+
+	tokens, err := NewRegistry(ks)
+	require.NoError(t, err)
+
+	adminToken, err := tokens.Issue(signer, perms.AllPerms, 0, "admin", nil)
+	require.NoError(t, err)
+	readToken, err := tokens.Issue(signer, perms.ReadPerms, time.Hour, "reader", nil)
+	require.NoError(t, err)
+	// legacy tokens without ID are still accepted
+	legacyToken, err := NewSignedJWT(signer, perms.ReadWritePerms)
+	require.NoError(t, err)
+
+	p, err := tokens.Verify(signer, adminToken)
+	require.NoError(t, err)
+	require.Equal(t, perms.AllPerms, p.Allow)
+	p, err = tokens.Verify(signer, legacyToken)
+	require.NoError(t, err)
+	require.Equal(t, perms.ReadWritePerms, p.Allow)
+
+	payload, err := ExtractSignedPayload(signer, readToken)
+	require.NoError(t, err)
+	require.Equal(t, "reader", payload.Subject)
+	require.NotEmpty(t, payload.ID)
+	require.InDelta(t, time.Now().Add(time.Hour).Unix(), payload.Expiry, 1)
+
+	list, err := tokens.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, "admin", list[0].Label)
+	require.True(t, list[0].ExpiresAt.IsZero())
+	require.Equal(t, payload.ID, list[1].ID)
+	require.Equal(t, perms.ReadPerms, list[1].Permissions)
+
+	// revoke the admin token and ensure the revocation survives reload
+	require.NoError(t, tokens.Revoke(list[0].ID))
+	require.ErrorIs(t, tokens.Revoke("unknown"), ErrNotFound)
+	_, err = tokens.Verify(signer, adminToken)
+	require.ErrorIs(t, err, ErrRevoked)
+
+	tokens, err = NewRegistry(ks)
+	require.NoError(t, err)
+	_, err = tokens.Verify(signer, adminToken)
+	require.ErrorIs(t, err, ErrRevoked)
+	_, err = tokens.Verify(signer, readToken)
+	require.NoError(t, err)
+
+	list, err = tokens.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.True(t, list[0].Revoked)
+	require.False(t, list[1].Revoked)
+}
+
+func TestExtractSignedPayload_Expired(t *testing.T) {
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+
+	tokens, err := NewRegistry(keystore.NewMapKeystore())
+	require.NoError(t, err)
+
+	token, err := tokens.Issue(signer, perms.ReadPerms, time.Nanosecond, "", nil)
+	require.NoError(t, err)
+
+	_, err = ExtractSignedPermissions(signer, token)
+	require.ErrorIs(t, err, ErrExpired)
+
+	// expired tokens are not listed
+	list, err := tokens.List()
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
+
+func TestRegistry_RecoverInterruptedStore(t *testing.T) {
+	ks := keystore.NewMapKeystore()
+	tokens, err := NewRegistry(ks)
+	require.NoError(t, err)
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	token, err := tokens.Issue(signer, perms.AllPerms, 0, "", nil)
+	require.NoError(t, err)
+	list, err := tokens.List()
+	require.NoError(t, err)
+	require.NoError(t, tokens.Revoke(list[0].ID))
+
+	// simulate a crash after the entry was deleted, but before it was written again
+	key, err := ks.Get(RevokedName)
+	require.NoError(t, err)
+	require.NoError(t, ks.Delete(RevokedName))
+	require.NoError(t, ks.Put(tmpName(RevokedName), key))
+
+	tokens, err = NewRegistry(ks)
+	require.NoError(t, err)
+	_, err = tokens.Verify(signer, token)
+	require.ErrorIs(t, err, ErrRevoked)
+}
+
+func TestRegistry_SharedKeystore(t *testing.T) {
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	ks, err := keystore.NewFSKeystore(filepath.Join(t.TempDir(), "keys"), nil)
+	require.NoError(t, err)
+
+	// the node and the `auth` command issue tokens at the same time
+	const amount = 10
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		tokens, err := NewRegistry(ks)
+		require.NoError(t, err)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < amount; j++ {
+				_, err := tokens.Issue(signer, perms.ReadPerms, 0, "", nil)
+				require.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	tokens, err := NewRegistry(ks)
+	require.NoError(t, err)
+	list, err := tokens.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2*amount)
+}
@@ -0,0 +1,118 @@
+package snapshot
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+// maxRecordSize limits the size of a single key or value read out of a datastore dump.
+const maxRecordSize = 32 << 20
+
+// DumpDatastore writes all the entries of the given datastore into the writer as a sequence of
+// length-prefixed key/value pairs. Keys under any of the given prefixes are skipped.
+func DumpDatastore(ctx context.Context, ds datastore.Read, w io.Writer, skip ...datastore.Key) error {
+	results, err := ds.Query(ctx, query.Query{})
+	if err != nil {
+		return fmt.Errorf("snapshot: querying datastore: %w", err)
+	}
+	defer results.Close()
+
+	bw := bufio.NewWriter(w)
+	for res := range results.Next() {
+		if res.Error != nil {
+			return fmt.Errorf("snapshot: iterating datastore: %w", res.Error)
+		}
+		if hasPrefix(res.Key, skip) {
+			continue
+		}
+
+		if err = writeRecord(bw, []byte(res.Key)); err != nil {
+			return err
+		}
+		if err = writeRecord(bw, res.Value); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// LoadDatastore puts all the entries written by DumpDatastore into the given datastore. Keys under
+// any of the given prefixes are skipped.
+func LoadDatastore(ctx context.Context, ds datastore.Batching, r io.Reader, skip ...datastore.Key) error {
+	batch, err := ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("snapshot: creating batch: %w", err)
+	}
+
+	br := bufio.NewReader(r)
+	for {
+		key, err := readRecord(br)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		value, err := readRecord(br)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+
+		if hasPrefix(string(key), skip) {
+			continue
+		}
+		if err = batch.Put(ctx, datastore.NewKey(string(key)), value); err != nil {
+			return fmt.Errorf("snapshot: putting entry: %w", err)
+		}
+	}
+	return batch.Commit(ctx)
+}
+
+func writeRecord(w io.Writer, data []byte) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+func readRecord(r *bufio.Reader) ([]byte, error) {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if l > maxRecordSize {
+		return nil, fmt.Errorf("snapshot: malformed datastore dump: record of %d bytes exceeds %d", l, maxRecordSize)
+	}
+
+	data := make([]byte, l)
+	if _, err = io.ReadFull(r, data); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+func hasPrefix(key string, prefixes []datastore.Key) bool {
+	for _, prefix := range prefixes {
+		if key == prefix.String() || strings.HasPrefix(key, prefix.String()+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package snapshot
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// tmpSuffix is appended to files while they are being written. Files are renamed once
+// written completely, so that an interrupted snapshot can be resumed by skipping existing files.
+const tmpSuffix = ".tmp"
+
+// CopyFile copies the file under src into dir/name and returns its description. The copy is
+// skipped if the file already exists under dir/name, which allows resuming an interrupted
+// snapshot. This is only safe for files that are never modified once written, like CAR files.
+func CopyFile(src, dir, name string) (File, error) {
+	dst := filepath.Join(dir, name)
+	if _, err := os.Stat(dst); err == nil {
+		return Describe(dir, name)
+	}
+
+	return WriteFile(dir, name, func(w io.Writer) error {
+		f, err := os.Open(src)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(w, f)
+		return err
+	})
+}
+
+// WriteFile creates a file under dir/name with the data written by the given function and
+// returns its description. The file is written into a temporary file first and then renamed.
+func WriteFile(dir, name string, write func(io.Writer) error) (File, error) {
+	dst := filepath.Join(dir, name)
+	err := os.MkdirAll(filepath.Dir(dst), os.ModePerm)
+	if err != nil {
+		return File{}, err
+	}
+
+	f, err := os.OpenFile(dst+tmpSuffix, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return File{}, err
+	}
+
+	h := sha256.New()
+	cw := &countingWriter{w: io.MultiWriter(f, h)}
+	if err = write(cw); err != nil {
+		f.Close()
+		return File{}, fmt.Errorf("snapshot: writing %s: %w", name, err)
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return File{}, err
+	}
+	if err = f.Close(); err != nil {
+		return File{}, err
+	}
+	if err = os.Rename(dst+tmpSuffix, dst); err != nil {
+		return File{}, err
+	}
+
+	return File{
+		Path:   name,
+		Size:   cw.n,
+		SHA256: hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// Describe computes the description of an existing file under dir/name.
+func Describe(dir, name string) (File, error) {
+	size, sum, err := checksum(filepath.Join(dir, name))
+	if err != nil {
+		return File{}, err
+	}
+	return File{Path: name, Size: size, SHA256: sum}, nil
+}
+
+func checksum(path string) (int64, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
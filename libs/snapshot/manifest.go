@@ -0,0 +1,93 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// Version is the current version of the snapshot layout.
+	Version = 1
+
+	// ManifestName is the name of the manifest file in the snapshot directory. The manifest is
+	// written last, so its presence marks the snapshot as complete.
+	ManifestName = "manifest.json"
+)
+
+// ErrIncomplete is returned on attempt to read the manifest of a snapshot that was not finished.
+var ErrIncomplete = errors.New("snapshot: manifest not found, snapshot is incomplete")
+
+// Manifest describes the contents of a node snapshot.
+type Manifest struct {
+	Version   int       `json:"version"`
+	NodeType  string    `json:"node_type"`
+	CreatedAt time.Time `json:"created_at"`
+	// FromHeight and ToHeight define the range of headers included in the snapshot.
+	FromHeight uint64 `json:"from_height"`
+	ToHeight   uint64 `json:"to_height"`
+	// EDSCount is the amount of EDSes included in the snapshot.
+	EDSCount int `json:"eds_count"`
+	// Files lists every file of the snapshot with its checksum.
+	Files []File `json:"files"`
+}
+
+// File is a single file of the snapshot.
+type File struct {
+	// Path is relative to the snapshot directory.
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// WriteManifest writes the Manifest into the given snapshot directory.
+func WriteManifest(dir string, m *Manifest) error {
+	bin, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("snapshot: marshalling manifest: %w", err)
+	}
+	// write via temporary file to never leave a partial manifest behind
+	tmp := filepath.Join(dir, ManifestName+tmpSuffix)
+	if err = os.WriteFile(tmp, bin, 0600); err != nil {
+		return fmt.Errorf("snapshot: writing manifest: %w", err)
+	}
+	return os.Rename(tmp, filepath.Join(dir, ManifestName))
+}
+
+// ReadManifest reads the Manifest out of the given snapshot directory.
+func ReadManifest(dir string) (*Manifest, error) {
+	bin, err := os.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrIncomplete
+		}
+		return nil, fmt.Errorf("snapshot: reading manifest: %w", err)
+	}
+
+	m := new(Manifest)
+	if err = json.Unmarshal(bin, m); err != nil {
+		return nil, fmt.Errorf("snapshot: unmarshalling manifest: %w", err)
+	}
+	if m.Version != Version {
+		return nil, fmt.Errorf("snapshot: unsupported version %d, expected %d", m.Version, Version)
+	}
+	return m, nil
+}
+
+// Verify checks that every file listed in the Manifest exists in the given snapshot directory and
+// matches its checksum.
+func (m *Manifest) Verify(dir string) error {
+	for _, f := range m.Files {
+		size, sum, err := checksum(filepath.Join(dir, f.Path))
+		if err != nil {
+			return fmt.Errorf("snapshot: verifying %s: %w", f.Path, err)
+		}
+		if size != f.Size || sum != f.SHA256 {
+			return fmt.Errorf("snapshot: checksum mismatch for %s", f.Path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+
+	src := sync.MutexWrap(datastore.NewMapDatastore())
+	for _, key := range []string{"/headers/1", "/headers/2", "/das/checkpoint", "/skipped/1"} {
+		err := src.Put(ctx, datastore.NewKey(key), []byte(key))
+		require.NoError(t, err)
+	}
+	srcDir := t.TempDir()
+	carPath := filepath.Join(srcDir, "eds.car")
+	err := os.WriteFile(carPath, []byte("car file"), 0600)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	_, err = ReadManifest(dir)
+	require.ErrorIs(t, err, ErrIncomplete)
+
+	dsFile, err := WriteFile(dir, "datastore.dump", func(w io.Writer) error {
+		return DumpDatastore(ctx, src, w, datastore.NewKey("/skipped"))
+	})
+	require.NoError(t, err)
+	carFile, err := CopyFile(carPath, dir, "blocks/eds.car")
+	require.NoError(t, err)
+
+	manifest := &Manifest{
+		Version:  Version,
+		NodeType: "Full",
+		Files:    []File{dsFile, carFile},
+	}
+	err = WriteManifest(dir, manifest)
+	require.NoError(t, err)
+	got, err := ReadManifest(dir)
+	require.NoError(t, err)
+	require.NoError(t, got.Verify(dir))
+	assert.Equal(t, manifest.Files, got.Files)
+
+	f, err := os.Open(filepath.Join(dir, "datastore.dump"))
+	require.NoError(t, err)
+	defer f.Close()
+	dst := sync.MutexWrap(datastore.NewMapDatastore())
+	err = LoadDatastore(ctx, dst, f)
+	require.NoError(t, err)
+
+	results, err := dst.Query(ctx, query.Query{})
+	require.NoError(t, err)
+	entries, err := results.Rest()
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+	for _, entry := range entries {
+		assert.Equal(t, []byte(entry.Key), entry.Value)
+	}
+}
+
+func TestSnapshotCorruptedFile(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+
+	src := sync.MutexWrap(datastore.NewMapDatastore())
+	err := src.Put(ctx, datastore.NewKey("/key"), bytes.Repeat([]byte{1}, 64))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	dsFile, err := WriteFile(dir, "datastore.dump", func(w io.Writer) error {
+		return DumpDatastore(ctx, src, w)
+	})
+	require.NoError(t, err)
+	manifest := &Manifest{Version: Version, Files: []File{dsFile}}
+	require.NoError(t, manifest.Verify(dir))
+
+	// a flipped byte is caught by the checksum
+	path := filepath.Join(dir, "datastore.dump")
+	dump, err := os.ReadFile(path)
+	require.NoError(t, err)
+	dump[len(dump)-1] ^= 0xff
+	err = os.WriteFile(path, dump, 0600)
+	require.NoError(t, err)
+	require.Error(t, manifest.Verify(dir))
+
+	// a truncated dump is rejected by the loader as well
+	dst := sync.MutexWrap(datastore.NewMapDatastore())
+	err = LoadDatastore(ctx, dst, bytes.NewReader(dump[:len(dump)-8]))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestSnapshotResume(t *testing.T) {
+	srcDir := t.TempDir()
+	src := filepath.Join(srcDir, "eds.car")
+	err := os.WriteFile(src, []byte("car file"), 0600)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	// files left behind by an interrupted snapshot are overwritten
+	err = os.MkdirAll(filepath.Join(dir, "blocks"), os.ModePerm)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(dir, "blocks/eds.car"+tmpSuffix), []byte("partial"), 0600)
+	require.NoError(t, err)
+
+	file, err := CopyFile(src, dir, "blocks/eds.car")
+	require.NoError(t, err)
+	assert.EqualValues(t, len("car file"), file.Size)
+
+	// complete files are skipped on resume
+	err = os.Remove(src)
+	require.NoError(t, err)
+	resumed, err := CopyFile(src, dir, "blocks/eds.car")
+	require.NoError(t, err)
+	assert.Equal(t, file, resumed)
+}
@@ -0,0 +1,151 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+)
+
+var log = logging.Logger("tlsconfig")
+
+// reloadCheckInterval limits how often the certificate files are checked for changes.
+var reloadCheckInterval = time.Second
+
+// Config defines the TLS settings of a server.
+type Config struct {
+	// CertPath is the path to the PEM-encoded certificate chain. TLS is disabled if empty.
+	CertPath string
+	// KeyPath is the path to the PEM-encoded private key of the certificate.
+	KeyPath string
+	// ClientCAPath is the path to the PEM-encoded CAs the client certificates are verified with.
+	// Setting it enables mutual TLS, so that clients without a valid certificate are rejected.
+	ClientCAPath string
+}
+
+// Enabled reports whether TLS is configured.
+func (cfg *Config) Enabled() bool {
+	return cfg.CertPath != "" || cfg.KeyPath != "" || cfg.ClientCAPath != ""
+}
+
+// Validate ensures the Config is either empty or complete.
+func (cfg *Config) Validate() error {
+	if !cfg.Enabled() {
+		return nil
+	}
+	if cfg.CertPath == "" || cfg.KeyPath == "" {
+		return errors.New("tls: both cert and key paths must be set")
+	}
+	return nil
+}
+
+// ServerConfig loads the certificates and returns the tls.Config of a server. Certificates are
+// reloaded on the next handshake after their files change, so they can be rotated without
+// restarting the server. A failed reload is logged and the previous certificates are kept.
+func (cfg *Config) ServerConfig() (*tls.Config, error) {
+	r := &reloader{cfg: *cfg}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: r.getConfigForClient,
+	}, nil
+}
+
+// reloader keeps the certificates loaded from the files of the Config up to date.
+type reloader struct {
+	cfg Config
+
+	lk        sync.Mutex
+	checkedAt time.Time
+	modTimes  []time.Time
+	config    *tls.Config
+}
+
+func (r *reloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	if time.Since(r.checkedAt) >= reloadCheckInterval {
+		r.checkedAt = time.Now()
+		if modTimes, err := r.stat(); err != nil {
+			log.Errorw("checking certificate files", "err", err)
+		} else if !equalTimes(modTimes, r.modTimes) {
+			if err := r.loadLocked(); err != nil {
+				log.Errorw("reloading certificates, keeping the previous ones", "err", err)
+			} else {
+				log.Info("reloaded certificates")
+			}
+		}
+	}
+	return r.config, nil
+}
+
+func (r *reloader) load() error {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	r.checkedAt = time.Now()
+	return r.loadLocked()
+}
+
+func (r *reloader) loadLocked() error {
+	modTimes, err := r.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertPath, r.cfg.KeyPath)
+	if err != nil {
+		return fmt.Errorf("tls: loading key pair: %w", err)
+	}
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+	if r.cfg.ClientCAPath != "" {
+		pem, err := os.ReadFile(r.cfg.ClientCAPath)
+		if err != nil {
+			return fmt.Errorf("tls: reading client CAs: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("tls: no certificates found in %s", r.cfg.ClientCAPath)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	r.config, r.modTimes = config, modTimes
+	return nil
+}
+
+func (r *reloader) stat() ([]time.Time, error) {
+	paths := []string{r.cfg.CertPath, r.cfg.KeyPath}
+	if r.cfg.ClientCAPath != "" {
+		paths = append(paths, r.cfg.ClientCAPath)
+	}
+	modTimes := make([]time.Time, len(paths))
+	for i, path := range paths {
+		st, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("tls: %w", err)
+		}
+		modTimes[i] = st.ModTime()
+	}
+	return modTimes, nil
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
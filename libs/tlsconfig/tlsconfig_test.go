@@ -0,0 +1,109 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig/tlstest"
+)
+
+func TestServerConfig_Reload(t *testing.T) {
+	reloadCheckInterval = 0
+	t.Cleanup(func() { reloadCheckInterval = time.Second })
+
+	ca := tlstest.NewCA(t)
+	certPath, keyPath, _ := ca.WriteFiles(t, t.TempDir(), "first")
+
+	cfg := &Config{CertPath: certPath, KeyPath: keyPath}
+	require.NoError(t, cfg.Validate())
+	serverCfg, err := cfg.ServerConfig()
+	require.NoError(t, err)
+	addr := serve(t, serverCfg)
+
+	require.Equal(t, "first", serverName(t, addr, ca.ClientConfig(t, "")))
+
+	// rotate the certificate
+	certPEM, keyPEM := ca.Issue(t, "second")
+	require.NoError(t, os.WriteFile(certPath, certPEM, 0600))
+	require.NoError(t, os.WriteFile(keyPath, keyPEM, 0600))
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certPath, future, future))
+	require.Equal(t, "second", serverName(t, addr, ca.ClientConfig(t, "")))
+
+	// broken files are ignored
+	require.NoError(t, os.WriteFile(keyPath, []byte("broken"), 0600))
+	require.NoError(t, os.Chtimes(keyPath, future, future.Add(time.Minute)))
+	require.Equal(t, "second", serverName(t, addr, ca.ClientConfig(t, "")))
+}
+
+func TestServerConfig_MutualTLS(t *testing.T) {
+	ca := tlstest.NewCA(t)
+	certPath, keyPath, caPath := ca.WriteFiles(t, t.TempDir(), "server")
+
+	cfg := &Config{CertPath: certPath, KeyPath: keyPath, ClientCAPath: caPath}
+	serverCfg, err := cfg.ServerConfig()
+	require.NoError(t, err)
+	addr := serve(t, serverCfg)
+
+	require.Equal(t, "server", serverName(t, addr, ca.ClientConfig(t, "client")))
+
+	// clients without a certificate are rejected
+	conn, err := tls.Dial("tcp", addr, ca.ClientConfig(t, ""))
+	if err == nil {
+		_, err = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	require.Error(t, err)
+
+	// clients with a certificate from another CA are rejected
+	other := tlstest.NewCA(t)
+	clientCfg := other.ClientConfig(t, "client")
+	clientCfg.RootCAs = ca.Pool()
+	conn, err = tls.Dial("tcp", addr, clientCfg)
+	if err == nil {
+		_, err = conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+	require.Error(t, err)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, (&Config{}).Validate())
+	require.Error(t, (&Config{CertPath: "cert.pem"}).Validate())
+	require.Error(t, (&Config{ClientCAPath: "ca.pem"}).Validate())
+}
+
+// serve accepts TLS connections, completing the handshake and writing a byte to each.
+func serve(t *testing.T, cfg *tls.Config) string {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				_, _ = conn.Write([]byte{1})
+			}(conn)
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// serverName returns the common name of the certificate the server presents.
+func serverName(t *testing.T, addr string, cfg *tls.Config) string {
+	conn, err := tls.Dial("tcp", addr, cfg)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Read(make([]byte, 1))
+	require.NoError(t, err)
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+}
@@ -0,0 +1,47 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package blob
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "blob.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		Submit   func(ctx context.Context, blobs []*blob.Blob) (uint64, error)                                                                    `perm:"write"`
+		Get      func(ctx context.Context, height uint64, namespace share.Namespace, commitment blob.Commitment) (*blob.Blob, error)              `perm:"read"`
+		GetAll   func(ctx context.Context, height uint64, namespaces []share.Namespace) ([]*blob.Blob, error)                                     `perm:"read"`
+		GetProof func(ctx context.Context, height uint64, namespace share.Namespace, commitment blob.Commitment) (*blob.Proof, error)             `perm:"read"`
+		Included func(ctx context.Context, height uint64, namespace share.Namespace, proof *blob.Proof, commitment blob.Commitment) (bool, error) `perm:"read"`
+	}
+}
+
+func (api *API) Submit(ctx context.Context, blobs []*blob.Blob) (uint64, error) {
+	return api.Internal.Submit(ctx, blobs)
+}
+
+func (api *API) Get(ctx context.Context, height uint64, namespace share.Namespace, commitment blob.Commitment) (*blob.Blob, error) {
+	return api.Internal.Get(ctx, height, namespace, commitment)
+}
+
+func (api *API) GetAll(ctx context.Context, height uint64, namespaces []share.Namespace) ([]*blob.Blob, error) {
+	return api.Internal.GetAll(ctx, height, namespaces)
+}
+
+func (api *API) GetProof(ctx context.Context, height uint64, namespace share.Namespace, commitment blob.Commitment) (*blob.Proof, error) {
+	return api.Internal.GetProof(ctx, height, namespace, commitment)
+}
+
+func (api *API) Included(ctx context.Context, height uint64, namespace share.Namespace, proof *blob.Proof, commitment blob.Commitment) (bool, error) {
+	return api.Internal.Included(ctx, height, namespace, proof, commitment)
+}
@@ -42,7 +42,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 					fetcher *core.BlockFetcher,
 					pubsub *shrexsub.PubSub,
 					construct header.ConstructFn,
-					store *eds.Store,
+					store eds.EDSStore,
 				) *core.Listener {
 					return core.NewListener(bcast, fetcher, pubsub.Broadcast, construct, store, p2p.BlockTime)
 				},
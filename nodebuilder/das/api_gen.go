@@ -0,0 +1,31 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package das
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-node/das"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "das.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		SamplingStats func(ctx context.Context) (das.SamplingStats, error) `perm:"read"`
+		WaitCatchUp   func(ctx context.Context) error                      `perm:"read"`
+	}
+}
+
+func (api *API) SamplingStats(ctx context.Context) (das.SamplingStats, error) {
+	return api.Internal.SamplingStats(ctx)
+}
+
+func (api *API) WaitCatchUp(ctx context.Context) error {
+	return api.Internal.WaitCatchUp(ctx)
+}
@@ -0,0 +1,31 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package fraud
+
+import (
+	"context"
+
+	"github.com/celestiaorg/go-fraud"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "fraud.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		Subscribe func(ctx context.Context, proofType fraud.ProofType) (<-chan Proof, error) `perm:"public"`
+		Get       func(ctx context.Context, proofType fraud.ProofType) ([]Proof, error)      `perm:"public"`
+	}
+}
+
+func (api *API) Subscribe(ctx context.Context, proofType fraud.ProofType) (<-chan Proof, error) {
+	return api.Internal.Subscribe(ctx, proofType)
+}
+
+func (api *API) Get(ctx context.Context, proofType fraud.ProofType) ([]Proof, error) {
+	return api.Internal.Get(ctx, proofType)
+}
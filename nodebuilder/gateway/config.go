@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 )
 
 type Config struct {
-	Address             string
-	Port                string
-	Enabled             bool
+	Address string
+	Port    string
+	Enabled bool
+	// TLS enables serving the gateway over TLS, or mutual TLS if the client CA is set.
+	TLS                 tlsconfig.Config
 	deprecatedEndpoints bool
 }
 
@@ -34,5 +37,8 @@ func (cfg *Config) Validate() error {
 	if err != nil {
 		return fmt.Errorf("gateway: invalid port: %s", err.Error())
 	}
+	if err = cfg.TLS.Validate(); err != nil {
+		return fmt.Errorf("gateway: %w", err)
+	}
 	return nil
 }
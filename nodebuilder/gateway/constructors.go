@@ -22,6 +22,16 @@ func Handler(
 	handler.RegisterMiddleware(serv)
 }
 
-func server(cfg *Config) *gateway.Server {
-	return gateway.NewServer(cfg.Address, cfg.Port)
+func server(cfg *Config) (*gateway.Server, error) {
+	srv := gateway.NewServer(cfg.Address, cfg.Port)
+	if !cfg.TLS.Enabled() {
+		return srv, nil
+	}
+
+	tlsCfg, err := cfg.TLS.ServerConfig()
+	if err != nil {
+		return nil, err
+	}
+	srv.EnableTLS(tlsCfg)
+	return srv, nil
 }
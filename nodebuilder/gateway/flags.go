@@ -10,6 +10,9 @@ var (
 	addrFlag            = "gateway.addr"
 	portFlag            = "gateway.port"
 	deprecatedEndpoints = "gateway.deprecated-endpoints"
+	tlsCertFlag         = "gateway.tls.cert"
+	tlsKeyFlag          = "gateway.tls.key"
+	tlsClientCAFlag     = "gateway.tls.client-ca"
 )
 
 // Flags gives a set of hardcoded node/gateway package flags.
@@ -36,6 +39,21 @@ func Flags() *flag.FlagSet {
 		"",
 		"Set a custom gateway port (default: 26659)",
 	)
+	flags.String(
+		tlsCertFlag,
+		"",
+		"Path to the PEM-encoded TLS certificate. Enables TLS for the gateway. Reloaded on change.",
+	)
+	flags.String(
+		tlsKeyFlag,
+		"",
+		"Path to the PEM-encoded TLS key of the certificate. Reloaded on change.",
+	)
+	flags.String(
+		tlsClientCAFlag,
+		"",
+		"Path to the PEM-encoded CAs verifying client certificates. Enables mutual TLS for the gateway.",
+	)
 
 	return flags
 }
@@ -62,4 +80,13 @@ func ParseFlags(cmd *cobra.Command, cfg *Config) {
 	if portVal != "" {
 		cfg.Port = portVal
 	}
+	if cert := cmd.Flag(tlsCertFlag).Value.String(); cert != "" {
+		cfg.TLS.CertPath = cert
+	}
+	if key := cmd.Flag(tlsKeyFlag).Value.String(); key != "" {
+		cfg.TLS.KeyPath = key
+	}
+	if ca := cmd.Flag(tlsClientCAFlag).Value.String(); ca != "" {
+		cfg.TLS.ClientCAPath = ca
+	}
 }
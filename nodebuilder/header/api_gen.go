@@ -0,0 +1,69 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package header
+
+import (
+	"context"
+
+	libhead "github.com/celestiaorg/go-header"
+	"github.com/celestiaorg/go-header/sync"
+
+	"github.com/celestiaorg/celestia-node/header"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "header.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		LocalHead                func(ctx context.Context) (*header.ExtendedHeader, error)                                           `perm:"read"`
+		GetByHash                func(ctx context.Context, hash libhead.Hash) (*header.ExtendedHeader, error)                        `perm:"public"`
+		GetVerifiedRangeByHeight func(ctx context.Context, from *header.ExtendedHeader, to uint64) ([]*header.ExtendedHeader, error) `perm:"public"`
+		GetByHeight              func(ctx context.Context, height uint64) (*header.ExtendedHeader, error)                            `perm:"public"`
+		WaitForHeight            func(ctx context.Context, height uint64) (*header.ExtendedHeader, error)                            `perm:"read"`
+		SyncState                func(ctx context.Context) (sync.State, error)                                                       `perm:"read"`
+		SyncWait                 func(ctx context.Context) error                                                                     `perm:"read"`
+		NetworkHead              func(ctx context.Context) (*header.ExtendedHeader, error)                                           `perm:"public"`
+		Subscribe                func(ctx context.Context) (<-chan *header.ExtendedHeader, error)                                    `perm:"public"`
+	}
+}
+
+func (api *API) LocalHead(ctx context.Context) (*header.ExtendedHeader, error) {
+	return api.Internal.LocalHead(ctx)
+}
+
+func (api *API) GetByHash(ctx context.Context, hash libhead.Hash) (*header.ExtendedHeader, error) {
+	return api.Internal.GetByHash(ctx, hash)
+}
+
+func (api *API) GetVerifiedRangeByHeight(ctx context.Context, from *header.ExtendedHeader, to uint64) ([]*header.ExtendedHeader, error) {
+	return api.Internal.GetVerifiedRangeByHeight(ctx, from, to)
+}
+
+func (api *API) GetByHeight(ctx context.Context, height uint64) (*header.ExtendedHeader, error) {
+	return api.Internal.GetByHeight(ctx, height)
+}
+
+func (api *API) WaitForHeight(ctx context.Context, height uint64) (*header.ExtendedHeader, error) {
+	return api.Internal.WaitForHeight(ctx, height)
+}
+
+func (api *API) SyncState(ctx context.Context) (sync.State, error) {
+	return api.Internal.SyncState(ctx)
+}
+
+func (api *API) SyncWait(ctx context.Context) error {
+	return api.Internal.SyncWait(ctx)
+}
+
+func (api *API) NetworkHead(ctx context.Context) (*header.ExtendedHeader, error) {
+	return api.Internal.NetworkHead(ctx)
+}
+
+func (api *API) Subscribe(ctx context.Context) (<-chan *header.ExtendedHeader, error) {
+	return api.Internal.Subscribe(ctx)
+}
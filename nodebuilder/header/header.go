@@ -10,15 +10,16 @@ import (
 )
 
 // Module exposes the functionality needed for querying headers from the network.
-// Any method signature changed here needs to also be changed in the API struct.
+// The API struct is generated from it by `go generate ./api`.
 //
+//version:1
 //go:generate mockgen -destination=mocks/api.go -package=mocks . Module
 type Module interface {
 	// LocalHead returns the ExtendedHeader of the chain head.
-	LocalHead(context.Context) (*header.ExtendedHeader, error)
+	LocalHead(context.Context) (*header.ExtendedHeader, error) //perm:read
 
 	// GetByHash returns the header of the given hash from the node's header store.
-	GetByHash(ctx context.Context, hash libhead.Hash) (*header.ExtendedHeader, error)
+	GetByHash(ctx context.Context, hash libhead.Hash) (*header.ExtendedHeader, error) //perm:public
 	// GetVerifiedRangeByHeight returns the given range [from:to) of ExtendedHeaders
 	// from the node's header store and verifies that the returned headers are
 	// adjacent to each other.
@@ -26,84 +27,21 @@ type Module interface {
 		ctx context.Context,
 		from *header.ExtendedHeader,
 		to uint64,
-	) ([]*header.ExtendedHeader, error)
+	) ([]*header.ExtendedHeader, error) //perm:public
 	// GetByHeight returns the ExtendedHeader at the given height if it is
 	// currently available.
-	GetByHeight(context.Context, uint64) (*header.ExtendedHeader, error)
+	GetByHeight(ctx context.Context, height uint64) (*header.ExtendedHeader, error) //perm:public
 	// WaitForHeight blocks until the header at the given height has been processed
 	// by the store or context deadline is exceeded.
-	WaitForHeight(context.Context, uint64) (*header.ExtendedHeader, error)
+	WaitForHeight(ctx context.Context, height uint64) (*header.ExtendedHeader, error) //perm:read
 
 	// SyncState returns the current state of the header Syncer.
-	SyncState(context.Context) (sync.State, error)
+	SyncState(context.Context) (sync.State, error) //perm:read
 	// SyncWait blocks until the header Syncer is synced to network head.
-	SyncWait(ctx context.Context) error
+	SyncWait(ctx context.Context) error //perm:read
 	// NetworkHead provides the Syncer's view of the current network head.
-	NetworkHead(ctx context.Context) (*header.ExtendedHeader, error)
+	NetworkHead(ctx context.Context) (*header.ExtendedHeader, error) //perm:public
 
 	// Subscribe to recent ExtendedHeaders from the network.
-	Subscribe(ctx context.Context) (<-chan *header.ExtendedHeader, error)
-}
-
-// API is a wrapper around Module for the RPC.
-// TODO(@distractedm1nd): These structs need to be autogenerated.
-type API struct {
-	Internal struct {
-		LocalHead func(context.Context) (*header.ExtendedHeader, error) `perm:"read"`
-		GetByHash func(
-			ctx context.Context,
-			hash libhead.Hash,
-		) (*header.ExtendedHeader, error) `perm:"public"`
-		GetVerifiedRangeByHeight func(
-			context.Context,
-			*header.ExtendedHeader,
-			uint64,
-		) ([]*header.ExtendedHeader, error) `perm:"public"`
-		GetByHeight   func(context.Context, uint64) (*header.ExtendedHeader, error)    `perm:"public"`
-		WaitForHeight func(context.Context, uint64) (*header.ExtendedHeader, error)    `perm:"read"`
-		SyncState     func(ctx context.Context) (sync.State, error)                    `perm:"read"`
-		SyncWait      func(ctx context.Context) error                                  `perm:"read"`
-		NetworkHead   func(ctx context.Context) (*header.ExtendedHeader, error)        `perm:"public"`
-		Subscribe     func(ctx context.Context) (<-chan *header.ExtendedHeader, error) `perm:"public"`
-	}
-}
-
-func (api *API) GetByHash(ctx context.Context, hash libhead.Hash) (*header.ExtendedHeader, error) {
-	return api.Internal.GetByHash(ctx, hash)
-}
-
-func (api *API) GetVerifiedRangeByHeight(
-	ctx context.Context,
-	from *header.ExtendedHeader,
-	to uint64,
-) ([]*header.ExtendedHeader, error) {
-	return api.Internal.GetVerifiedRangeByHeight(ctx, from, to)
-}
-
-func (api *API) GetByHeight(ctx context.Context, u uint64) (*header.ExtendedHeader, error) {
-	return api.Internal.GetByHeight(ctx, u)
-}
-
-func (api *API) WaitForHeight(ctx context.Context, u uint64) (*header.ExtendedHeader, error) {
-	return api.Internal.WaitForHeight(ctx, u)
-}
-
-func (api *API) LocalHead(ctx context.Context) (*header.ExtendedHeader, error) {
-	return api.Internal.LocalHead(ctx)
-}
-
-func (api *API) SyncState(ctx context.Context) (sync.State, error) {
-	return api.Internal.SyncState(ctx)
-}
-
-func (api *API) SyncWait(ctx context.Context) error {
-	return api.Internal.SyncWait(ctx)
-}
-
-func (api *API) NetworkHead(ctx context.Context) (*header.ExtendedHeader, error) {
-	return api.Internal.NetworkHead(ctx)
-}
-
-func (api *API) Subscribe(ctx context.Context) (<-chan *header.ExtendedHeader, error) {
-	return api.Internal.Subscribe(ctx)
+	Subscribe(ctx context.Context) (<-chan *header.ExtendedHeader, error) //perm:public
 }
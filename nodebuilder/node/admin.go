@@ -2,25 +2,43 @@ package node
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/cristalhq/jwt"
 	"github.com/filecoin-project/go-jsonrpc/auth"
 	logging "github.com/ipfs/go-log/v2"
 
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
 	"github.com/celestiaorg/celestia-node/libs/authtoken"
 )
 
+// APIVersion is the version of the API as a whole, reported by Info. The compatibility of clients
+// is negotiated with the versions of the individual modules, see ModuleVersion.
 const APIVersion = "v0.2.1"
 
 type module struct {
-	tp     Type
-	signer jwt.Signer
+	tp       Type
+	signer   jwt.Signer
+	tokens   *authtoken.Registry
+	snapshot snapshotter
+	audit    audit.Log
 }
 
-func newModule(tp Type, signer jwt.Signer) Module {
+func newModule(
+	tp Type,
+	signer jwt.Signer,
+	tokens *authtoken.Registry,
+	snapshot snapshotter,
+	auditLog audit.Log,
+) Module {
 	return &module{
-		tp:     tp,
-		signer: signer,
+		tp:       tp,
+		signer:   signer,
+		tokens:   tokens,
+		snapshot: snapshot,
+		audit:    auditLog,
 	}
 }
 
@@ -43,9 +61,53 @@ func (m *module) LogLevelSet(_ context.Context, name, level string) error {
 }
 
 func (m *module) AuthVerify(_ context.Context, token string) ([]auth.Permission, error) {
-	return authtoken.ExtractSignedPermissions(m.signer, token)
+	p, err := m.tokens.Verify(m.signer, token)
+	if err != nil {
+		return nil, err
+	}
+	return p.Allow, nil
+}
+
+func (m *module) AuthNew(ctx context.Context, permissions []auth.Permission) (string, error) {
+	if err := checkCanIssue(ctx); err != nil {
+		return "", err
+	}
+	return m.tokens.Issue(m.signer, permissions, 0, "", nil)
 }
 
-func (m *module) AuthNew(_ context.Context, permissions []auth.Permission) (string, error) {
-	return authtoken.NewSignedJWT(m.signer, permissions)
+func (m *module) AuthIssue(
+	ctx context.Context,
+	permissions []auth.Permission,
+	ttl time.Duration,
+	label string,
+	scope *perms.Scope,
+) (string, error) {
+	if err := checkCanIssue(ctx); err != nil {
+		return "", err
+	}
+	return m.tokens.Issue(m.signer, permissions, ttl, label, scope)
+}
+
+// checkCanIssue denies issuing tokens to callers with a scoped token, as the issued tokens could
+// escape the scope.
+func checkCanIssue(ctx context.Context) error {
+	if scope := perms.ScopeFromContext(ctx); !scope.IsEmpty() {
+		return fmt.Errorf("%w: scoped tokens cannot issue tokens", perms.ErrOutOfScope)
+	}
+	return nil
+}
+
+func (m *module) AuthList(context.Context) ([]authtoken.TokenInfo, error) {
+	return m.tokens.List()
+}
+
+func (m *module) AuthRevoke(_ context.Context, id string) error {
+	return m.tokens.Revoke(id)
+}
+
+func (m *module) AuditQuery(ctx context.Context, query audit.Query) ([]*audit.Entry, error) {
+	if m.audit == nil {
+		return nil, audit.ErrDisabled
+	}
+	return m.audit.Query(ctx, query)
 }
@@ -0,0 +1,32 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cristalhq/jwt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
+	"github.com/celestiaorg/celestia-node/libs/keystore"
+)
+
+func TestModule_AuthIssue_Scoped(t *testing.T) {
+	signer, err := jwt.NewHS256(make([]byte, 32))
+	require.NoError(t, err)
+	tokens, err := authtoken.NewRegistry(keystore.NewMapKeystore())
+	require.NoError(t, err)
+	m := newModule(Full, signer, tokens, snapshotter{}, nil)
+
+	ctx := context.Background()
+	_, err = m.AuthNew(ctx, perms.AllPerms)
+	require.NoError(t, err)
+
+	// tokens issued by scoped callers could escape the scope
+	scoped := perms.WithScope(ctx, &perms.Scope{Methods: []string{"node.*"}})
+	_, err = m.AuthNew(scoped, perms.AllPerms)
+	require.ErrorIs(t, err, perms.ErrOutOfScope)
+	_, err = m.AuthIssue(scoped, perms.ReadPerms, 0, "", &perms.Scope{Methods: []string{"node.Info"}})
+	require.ErrorIs(t, err, perms.ErrOutOfScope)
+}
@@ -0,0 +1,72 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
+	"github.com/celestiaorg/celestia-node/libs/snapshot"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "node.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		Info           func(ctx context.Context) (Info, error)                                                                                       `perm:"admin"`
+		LogLevelSet    func(ctx context.Context, name string, level string) error                                                                    `perm:"admin"`
+		AuthVerify     func(ctx context.Context, token string) ([]auth.Permission, error)                                                            `perm:"admin"`
+		AuthNew        func(ctx context.Context, perms []auth.Permission) (string, error)                                                            `perm:"admin"`
+		AuthIssue      func(ctx context.Context, permissions []auth.Permission, ttl time.Duration, label string, scope *perms.Scope) (string, error) `perm:"admin"`
+		AuthList       func(ctx context.Context) ([]authtoken.TokenInfo, error)                                                                      `perm:"admin"`
+		AuthRevoke     func(ctx context.Context, id string) error                                                                                    `perm:"admin"`
+		AuditQuery     func(ctx context.Context, query audit.Query) ([]*audit.Entry, error)                                                          `perm:"admin"`
+		SnapshotCreate func(ctx context.Context, path string) (*snapshot.Manifest, error)                                                            `perm:"admin"`
+	}
+}
+
+func (api *API) Info(ctx context.Context) (Info, error) {
+	return api.Internal.Info(ctx)
+}
+
+func (api *API) LogLevelSet(ctx context.Context, name string, level string) error {
+	return api.Internal.LogLevelSet(ctx, name, level)
+}
+
+func (api *API) AuthVerify(ctx context.Context, token string) ([]auth.Permission, error) {
+	return api.Internal.AuthVerify(ctx, token)
+}
+
+func (api *API) AuthNew(ctx context.Context, perms []auth.Permission) (string, error) {
+	return api.Internal.AuthNew(ctx, perms)
+}
+
+func (api *API) AuthIssue(ctx context.Context, permissions []auth.Permission, ttl time.Duration, label string, scope *perms.Scope) (string, error) {
+	return api.Internal.AuthIssue(ctx, permissions, ttl, label, scope)
+}
+
+func (api *API) AuthList(ctx context.Context) ([]authtoken.TokenInfo, error) {
+	return api.Internal.AuthList(ctx)
+}
+
+func (api *API) AuthRevoke(ctx context.Context, id string) error {
+	return api.Internal.AuthRevoke(ctx, id)
+}
+
+func (api *API) AuditQuery(ctx context.Context, query audit.Query) ([]*audit.Entry, error) {
+	return api.Internal.AuditQuery(ctx, query)
+}
+
+func (api *API) SnapshotCreate(ctx context.Context, path string) (*snapshot.Manifest, error) {
+	return api.Internal.SnapshotCreate(ctx, path)
+}
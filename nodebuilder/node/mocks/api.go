@@ -7,10 +7,15 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	auth "github.com/filecoin-project/go-jsonrpc/auth"
 	gomock "github.com/golang/mock/gomock"
 
+	audit "github.com/celestiaorg/celestia-node/api/rpc/audit"
+	perms "github.com/celestiaorg/celestia-node/api/rpc/perms"
+	authtoken "github.com/celestiaorg/celestia-node/libs/authtoken"
+	snapshot "github.com/celestiaorg/celestia-node/libs/snapshot"
 	node "github.com/celestiaorg/celestia-node/nodebuilder/node"
 )
 
@@ -37,6 +42,51 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 	return m.recorder
 }
 
+// AuditQuery mocks base method.
+func (m *MockModule) AuditQuery(arg0 context.Context, arg1 audit.Query) ([]*audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditQuery", arg0, arg1)
+	ret0, _ := ret[0].([]*audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditQuery indicates an expected call of AuditQuery.
+func (mr *MockModuleMockRecorder) AuditQuery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditQuery", reflect.TypeOf((*MockModule)(nil).AuditQuery), arg0, arg1)
+}
+
+// AuthIssue mocks base method.
+func (m *MockModule) AuthIssue(arg0 context.Context, arg1 []auth.Permission, arg2 time.Duration, arg3 string, arg4 *perms.Scope) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthIssue", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthIssue indicates an expected call of AuthIssue.
+func (mr *MockModuleMockRecorder) AuthIssue(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthIssue", reflect.TypeOf((*MockModule)(nil).AuthIssue), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AuthList mocks base method.
+func (m *MockModule) AuthList(arg0 context.Context) ([]authtoken.TokenInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthList", arg0)
+	ret0, _ := ret[0].([]authtoken.TokenInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthList indicates an expected call of AuthList.
+func (mr *MockModuleMockRecorder) AuthList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthList", reflect.TypeOf((*MockModule)(nil).AuthList), arg0)
+}
+
 // AuthNew mocks base method.
 func (m *MockModule) AuthNew(arg0 context.Context, arg1 []auth.Permission) (string, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +102,20 @@ func (mr *MockModuleMockRecorder) AuthNew(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthNew", reflect.TypeOf((*MockModule)(nil).AuthNew), arg0, arg1)
 }
 
+// AuthRevoke mocks base method.
+func (m *MockModule) AuthRevoke(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRevoke", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthRevoke indicates an expected call of AuthRevoke.
+func (mr *MockModuleMockRecorder) AuthRevoke(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRevoke", reflect.TypeOf((*MockModule)(nil).AuthRevoke), arg0, arg1)
+}
+
 // AuthVerify mocks base method.
 func (m *MockModule) AuthVerify(arg0 context.Context, arg1 string) ([]auth.Permission, error) {
 	m.ctrl.T.Helper()
@@ -95,3 +159,18 @@ func (mr *MockModuleMockRecorder) LogLevelSet(arg0, arg1, arg2 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLevelSet", reflect.TypeOf((*MockModule)(nil).LogLevelSet), arg0, arg1, arg2)
 }
+
+// SnapshotCreate mocks base method.
+func (m *MockModule) SnapshotCreate(arg0 context.Context, arg1 string) (*snapshot.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotCreate", arg0, arg1)
+	ret0, _ := ret[0].(*snapshot.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotCreate indicates an expected call of SnapshotCreate.
+func (mr *MockModuleMockRecorder) SnapshotCreate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotCreate", reflect.TypeOf((*MockModule)(nil).SnapshotCreate), arg0, arg1)
+}
@@ -2,15 +2,47 @@ package node
 
 import (
 	"github.com/cristalhq/jwt"
+	"github.com/ipfs/go-datastore"
 	"go.uber.org/fx"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
+	"github.com/celestiaorg/celestia-node/share/eds"
 )
 
 func ConstructModule(tp Type) fx.Option {
 	return fx.Module(
 		"node",
-		fx.Provide(func(secret jwt.Signer) Module {
-			return newModule(tp, secret)
+		fx.Provide(func(
+			secret jwt.Signer,
+			tokens *authtoken.Registry,
+			snapshot snapshotter,
+			auditLog audit.Log,
+		) Module {
+			return newModule(tp, secret, tokens, snapshot, auditLog)
 		}),
 		fx.Provide(secret),
+		fx.Provide(authtoken.NewRegistry),
+		fx.Provide(newSnapshotter),
 	)
 }
+
+type snapshotterParams struct {
+	fx.In
+
+	Datastore   datastore.Batching
+	HeaderStore libhead.Store[*header.ExtendedHeader]
+	// Store is only provided on bridge and full nodes.
+	Store eds.EDSStore `optional:"true"`
+}
+
+func newSnapshotter(params snapshotterParams) snapshotter {
+	return snapshotter{
+		ds:     params.Datastore,
+		hstore: params.HeaderStore,
+		store:  params.Store,
+	}
+}
@@ -2,50 +2,53 @@ package node
 
 import (
 	"context"
+	"time"
 
 	"github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
+	"github.com/celestiaorg/celestia-node/libs/snapshot"
 )
 
 // Module defines the API related to interacting with the "administrative"
 // node.
 //
+//version:1
 //go:generate mockgen -destination=mocks/api.go -package=mocks . Module
 type Module interface {
 	// Info returns administrative information about the node.
-	Info(context.Context) (Info, error)
+	Info(context.Context) (Info, error) //perm:admin
 
 	// LogLevelSet sets the given component log level to the given level.
-	LogLevelSet(ctx context.Context, name, level string) error
+	LogLevelSet(ctx context.Context, name, level string) error //perm:admin
 
 	// AuthVerify returns the permissions assigned to the given token.
-	AuthVerify(ctx context.Context, token string) ([]auth.Permission, error)
+	AuthVerify(ctx context.Context, token string) ([]auth.Permission, error) //perm:admin
 	// AuthNew signs and returns a new token with the given permissions.
-	AuthNew(ctx context.Context, perms []auth.Permission) (string, error)
-}
-
-var _ Module = (*API)(nil)
-
-type API struct {
-	Internal struct {
-		Info        func(context.Context) (Info, error)                                `perm:"admin"`
-		LogLevelSet func(ctx context.Context, name, level string) error                `perm:"admin"`
-		AuthVerify  func(ctx context.Context, token string) ([]auth.Permission, error) `perm:"admin"`
-		AuthNew     func(ctx context.Context, perms []auth.Permission) (string, error) `perm:"admin"`
-	}
-}
-
-func (api *API) Info(ctx context.Context) (Info, error) {
-	return api.Internal.Info(ctx)
-}
-
-func (api *API) LogLevelSet(ctx context.Context, name, level string) error {
-	return api.Internal.LogLevelSet(ctx, name, level)
-}
-
-func (api *API) AuthVerify(ctx context.Context, token string) ([]auth.Permission, error) {
-	return api.Internal.AuthVerify(ctx, token)
-}
-
-func (api *API) AuthNew(ctx context.Context, perms []auth.Permission) (string, error) {
-	return api.Internal.AuthNew(ctx, perms)
+	AuthNew(ctx context.Context, perms []auth.Permission) (string, error) //perm:admin
+	// AuthIssue signs and returns a new token with the given permissions, label and optional scope,
+	// which expires after the given ttl. Zero ttl means the token never expires.
+	// Callers with a scoped token cannot issue tokens.
+	AuthIssue(
+		ctx context.Context,
+		permissions []auth.Permission,
+		ttl time.Duration,
+		label string,
+		scope *perms.Scope,
+	) (string, error) //perm:admin
+	// AuthList lists the unexpired tokens issued by the node.
+	AuthList(ctx context.Context) ([]authtoken.TokenInfo, error) //perm:admin
+	// AuthRevoke revokes the issued token with the given ID.
+	AuthRevoke(ctx context.Context, id string) error //perm:admin
+
+	// AuditQuery returns the most recent RPC audit log entries matching the query in chronological
+	// order.
+	AuditQuery(ctx context.Context, query audit.Query) ([]*audit.Entry, error) //perm:admin
+
+	// SnapshotCreate writes a snapshot of the node's EDS store, header store and DAS checkpoint
+	// into the given directory on the node's machine. An incomplete snapshot in the directory is
+	// resumed.
+	SnapshotCreate(ctx context.Context, path string) (*snapshot.Manifest, error) //perm:admin
 }
@@ -0,0 +1,132 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/filecoin-project/dagstore"
+	"github.com/ipfs/go-datastore"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/mitchellh/go-homedir"
+
+	libhead "github.com/celestiaorg/go-header"
+
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/libs/snapshot"
+	"github.com/celestiaorg/celestia-node/share/eds"
+)
+
+// DatastoreDumpName is the name of the node datastore dump in a snapshot directory.
+const DatastoreDumpName = "datastore.dump"
+
+var log = logging.Logger("module/node")
+
+// snapshotter captures the node's data into snapshots.
+type snapshotter struct {
+	ds     datastore.Batching
+	hstore libhead.Store[*header.ExtendedHeader]
+	// store is only available on bridge and full nodes.
+	store eds.EDSStore
+}
+
+func (m *module) SnapshotCreate(ctx context.Context, path string) (*snapshot.Manifest, error) {
+	var store *eds.Store
+	if m.snapshot.store != nil {
+		var ok bool
+		store, ok = m.snapshot.store.(*eds.Store)
+		if !ok {
+			return nil, errors.New("snapshot: only supported by the dagstore EDS store backend")
+		}
+	}
+
+	dir, err := homedir.Expand(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	_, err = snapshot.ReadManifest(dir)
+	switch {
+	case err == nil:
+		return nil, fmt.Errorf("snapshot: %s already contains a complete snapshot", dir)
+	case !errors.Is(err, snapshot.ErrIncomplete):
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	manifest := &snapshot.Manifest{
+		Version:   snapshot.Version,
+		NodeType:  m.tp.String(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	// the datastore with the headers and the DAS checkpoint is dumped behind the Barrier of the EDS
+	// store, so that the DAS checkpoint never claims heights whose EDSes are not in the snapshot.
+	// Shard states are skipped as they are rebuilt for the restored CAR files.
+	capture := func() error {
+		manifest.FromHeight = tailHeight(ctx, m.snapshot.hstore)
+		manifest.ToHeight = m.snapshot.hstore.Height()
+		dsFile, err := snapshot.WriteFile(dir, DatastoreDumpName, func(w io.Writer) error {
+			return m.snapshot.dumpDatastore(ctx, w)
+		})
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, dsFile)
+		return nil
+	}
+
+	if store == nil {
+		if err = capture(); err != nil {
+			return nil, err
+		}
+	} else {
+		hashes, files, err := store.Snapshot(ctx, dir, capture)
+		if err != nil {
+			return nil, err
+		}
+		manifest.EDSCount = len(hashes)
+		manifest.Files = append(manifest.Files, files...)
+	}
+
+	sort.Slice(manifest.Files, func(i, j int) bool {
+		return manifest.Files[i].Path < manifest.Files[j].Path
+	})
+	if err = snapshot.WriteManifest(dir, manifest); err != nil {
+		return nil, err
+	}
+	log.Infow("created snapshot", "path", dir, "from", manifest.FromHeight, "to", manifest.ToHeight,
+		"eds", manifest.EDSCount)
+	return manifest, nil
+}
+
+// dumpDatastore dumps the node datastore out of a read-only transaction, if supported, so that
+// the dump is a consistent view of the datastore while the node keeps writing to it.
+func (s snapshotter) dumpDatastore(ctx context.Context, w io.Writer) error {
+	var r datastore.Read = s.ds
+	if txnDs, ok := s.ds.(datastore.TxnDatastore); ok {
+		txn, err := txnDs.NewTransaction(ctx, true)
+		if err != nil {
+			return fmt.Errorf("snapshot: opening datastore transaction: %w", err)
+		}
+		defer txn.Discard(ctx)
+		r = txn
+	}
+	return snapshot.DumpDatastore(ctx, r, w, dagstore.StoreNamespace)
+}
+
+// tailHeight finds the lowest height kept by the header store. Headers are stored contiguously up
+// to the head, so the lowest height can be found with a binary search.
+func tailHeight(ctx context.Context, hstore libhead.Store[*header.ExtendedHeader]) uint64 {
+	head := hstore.Height()
+	idx := sort.Search(int(head), func(i int) bool {
+		return hstore.HasAt(ctx, uint64(i+1))
+	})
+	return uint64(idx + 1)
+}
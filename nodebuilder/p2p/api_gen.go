@@ -0,0 +1,132 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package p2p
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+
+	"github.com/celestiaorg/celestia-node/share/p2p/peers"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "p2p.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		Info                  func(ctx context.Context) (peer.AddrInfo, error)                     `perm:"admin"`
+		Peers                 func(ctx context.Context) ([]peer.ID, error)                         `perm:"admin"`
+		PeerInfo              func(ctx context.Context, id peer.ID) (peer.AddrInfo, error)         `perm:"admin"`
+		Connect               func(ctx context.Context, pi peer.AddrInfo) error                    `perm:"admin"`
+		ClosePeer             func(ctx context.Context, id peer.ID) error                          `perm:"admin"`
+		Connectedness         func(ctx context.Context, id peer.ID) (network.Connectedness, error) `perm:"admin"`
+		NATStatus             func(ctx context.Context) (network.Reachability, error)              `perm:"admin"`
+		BlockPeer             func(ctx context.Context, p peer.ID) error                           `perm:"admin"`
+		UnblockPeer           func(ctx context.Context, p peer.ID) error                           `perm:"admin"`
+		ListBlockedPeers      func(ctx context.Context) ([]peer.ID, error)                         `perm:"admin"`
+		ListBlacklistedPeers  func(ctx context.Context) ([]peers.BlacklistEntry, error)            `perm:"admin"`
+		RemoveBlacklistedPeer func(ctx context.Context, id peer.ID) error                          `perm:"admin"`
+		Protect               func(ctx context.Context, id peer.ID, tag string) error              `perm:"admin"`
+		Unprotect             func(ctx context.Context, id peer.ID, tag string) (bool, error)      `perm:"admin"`
+		IsProtected           func(ctx context.Context, id peer.ID, tag string) (bool, error)      `perm:"admin"`
+		BandwidthStats        func(ctx context.Context) (metrics.Stats, error)                     `perm:"admin"`
+		BandwidthForPeer      func(ctx context.Context, id peer.ID) (metrics.Stats, error)         `perm:"admin"`
+		BandwidthForProtocol  func(ctx context.Context, proto protocol.ID) (metrics.Stats, error)  `perm:"admin"`
+		ResourceState         func(ctx context.Context) (rcmgr.ResourceManagerStat, error)         `perm:"admin"`
+		PubSubPeers           func(ctx context.Context, topic string) ([]peer.ID, error)           `perm:"admin"`
+		PeerScores            func(ctx context.Context) ([]peers.PeerScore, error)                 `perm:"admin"`
+	}
+}
+
+func (api *API) Info(ctx context.Context) (peer.AddrInfo, error) {
+	return api.Internal.Info(ctx)
+}
+
+func (api *API) Peers(ctx context.Context) ([]peer.ID, error) {
+	return api.Internal.Peers(ctx)
+}
+
+func (api *API) PeerInfo(ctx context.Context, id peer.ID) (peer.AddrInfo, error) {
+	return api.Internal.PeerInfo(ctx, id)
+}
+
+func (api *API) Connect(ctx context.Context, pi peer.AddrInfo) error {
+	return api.Internal.Connect(ctx, pi)
+}
+
+func (api *API) ClosePeer(ctx context.Context, id peer.ID) error {
+	return api.Internal.ClosePeer(ctx, id)
+}
+
+func (api *API) Connectedness(ctx context.Context, id peer.ID) (network.Connectedness, error) {
+	return api.Internal.Connectedness(ctx, id)
+}
+
+func (api *API) NATStatus(ctx context.Context) (network.Reachability, error) {
+	return api.Internal.NATStatus(ctx)
+}
+
+func (api *API) BlockPeer(ctx context.Context, p peer.ID) error {
+	return api.Internal.BlockPeer(ctx, p)
+}
+
+func (api *API) UnblockPeer(ctx context.Context, p peer.ID) error {
+	return api.Internal.UnblockPeer(ctx, p)
+}
+
+func (api *API) ListBlockedPeers(ctx context.Context) ([]peer.ID, error) {
+	return api.Internal.ListBlockedPeers(ctx)
+}
+
+func (api *API) ListBlacklistedPeers(ctx context.Context) ([]peers.BlacklistEntry, error) {
+	return api.Internal.ListBlacklistedPeers(ctx)
+}
+
+func (api *API) RemoveBlacklistedPeer(ctx context.Context, id peer.ID) error {
+	return api.Internal.RemoveBlacklistedPeer(ctx, id)
+}
+
+func (api *API) Protect(ctx context.Context, id peer.ID, tag string) error {
+	return api.Internal.Protect(ctx, id, tag)
+}
+
+func (api *API) Unprotect(ctx context.Context, id peer.ID, tag string) (bool, error) {
+	return api.Internal.Unprotect(ctx, id, tag)
+}
+
+func (api *API) IsProtected(ctx context.Context, id peer.ID, tag string) (bool, error) {
+	return api.Internal.IsProtected(ctx, id, tag)
+}
+
+func (api *API) BandwidthStats(ctx context.Context) (metrics.Stats, error) {
+	return api.Internal.BandwidthStats(ctx)
+}
+
+func (api *API) BandwidthForPeer(ctx context.Context, id peer.ID) (metrics.Stats, error) {
+	return api.Internal.BandwidthForPeer(ctx, id)
+}
+
+func (api *API) BandwidthForProtocol(ctx context.Context, proto protocol.ID) (metrics.Stats, error) {
+	return api.Internal.BandwidthForProtocol(ctx, proto)
+}
+
+func (api *API) ResourceState(ctx context.Context) (rcmgr.ResourceManagerStat, error) {
+	return api.Internal.ResourceState(ctx)
+}
+
+func (api *API) PubSubPeers(ctx context.Context, topic string) ([]peer.ID, error) {
+	return api.Internal.PubSubPeers(ctx, topic)
+}
+
+func (api *API) PeerScores(ctx context.Context) ([]peers.PeerScore, error) {
+	return api.Internal.PeerScores(ctx)
+}
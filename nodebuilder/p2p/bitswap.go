@@ -53,7 +53,7 @@ func blockstoreFromDatastore(ctx context.Context, ds datastore.Batching) (blocks
 	)
 }
 
-func blockstoreFromEDSStore(ctx context.Context, store *eds.Store) (blockstore.Blockstore, error) {
+func blockstoreFromEDSStore(ctx context.Context, store eds.EDSStore) (blockstore.Blockstore, error) {
 	return blockstore.CachedBlockstore(
 		ctx,
 		store.Blockstore(),
@@ -14,6 +14,8 @@ import (
 	peer "github.com/libp2p/go-libp2p/core/peer"
 	protocol "github.com/libp2p/go-libp2p/core/protocol"
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+
+	peers "github.com/celestiaorg/celestia-node/share/p2p/peers"
 )
 
 // MockModule is a mock of Module interface.
@@ -171,6 +173,21 @@ func (mr *MockModuleMockRecorder) IsProtected(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProtected", reflect.TypeOf((*MockModule)(nil).IsProtected), arg0, arg1, arg2)
 }
 
+// ListBlacklistedPeers mocks base method.
+func (m *MockModule) ListBlacklistedPeers(arg0 context.Context) ([]peers.BlacklistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlacklistedPeers", arg0)
+	ret0, _ := ret[0].([]peers.BlacklistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlacklistedPeers indicates an expected call of ListBlacklistedPeers.
+func (mr *MockModuleMockRecorder) ListBlacklistedPeers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlacklistedPeers", reflect.TypeOf((*MockModule)(nil).ListBlacklistedPeers), arg0)
+}
+
 // ListBlockedPeers mocks base method.
 func (m *MockModule) ListBlockedPeers(arg0 context.Context) ([]peer.ID, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +233,21 @@ func (mr *MockModuleMockRecorder) PeerInfo(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeerInfo", reflect.TypeOf((*MockModule)(nil).PeerInfo), arg0, arg1)
 }
 
+// PeerScores mocks base method.
+func (m *MockModule) PeerScores(arg0 context.Context) ([]peers.PeerScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeerScores", arg0)
+	ret0, _ := ret[0].([]peers.PeerScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeerScores indicates an expected call of PeerScores.
+func (mr *MockModuleMockRecorder) PeerScores(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeerScores", reflect.TypeOf((*MockModule)(nil).PeerScores), arg0)
+}
+
 // Peers mocks base method.
 func (m *MockModule) Peers(arg0 context.Context) ([]peer.ID, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +292,20 @@ func (mr *MockModuleMockRecorder) PubSubPeers(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PubSubPeers", reflect.TypeOf((*MockModule)(nil).PubSubPeers), arg0, arg1)
 }
 
+// RemoveBlacklistedPeer mocks base method.
+func (m *MockModule) RemoveBlacklistedPeer(arg0 context.Context, arg1 peer.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlacklistedPeer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlacklistedPeer indicates an expected call of RemoveBlacklistedPeer.
+func (mr *MockModuleMockRecorder) RemoveBlacklistedPeer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlacklistedPeer", reflect.TypeOf((*MockModule)(nil).RemoveBlacklistedPeer), arg0, arg1)
+}
+
 // ResourceState mocks base method.
 func (m *MockModule) ResourceState(arg0 context.Context) (rcmgr.ResourceManagerStat, error) {
 	m.ctrl.T.Helper()
@@ -32,7 +32,7 @@ func ConstructModule(tp node.Type, cfg *Config) fx.Option {
 		fx.Provide(contentRouting),
 		fx.Provide(addrsFactory(cfg.AnnounceAddresses, cfg.NoAnnounceAddresses)),
 		fx.Provide(metrics.NewBandwidthCounter),
-		fx.Provide(newModule),
+		fx.Provide(newModuleWithParams),
 		fx.Invoke(Listen(cfg.ListenAddresses)),
 		fx.Provide(resourceManager),
 		fx.Provide(resourceManagerOpt(allowList)),
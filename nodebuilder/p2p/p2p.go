@@ -14,69 +14,81 @@ import (
 	basichost "github.com/libp2p/go-libp2p/p2p/host/basic"
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	"github.com/libp2p/go-libp2p/p2p/net/conngater"
-)
+	"go.uber.org/fx"
 
-var _ Module = (*API)(nil)
+	"github.com/celestiaorg/celestia-node/share/p2p/peers"
+)
 
 // Module represents all accessible methods related to the node's p2p
 // host / operations.
 //
 //nolint:dupl
+//version:1
 //go:generate mockgen -destination=mocks/api.go -package=mocks . Module
 type Module interface {
 	// Info returns address information about the host.
-	Info(context.Context) (peer.AddrInfo, error)
+	Info(context.Context) (peer.AddrInfo, error) //perm:admin
 	// Peers returns connected peers.
-	Peers(context.Context) ([]peer.ID, error)
+	Peers(context.Context) ([]peer.ID, error) //perm:admin
 	// PeerInfo returns a small slice of information Peerstore has on the
 	// given peer.
-	PeerInfo(ctx context.Context, id peer.ID) (peer.AddrInfo, error)
+	PeerInfo(ctx context.Context, id peer.ID) (peer.AddrInfo, error) //perm:admin
 
 	// Connect ensures there is a connection between this host and the peer with
 	// given peer.
-	Connect(ctx context.Context, pi peer.AddrInfo) error
+	Connect(ctx context.Context, pi peer.AddrInfo) error //perm:admin
 	// ClosePeer closes the connection to a given peer.
-	ClosePeer(ctx context.Context, id peer.ID) error
+	ClosePeer(ctx context.Context, id peer.ID) error //perm:admin
 	// Connectedness returns a state signaling connection capabilities.
-	Connectedness(ctx context.Context, id peer.ID) (network.Connectedness, error)
+	Connectedness(ctx context.Context, id peer.ID) (network.Connectedness, error) //perm:admin
 	// NATStatus returns the current NAT status.
-	NATStatus(context.Context) (network.Reachability, error)
+	NATStatus(context.Context) (network.Reachability, error) //perm:admin
 
 	// BlockPeer adds a peer to the set of blocked peers.
-	BlockPeer(ctx context.Context, p peer.ID) error
+	BlockPeer(ctx context.Context, p peer.ID) error //perm:admin
 	// UnblockPeer removes a peer from the set of blocked peers.
-	UnblockPeer(ctx context.Context, p peer.ID) error
+	UnblockPeer(ctx context.Context, p peer.ID) error //perm:admin
 	// ListBlockedPeers returns a list of blocked peers.
-	ListBlockedPeers(context.Context) ([]peer.ID, error)
+	ListBlockedPeers(context.Context) ([]peer.ID, error) //perm:admin
+	// ListBlacklistedPeers returns the peers blacklisted for misbehaving while serving shares, with
+	// the reasons and expiration times. It is not available on bridge nodes.
+	ListBlacklistedPeers(context.Context) ([]peers.BlacklistEntry, error) //perm:admin
+	// RemoveBlacklistedPeer removes a peer from the blacklist of misbehaving peers and unblocks it.
+	// It is not available on bridge nodes.
+	RemoveBlacklistedPeer(ctx context.Context, id peer.ID) error //perm:admin
 	// Protect adds a peer to the list of peers who have a bidirectional
 	// peering agreement that they are protected from being trimmed, dropped
 	// or negatively scored.
-	Protect(ctx context.Context, id peer.ID, tag string) error
+	Protect(ctx context.Context, id peer.ID, tag string) error //perm:admin
 	// Unprotect removes a peer from the list of peers who have a bidirectional
 	// peering agreement that they are protected from being trimmed, dropped
 	// or negatively scored, returning a bool representing whether the given
 	// peer is protected or not.
-	Unprotect(ctx context.Context, id peer.ID, tag string) (bool, error)
+	Unprotect(ctx context.Context, id peer.ID, tag string) (bool, error) //perm:admin
 	// IsProtected returns whether the given peer is protected.
-	IsProtected(ctx context.Context, id peer.ID, tag string) (bool, error)
+	IsProtected(ctx context.Context, id peer.ID, tag string) (bool, error) //perm:admin
 
 	// BandwidthStats returns a Stats struct with bandwidth metrics for all
 	// data sent/received by the local peer, regardless of protocol or remote
 	// peer IDs.
-	BandwidthStats(context.Context) (metrics.Stats, error)
+	BandwidthStats(context.Context) (metrics.Stats, error) //perm:admin
 	// BandwidthForPeer returns a Stats struct with bandwidth metrics associated with the given peer.ID.
 	// The metrics returned include all traffic sent / received for the peer, regardless of protocol.
-	BandwidthForPeer(ctx context.Context, id peer.ID) (metrics.Stats, error)
+	BandwidthForPeer(ctx context.Context, id peer.ID) (metrics.Stats, error) //perm:admin
 	// BandwidthForProtocol returns a Stats struct with bandwidth metrics associated with the given
 	// protocol.ID.
-	BandwidthForProtocol(ctx context.Context, proto protocol.ID) (metrics.Stats, error)
+	BandwidthForProtocol(ctx context.Context, proto protocol.ID) (metrics.Stats, error) //perm:admin
 
 	// ResourceState returns the state of the resource manager.
-	ResourceState(context.Context) (rcmgr.ResourceManagerStat, error)
+	ResourceState(context.Context) (rcmgr.ResourceManagerStat, error) //perm:admin
 
 	// PubSubPeers returns the peer IDs of the peers joined on
 	// the given topic.
-	PubSubPeers(ctx context.Context, topic string) ([]peer.ID, error)
+	PubSubPeers(ctx context.Context, topic string) ([]peer.ID, error) //perm:admin
+
+	// PeerScores returns the scores of the peers requested for shares, per shrex protocol.
+	// It is not available on bridge nodes.
+	PeerScores(context.Context) ([]peers.PeerScore, error) //perm:admin
 }
 
 // module contains all components necessary to access information and
@@ -87,6 +99,30 @@ type module struct {
 	connGater *conngater.BasicConnectionGater
 	bw        *metrics.BandwidthCounter
 	rm        network.ResourceManager
+	// peerManager is only available on light and full nodes
+	peerManager *peers.Manager
+}
+
+type moduleParams struct {
+	fx.In
+
+	Host        HostBase
+	PubSub      *pubsub.PubSub
+	ConnGater   *conngater.BasicConnectionGater
+	Bandwidth   *metrics.BandwidthCounter
+	Resources   network.ResourceManager
+	PeerManager *peers.Manager `optional:"true"`
+}
+
+func newModuleWithParams(params moduleParams) Module {
+	return newModule(
+		params.Host,
+		params.PubSub,
+		params.ConnGater,
+		params.Bandwidth,
+		params.Resources,
+		params.PeerManager,
+	)
 }
 
 func newModule(
@@ -95,13 +131,15 @@ func newModule(
 	cg *conngater.BasicConnectionGater,
 	bw *metrics.BandwidthCounter,
 	rm network.ResourceManager,
+	pm *peers.Manager,
 ) Module {
 	return &module{
-		host:      host,
-		ps:        ps,
-		connGater: cg,
-		bw:        bw,
-		rm:        rm,
+		host:        host,
+		ps:          ps,
+		connGater:   cg,
+		bw:          bw,
+		rm:          rm,
+		peerManager: pm,
 	}
 }
 
@@ -138,18 +176,46 @@ func (m *module) NATStatus(context.Context) (network.Reachability, error) {
 	return basic.GetAutoNat().Status(), nil
 }
 
-func (m *module) BlockPeer(_ context.Context, p peer.ID) error {
-	return m.connGater.BlockPeer(p)
+func (m *module) BlockPeer(ctx context.Context, p peer.ID) error {
+	if err := m.connGater.BlockPeer(p); err != nil {
+		return err
+	}
+	if m.peerManager != nil {
+		// keep the peer blocked if the peer manager removes it from its blacklist
+		return m.peerManager.BlockedByOperator(ctx, p)
+	}
+	return nil
 }
 
-func (m *module) UnblockPeer(_ context.Context, p peer.ID) error {
-	return m.connGater.UnblockPeer(p)
+func (m *module) UnblockPeer(ctx context.Context, p peer.ID) error {
+	if err := m.connGater.UnblockPeer(p); err != nil {
+		return err
+	}
+	if m.peerManager != nil {
+		// forget the peer in the blacklist, so that it is not blocked again on restart
+		return m.peerManager.UnblockedByOperator(ctx, p)
+	}
+	return nil
 }
 
 func (m *module) ListBlockedPeers(context.Context) ([]peer.ID, error) {
 	return m.connGater.ListBlockedPeers(), nil
 }
 
+func (m *module) ListBlacklistedPeers(context.Context) ([]peers.BlacklistEntry, error) {
+	if m.peerManager == nil {
+		return nil, fmt.Errorf("peer blacklist is not available on this node type")
+	}
+	return m.peerManager.Blacklist(), nil
+}
+
+func (m *module) RemoveBlacklistedPeer(ctx context.Context, id peer.ID) error {
+	if m.peerManager == nil {
+		return fmt.Errorf("peer blacklist is not available on this node type")
+	}
+	return m.peerManager.RemoveFromBlacklist(ctx, id)
+}
+
 func (m *module) Protect(_ context.Context, id peer.ID, tag string) error {
 	m.host.ConnManager().Protect(id, tag)
 	return nil
@@ -188,101 +254,9 @@ func (m *module) PubSubPeers(_ context.Context, topic string) ([]peer.ID, error)
 	return m.ps.ListPeers(topic), nil
 }
 
-// API is a wrapper around Module for the RPC.
-// TODO(@distractedm1nd): These structs need to be autogenerated.
-//
-//nolint:dupl
-type API struct {
-	Internal struct {
-		Info                 func(context.Context) (peer.AddrInfo, error)                         `perm:"admin"`
-		Peers                func(context.Context) ([]peer.ID, error)                             `perm:"admin"`
-		PeerInfo             func(ctx context.Context, id peer.ID) (peer.AddrInfo, error)         `perm:"admin"`
-		Connect              func(ctx context.Context, pi peer.AddrInfo) error                    `perm:"admin"`
-		ClosePeer            func(ctx context.Context, id peer.ID) error                          `perm:"admin"`
-		Connectedness        func(ctx context.Context, id peer.ID) (network.Connectedness, error) `perm:"admin"`
-		NATStatus            func(context.Context) (network.Reachability, error)                  `perm:"admin"`
-		BlockPeer            func(ctx context.Context, p peer.ID) error                           `perm:"admin"`
-		UnblockPeer          func(ctx context.Context, p peer.ID) error                           `perm:"admin"`
-		ListBlockedPeers     func(context.Context) ([]peer.ID, error)                             `perm:"admin"`
-		Protect              func(ctx context.Context, id peer.ID, tag string) error              `perm:"admin"`
-		Unprotect            func(ctx context.Context, id peer.ID, tag string) (bool, error)      `perm:"admin"`
-		IsProtected          func(ctx context.Context, id peer.ID, tag string) (bool, error)      `perm:"admin"`
-		BandwidthStats       func(context.Context) (metrics.Stats, error)                         `perm:"admin"`
-		BandwidthForPeer     func(ctx context.Context, id peer.ID) (metrics.Stats, error)         `perm:"admin"`
-		BandwidthForProtocol func(ctx context.Context, proto protocol.ID) (metrics.Stats, error)  `perm:"admin"`
-		ResourceState        func(context.Context) (rcmgr.ResourceManagerStat, error)             `perm:"admin"`
-		PubSubPeers          func(ctx context.Context, topic string) ([]peer.ID, error)           `perm:"admin"`
+func (m *module) PeerScores(context.Context) ([]peers.PeerScore, error) {
+	if m.peerManager == nil {
+		return nil, fmt.Errorf("peer scores are not available on this node type")
 	}
-}
-
-func (api *API) Info(ctx context.Context) (peer.AddrInfo, error) {
-	return api.Internal.Info(ctx)
-}
-
-func (api *API) Peers(ctx context.Context) ([]peer.ID, error) {
-	return api.Internal.Peers(ctx)
-}
-
-func (api *API) PeerInfo(ctx context.Context, id peer.ID) (peer.AddrInfo, error) {
-	return api.Internal.PeerInfo(ctx, id)
-}
-
-func (api *API) Connect(ctx context.Context, pi peer.AddrInfo) error {
-	return api.Internal.Connect(ctx, pi)
-}
-
-func (api *API) ClosePeer(ctx context.Context, id peer.ID) error {
-	return api.Internal.ClosePeer(ctx, id)
-}
-
-func (api *API) Connectedness(ctx context.Context, id peer.ID) (network.Connectedness, error) {
-	return api.Internal.Connectedness(ctx, id)
-}
-
-func (api *API) NATStatus(ctx context.Context) (network.Reachability, error) {
-	return api.Internal.NATStatus(ctx)
-}
-
-func (api *API) BlockPeer(ctx context.Context, p peer.ID) error {
-	return api.Internal.BlockPeer(ctx, p)
-}
-
-func (api *API) UnblockPeer(ctx context.Context, p peer.ID) error {
-	return api.Internal.UnblockPeer(ctx, p)
-}
-
-func (api *API) ListBlockedPeers(ctx context.Context) ([]peer.ID, error) {
-	return api.Internal.ListBlockedPeers(ctx)
-}
-
-func (api *API) Protect(ctx context.Context, id peer.ID, tag string) error {
-	return api.Internal.Protect(ctx, id, tag)
-}
-
-func (api *API) Unprotect(ctx context.Context, id peer.ID, tag string) (bool, error) {
-	return api.Internal.Unprotect(ctx, id, tag)
-}
-
-func (api *API) IsProtected(ctx context.Context, id peer.ID, tag string) (bool, error) {
-	return api.Internal.IsProtected(ctx, id, tag)
-}
-
-func (api *API) BandwidthStats(ctx context.Context) (metrics.Stats, error) {
-	return api.Internal.BandwidthStats(ctx)
-}
-
-func (api *API) BandwidthForPeer(ctx context.Context, id peer.ID) (metrics.Stats, error) {
-	return api.Internal.BandwidthForPeer(ctx, id)
-}
-
-func (api *API) BandwidthForProtocol(ctx context.Context, proto protocol.ID) (metrics.Stats, error) {
-	return api.Internal.BandwidthForProtocol(ctx, proto)
-}
-
-func (api *API) ResourceState(ctx context.Context) (rcmgr.ResourceManagerStat, error) {
-	return api.Internal.ResourceState(ctx)
-}
-
-func (api *API) PubSubPeers(ctx context.Context, topic string) ([]peer.ID, error) {
-	return api.Internal.PubSubPeers(ctx, topic)
+	return m.peerManager.Scores(), nil
 }
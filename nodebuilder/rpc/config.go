@@ -2,24 +2,84 @@ package rpc
 
 import (
 	"fmt"
+	"io/fs"
 	"strconv"
+	"time"
 
+	"github.com/filecoin-project/go-jsonrpc/auth"
+
+	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/tlsconfig"
 	"github.com/celestiaorg/celestia-node/libs/utils"
 )
 
 type Config struct {
 	Address string
 	Port    string
+	// TLS enables serving the RPC over TLS, or mutual TLS if the client CA is set.
+	TLS tlsconfig.Config
+	// ClientCertPerms maps the common names of client certificates to the permission levels
+	// (public, read, write or admin) granted to the requests without a token. Requires mutual TLS.
+	ClientCertPerms map[string]string
+	// SocketPath enables serving the RPC on the unix socket at the given path as well.
+	SocketPath string
+	// SocketMode is the octal file mode of the socket controlling who can access it.
+	SocketMode string
+	// SocketPerms is the permission level granted to the requests over the socket without a token.
+	SocketPerms string
+	// Limits defines the per-token and per-IP rate limits and concurrency caps of the calls, and the
+	// weights of the methods in rate limits.
+	Limits rpc.Limits
+	// Audit configures the audit log of the calls.
+	Audit AuditConfig
+	// GRPC enables serving the header, share, blob and das modules over gRPC on the same address as
+	// the JSON-RPC.
+	GRPC bool
+	// GraphQL enables serving the GraphQL API over the header, share, blob and das modules at the
+	// /graphql path of the RPC address.
+	GraphQL bool
+}
+
+// AuditConfig configures the audit log recording the caller, method, parameter summary, status and
+// latency of every call.
+type AuditConfig struct {
+	// Store is where the audit log is kept: "file" or "datastore". Empty disables the audit log.
+	Store string
+	// Path is the path of the file log. Defaults to audit/rpc.log in the node store.
+	Path string
+	// MaxFileSizeMB is the size in megabytes the file log is rotated at.
+	MaxFileSizeMB int
+	// MaxFiles is the number of rotated files kept besides the current one.
+	MaxFiles int
+	// Retention is how long the entries are kept in the datastore. Zero keeps them forever.
+	Retention time.Duration
 }
 
 func DefaultConfig() Config {
 	return Config{
 		Address: "0.0.0.0",
 		// do NOT expose the same port as celestia-core by default so that both can run on the same machine
-		Port: "26658",
+		Port:        "26658",
+		SocketMode:  defaultSocketMode,
+		SocketPerms: defaultSocketPerms,
+		Limits:      rpc.DefaultLimits(),
+		Audit: AuditConfig{
+			MaxFileSizeMB: 100,
+			MaxFiles:      5,
+			Retention:     30 * 24 * time.Hour,
+		},
 	}
 }
 
+const (
+	defaultSocketMode  = "0600"
+	defaultSocketPerms = "admin"
+
+	auditStoreFile      = "file"
+	auditStoreDatastore = "datastore"
+)
+
 func (cfg *Config) Validate() error {
 	sanitizedAddress, err := utils.ValidateAddr(cfg.Address)
 	if err != nil {
@@ -31,5 +91,77 @@ func (cfg *Config) Validate() error {
 	if err != nil {
 		return fmt.Errorf("service/rpc: invalid port: %s", err.Error())
 	}
+
+	if err = cfg.TLS.Validate(); err != nil {
+		return fmt.Errorf("service/rpc: %w", err)
+	}
+	if len(cfg.ClientCertPerms) != 0 && cfg.TLS.ClientCAPath == "" {
+		return fmt.Errorf("service/rpc: client certificate permissions require a client CA")
+	}
+	for name, level := range cfg.ClientCertPerms {
+		if _, ok := perms.Levels[level]; !ok {
+			return fmt.Errorf("service/rpc: invalid permission level %q for client %q", level, name)
+		}
+	}
+
+	if err = cfg.Limits.Validate(); err != nil {
+		return fmt.Errorf("service/rpc: %w", err)
+	}
+	if err = cfg.Audit.Validate(); err != nil {
+		return fmt.Errorf("service/rpc: %w", err)
+	}
+
+	if cfg.SocketPath == "" {
+		return nil
+	}
+	if _, err = cfg.socketMode(); err != nil {
+		return fmt.Errorf("service/rpc: invalid socket mode: %w", err)
+	}
+	if _, err = cfg.socketPerms(); err != nil {
+		return fmt.Errorf("service/rpc: %w", err)
+	}
+	return nil
+}
+
+func (cfg *Config) socketPerms() ([]auth.Permission, error) {
+	level := cfg.SocketPerms
+	if level == "" {
+		level = defaultSocketPerms
+	}
+	permissions, ok := perms.Levels[level]
+	if !ok {
+		return nil, fmt.Errorf("invalid socket permission level %q", level)
+	}
+	return permissions, nil
+}
+
+func (cfg *Config) socketMode() (fs.FileMode, error) {
+	mode := cfg.SocketMode
+	if mode == "" {
+		mode = defaultSocketMode
+	}
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil {
+		return 0, err
+	}
+	if m&^uint64(fs.ModePerm) != 0 {
+		return 0, fmt.Errorf("%s is not a permission mode", mode)
+	}
+	return fs.FileMode(m), nil
+}
+
+func (cfg *AuditConfig) Validate() error {
+	switch cfg.Store {
+	case "", auditStoreDatastore:
+	case auditStoreFile:
+		if cfg.MaxFileSizeMB < 0 || cfg.MaxFiles < 0 {
+			return fmt.Errorf("negative audit log file limits")
+		}
+	default:
+		return fmt.Errorf("invalid audit log store %q, must be %q or %q", cfg.Store, auditStoreFile, auditStoreDatastore)
+	}
+	if cfg.Retention < 0 {
+		return fmt.Errorf("negative audit log retention")
+	}
 	return nil
 }
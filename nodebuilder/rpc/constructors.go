@@ -1,9 +1,18 @@
 package rpc
 
 import (
+	"path/filepath"
+
 	"github.com/cristalhq/jwt"
+	"github.com/filecoin-project/go-jsonrpc/auth"
+	"github.com/ipfs/go-datastore"
 
+	"github.com/celestiaorg/celestia-node/api/graphql"
+	"github.com/celestiaorg/celestia-node/api/grpcapi"
 	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
+	"github.com/celestiaorg/celestia-node/api/rpc/perms"
+	"github.com/celestiaorg/celestia-node/libs/authtoken"
 	"github.com/celestiaorg/celestia-node/nodebuilder/blob"
 	"github.com/celestiaorg/celestia-node/nodebuilder/das"
 	"github.com/celestiaorg/celestia-node/nodebuilder/fraud"
@@ -16,6 +25,7 @@ import (
 
 // registerEndpoints registers the given services on the rpc.
 func registerEndpoints(
+	cfg *Config,
 	stateMod state.Module,
 	shareMod share.Module,
 	fraudMod fraud.Module,
@@ -26,16 +36,76 @@ func registerEndpoints(
 	blobMod blob.Module,
 	serv *rpc.Server,
 ) {
-	serv.RegisterAuthedService("fraud", fraudMod, &fraud.API{})
-	serv.RegisterAuthedService("das", daserMod, &das.API{})
-	serv.RegisterAuthedService("header", headerMod, &header.API{})
-	serv.RegisterAuthedService("state", stateMod, &state.API{})
-	serv.RegisterAuthedService("share", shareMod, &share.API{})
-	serv.RegisterAuthedService("p2p", p2pMod, &p2p.API{})
-	serv.RegisterAuthedService("node", nodeMod, &node.API{})
-	serv.RegisterAuthedService("blob", blobMod, &blob.API{})
+	dasAPI, headerAPI, shareAPI, blobAPI := &das.API{}, &header.API{}, &share.API{}, &blob.API{}
+	serv.RegisterVersionedService("fraud", fraud.ModuleVersion, fraudMod, &fraud.API{})
+	serv.RegisterVersionedService("das", das.ModuleVersion, daserMod, dasAPI)
+	serv.RegisterVersionedService("header", header.ModuleVersion, headerMod, headerAPI)
+	serv.RegisterVersionedService("state", state.ModuleVersion, stateMod, &state.API{})
+	serv.RegisterVersionedService("share", share.ModuleVersion, shareMod, shareAPI)
+	serv.RegisterVersionedService("p2p", p2p.ModuleVersion, p2pMod, &p2p.API{})
+	serv.RegisterVersionedService("node", node.ModuleVersion, nodeMod, &node.API{})
+	serv.RegisterVersionedService("blob", blob.ModuleVersion, blobMod, blobAPI)
+	if cfg.GRPC {
+		// the gRPC services call the modules through their registered APIs, sharing the permission
+		// checks, limits and audit log with the JSON-RPC
+		grpcapi.Register(serv, headerAPI, shareAPI, blobAPI, dasAPI)
+	}
+	if cfg.GraphQL {
+		serv.RegisterHTTPHandler("/graphql", graphql.NewHandler(headerAPI, shareAPI, blobAPI, dasAPI))
+	}
+}
+
+func server(
+	cfg *Config,
+	signer jwt.Signer,
+	tokens *authtoken.Registry,
+	auditLog audit.Log,
+) (*rpc.Server, error) {
+	srv := rpc.NewServer(cfg.Address, cfg.Port, signer, tokens)
+	srv.SetLimits(cfg.Limits)
+	if auditLog != nil {
+		srv.EnableAudit(auditLog)
+	}
+	if cfg.SocketPath != "" {
+		mode, err := cfg.socketMode()
+		if err != nil {
+			return nil, err
+		}
+		permissions, err := cfg.socketPerms()
+		if err != nil {
+			return nil, err
+		}
+		srv.EnableUnixSocket(cfg.SocketPath, mode, permissions)
+	}
+	if !cfg.TLS.Enabled() {
+		return srv, nil
+	}
+
+	tlsCfg, err := cfg.TLS.ServerConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientCertPerms := make(map[string][]auth.Permission, len(cfg.ClientCertPerms))
+	for name, level := range cfg.ClientCertPerms {
+		clientCertPerms[name] = perms.Levels[level]
+	}
+	srv.EnableTLS(tlsCfg, clientCertPerms)
+	return srv, nil
 }
 
-func server(cfg *Config, auth jwt.Signer) *rpc.Server {
-	return rpc.NewServer(cfg.Address, cfg.Port, auth)
+// auditLog opens the audit log in the configured store. It returns nil if the audit log is
+// disabled.
+func auditLog(cfg *Config, path node.StorePath, ds datastore.Batching) (audit.Log, error) {
+	switch cfg.Audit.Store {
+	case auditStoreFile:
+		logPath := cfg.Audit.Path
+		if logPath == "" {
+			logPath = filepath.Join(string(path), "audit", "rpc.log")
+		}
+		return audit.NewFileLog(logPath, int64(cfg.Audit.MaxFileSizeMB)<<20, cfg.Audit.MaxFiles)
+	case auditStoreDatastore:
+		return audit.NewDatastoreLog(ds, cfg.Audit.Retention), nil
+	default:
+		return nil, nil
+	}
 }
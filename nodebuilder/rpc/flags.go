@@ -6,8 +6,15 @@ import (
 )
 
 var (
-	addrFlag = "rpc.addr"
-	portFlag = "rpc.port"
+	addrFlag        = "rpc.addr"
+	portFlag        = "rpc.port"
+	tlsCertFlag     = "rpc.tls.cert"
+	tlsKeyFlag      = "rpc.tls.key"
+	tlsClientCAFlag = "rpc.tls.client-ca"
+	socketFlag      = "rpc.socket"
+	auditFlag       = "rpc.audit"
+	grpcFlag        = "rpc.grpc"
+	graphqlFlag     = "rpc.graphql"
 )
 
 // Flags gives a set of hardcoded node/rpc package flags.
@@ -24,6 +31,41 @@ func Flags() *flag.FlagSet {
 		"",
 		"Set a custom RPC port (default: 26658)",
 	)
+	flags.String(
+		tlsCertFlag,
+		"",
+		"Path to the PEM-encoded TLS certificate. Enables TLS for the RPC. Reloaded on change.",
+	)
+	flags.String(
+		tlsKeyFlag,
+		"",
+		"Path to the PEM-encoded TLS key of the certificate. Reloaded on change.",
+	)
+	flags.String(
+		tlsClientCAFlag,
+		"",
+		"Path to the PEM-encoded CAs verifying client certificates. Enables mutual TLS for the RPC.",
+	)
+	flags.String(
+		socketFlag,
+		"",
+		"Path to the unix socket to serve the RPC on as well. Access is controlled by the socket file permissions.",
+	)
+	flags.String(
+		auditFlag,
+		"",
+		"Enables the audit log of the RPC calls, kept in a rotating \"file\" or the node \"datastore\".",
+	)
+	flags.Bool(
+		grpcFlag,
+		false,
+		"Enables serving the header, share, blob and das modules over gRPC on the RPC address as well.",
+	)
+	flags.Bool(
+		graphqlFlag,
+		false,
+		"Enables serving the GraphQL API over headers, blobs and DAS state at the /graphql path of the RPC address.",
+	)
 
 	return flags
 }
@@ -38,4 +80,27 @@ func ParseFlags(cmd *cobra.Command, cfg *Config) {
 	if port != "" {
 		cfg.Port = port
 	}
+	if cert := cmd.Flag(tlsCertFlag).Value.String(); cert != "" {
+		cfg.TLS.CertPath = cert
+	}
+	if key := cmd.Flag(tlsKeyFlag).Value.String(); key != "" {
+		cfg.TLS.KeyPath = key
+	}
+	if ca := cmd.Flag(tlsClientCAFlag).Value.String(); ca != "" {
+		cfg.TLS.ClientCAPath = ca
+	}
+	if socket := cmd.Flag(socketFlag).Value.String(); socket != "" {
+		cfg.SocketPath = socket
+	}
+	if store := cmd.Flag(auditFlag).Value.String(); store != "" {
+		cfg.Audit.Store = store
+	}
+	grpcEnabled, err := cmd.Flags().GetBool(grpcFlag)
+	if cmd.Flags().Changed(grpcFlag) && err == nil {
+		cfg.GRPC = grpcEnabled
+	}
+	graphqlEnabled, err := cmd.Flags().GetBool(graphqlFlag)
+	if cmd.Flags().Changed(graphqlFlag) && err == nil {
+		cfg.GraphQL = graphqlEnabled
+	}
 }
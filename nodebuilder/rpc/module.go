@@ -6,6 +6,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/celestiaorg/celestia-node/api/rpc"
+	"github.com/celestiaorg/celestia-node/api/rpc/audit"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 )
 
@@ -16,6 +17,15 @@ func ConstructModule(tp node.Type, cfg *Config) fx.Option {
 	baseComponents := fx.Options(
 		fx.Supply(cfg),
 		fx.Error(cfgErr),
+		fx.Provide(fx.Annotate(
+			auditLog,
+			fx.OnStop(func(auditLog audit.Log) error {
+				if auditLog == nil {
+					return nil
+				}
+				return auditLog.Close()
+			}),
+		)),
 		fx.Provide(fx.Annotate(
 			server,
 			fx.OnStart(func(ctx context.Context, server *rpc.Server) error {
@@ -0,0 +1,11 @@
+package rpc
+
+import (
+	"github.com/celestiaorg/celestia-node/api/rpc"
+)
+
+// WithMetrics is a utility function that is expected to be
+// "invoked" by the fx lifecycle.
+func WithMetrics(srv *rpc.Server) error {
+	return srv.WithMetrics()
+}
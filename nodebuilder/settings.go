@@ -27,6 +27,7 @@ import (
 	modheader "github.com/celestiaorg/celestia-node/nodebuilder/header"
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/nodebuilder/p2p"
+	"github.com/celestiaorg/celestia-node/nodebuilder/rpc"
 	"github.com/celestiaorg/celestia-node/nodebuilder/share"
 	"github.com/celestiaorg/celestia-node/state"
 )
@@ -85,6 +86,7 @@ func WithMetrics(metricOpts []otlpmetrichttp.Option, nodeType node.Type) fx.Opti
 		fx.Invoke(node.WithMetrics),
 		fx.Invoke(modheader.WithMetrics),
 		fx.Invoke(share.WithDiscoveryMetrics),
+		fx.Invoke(rpc.WithMetrics),
 	)
 
 	samplingMetrics := fx.Options(
@@ -92,6 +94,7 @@ func WithMetrics(metricOpts []otlpmetrichttp.Option, nodeType node.Type) fx.Opti
 		fx.Invoke(share.WithPeerManagerMetrics),
 		fx.Invoke(share.WithShrexClientMetrics),
 		fx.Invoke(share.WithShrexGetterMetrics),
+		fx.Invoke(share.WithCascadeGetterMetrics),
 	)
 
 	var opts fx.Option
@@ -0,0 +1,58 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package share
+
+import (
+	"context"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "share.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		SharesAvailable           func(ctx context.Context, root *share.Root) error                                                      `perm:"public"`
+		ProbabilityOfAvailability func(ctx context.Context) float64                                                                      `perm:"public"`
+		GetShare                  func(ctx context.Context, dah *share.Root, row int, col int) (share.Share, error)                      `perm:"public"`
+		GetEDS                    func(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error)                        `perm:"public"`
+		GetSharesByNamespace      func(ctx context.Context, root *share.Root, namespace share.Namespace) (share.NamespacedShares, error) `perm:"public"`
+		ExportEDS                 func(ctx context.Context, height uint64) ([]byte, error)                                               `perm:"admin"`
+		ImportEDS                 func(ctx context.Context, height uint64, car []byte) error                                             `perm:"admin"`
+	}
+}
+
+func (api *API) SharesAvailable(ctx context.Context, root *share.Root) error {
+	return api.Internal.SharesAvailable(ctx, root)
+}
+
+func (api *API) ProbabilityOfAvailability(ctx context.Context) float64 {
+	return api.Internal.ProbabilityOfAvailability(ctx)
+}
+
+func (api *API) GetShare(ctx context.Context, dah *share.Root, row int, col int) (share.Share, error) {
+	return api.Internal.GetShare(ctx, dah, row, col)
+}
+
+func (api *API) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error) {
+	return api.Internal.GetEDS(ctx, root)
+}
+
+func (api *API) GetSharesByNamespace(ctx context.Context, root *share.Root, namespace share.Namespace) (share.NamespacedShares, error) {
+	return api.Internal.GetSharesByNamespace(ctx, root, namespace)
+}
+
+func (api *API) ExportEDS(ctx context.Context, height uint64) ([]byte, error) {
+	return api.Internal.ExportEDS(ctx, height)
+}
+
+func (api *API) ImportEDS(ctx context.Context, height uint64, car []byte) error {
+	return api.Internal.ImportEDS(ctx, height, car)
+}
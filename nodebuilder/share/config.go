@@ -5,37 +5,68 @@ import (
 
 	"github.com/celestiaorg/celestia-node/nodebuilder/node"
 	"github.com/celestiaorg/celestia-node/share/availability/light"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/getters"
 	"github.com/celestiaorg/celestia-node/share/p2p/discovery"
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
 )
 
+// EDSStoreBackend is the storage backend of EDSes on bridge and full nodes.
+type EDSStoreBackend string
+
+const (
+	// DAGStoreBackend stores whole EDSes with their proofs as CARv1 files indexed by the DAGStore.
+	DAGStoreBackend EDSStoreBackend = "dagstore"
+	// ODSBackend stores only ODSes in flat files and recomputes parity shares and proofs on the fly.
+	ODSBackend EDSStoreBackend = "ods"
+)
+
 // TODO: some params are pointers and other are not, Let's fix this.
 type Config struct {
 	UseShareExchange bool
-	// ShrExEDSParams sets shrexeds client and server configuration parameters
+	// ShrExEDSParams sets shrexeds client and server configuration parameters, including the
+	// enabled stream compressions
 	ShrExEDSParams *shrexeds.Parameters
-	// ShrExNDParams sets shrexnd client and server configuration parameters
+	// ShrExNDParams sets shrexnd client and server configuration parameters, including the enabled
+	// stream compressions
 	ShrExNDParams *shrexnd.Parameters
 	// PeerManagerParams sets peer-manager configuration parameters
 	PeerManagerParams peers.Parameters
+	// ShrexGetterParams sets the parameters of the shrex getter
+	ShrexGetterParams getters.ShrexParameters `toml:",omitempty"`
+	// CascadeGetterParams sets the hedging parameters of the cascade getter per operation
+	CascadeGetterParams getters.CascadeParameters `toml:",omitempty"`
 
 	LightAvailability light.Parameters `toml:",omitempty"`
 	Discovery         discovery.Parameters
+
+	// EDSStoreBackend selects the storage backend of EDSes. Empty value stands for DAGStoreBackend.
+	EDSStoreBackend EDSStoreBackend `toml:",omitempty"`
+	// EDSStoreParams sets the parameters of the dagstore EDS store backend
+	EDSStoreParams eds.Parameters `toml:",omitempty"`
+	// EDSStoreVerifier sets the background verification parameters of the EDS store
+	EDSStoreVerifier eds.VerifierParameters `toml:",omitempty"`
 }
 
 func DefaultConfig(tp node.Type) Config {
 	cfg := Config{
-		Discovery:         discovery.DefaultParameters(),
-		ShrExEDSParams:    shrexeds.DefaultParameters(),
-		ShrExNDParams:     shrexnd.DefaultParameters(),
-		UseShareExchange:  true,
-		PeerManagerParams: peers.DefaultParameters(),
+		Discovery:           discovery.DefaultParameters(),
+		ShrExEDSParams:      shrexeds.DefaultParameters(),
+		ShrExNDParams:       shrexnd.DefaultParameters(),
+		UseShareExchange:    true,
+		PeerManagerParams:   peers.DefaultParameters(),
+		ShrexGetterParams:   getters.DefaultShrexParameters(),
+		CascadeGetterParams: getters.DefaultCascadeParameters(),
 	}
 
 	if tp == node.Light {
 		cfg.LightAvailability = light.DefaultParameters()
+	} else {
+		cfg.EDSStoreBackend = DAGStoreBackend
+		cfg.EDSStoreParams = eds.DefaultParameters()
+		cfg.EDSStoreVerifier = eds.DefaultVerifierParameters()
 	}
 
 	return cfg
@@ -47,6 +78,15 @@ func (cfg *Config) Validate(tp node.Type) error {
 		if err := cfg.LightAvailability.Validate(); err != nil {
 			return fmt.Errorf("nodebuilder/share: %w", err)
 		}
+	} else {
+		switch cfg.EDSStoreBackend {
+		case "", DAGStoreBackend, ODSBackend:
+		default:
+			return fmt.Errorf("nodebuilder/share: unknown EDS store backend %q", cfg.EDSStoreBackend)
+		}
+		if err := cfg.EDSStoreVerifier.Validate(); err != nil {
+			return fmt.Errorf("nodebuilder/share: %w", err)
+		}
 	}
 
 	if err := cfg.Discovery.Validate(); err != nil {
@@ -65,5 +105,13 @@ func (cfg *Config) Validate(tp node.Type) error {
 		return fmt.Errorf("nodebuilder/share: %w", err)
 	}
 
+	if err := cfg.ShrexGetterParams.Validate(); err != nil {
+		return fmt.Errorf("nodebuilder/share: %w", err)
+	}
+
+	if err := cfg.CascadeGetterParams.Validate(); err != nil {
+		return fmt.Errorf("nodebuilder/share: %w", err)
+	}
+
 	return nil
 }
@@ -13,7 +13,9 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
+	libhead "github.com/celestiaorg/go-header"
 
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/availability/cache"
 	"github.com/celestiaorg/celestia-node/share/availability/light"
@@ -23,17 +25,21 @@ import (
 	disc "github.com/celestiaorg/celestia-node/share/p2p/discovery"
 )
 
-func newDiscovery(cfg Config) func(routing.ContentRouting, host.Host) *disc.Discovery {
+func newDiscovery(cfg Config) func(routing.ContentRouting, host.Host, datastore.Batching) *disc.Discovery {
 	return func(
 		r routing.ContentRouting,
 		h host.Host,
+		ds datastore.Batching,
 	) *disc.Discovery {
-		return disc.NewDiscovery(
+		d := disc.NewDiscovery(
 			h,
 			routingdisc.NewRoutingDiscovery(r),
 			disc.WithPeersLimit(cfg.Discovery.PeersLimit),
 			disc.WithAdvertiseInterval(cfg.Discovery.AdvertiseInterval),
+			disc.WithKnownPeersTTL(cfg.Discovery.KnownPeersTTL),
 		)
+		d.WithPeerStore(ds)
+		return d
 	}
 }
 
@@ -46,12 +52,45 @@ func cacheAvailability(lc fx.Lifecycle, ds datastore.Batching, avail *light.Shar
 	return ca
 }
 
-func newModule(getter share.Getter, avail share.Availability) Module {
-	return &module{getter, avail}
+type moduleParams struct {
+	fx.In
+
+	Getter      share.Getter
+	Avail       share.Availability
+	HeaderStore libhead.Store[*header.ExtendedHeader]
+	// Store is only provided on bridge and full nodes.
+	Store eds.EDSStore `optional:"true"`
+}
+
+func newModule(params moduleParams) Module {
+	return &module{
+		Getter:       params.Getter,
+		Availability: params.Avail,
+		getByHeight:  params.HeaderStore.GetByHeight,
+		store:        params.Store,
+	}
+}
+
+type verifierParams struct {
+	fx.In
+
+	Store *eds.Store
+	// ShrexGetter is only provided on full nodes. Bridge nodes don't refetch broken EDSes.
+	ShrexGetter *getters.ShrexGetter `optional:"true"`
+}
+
+func newVerifier(params eds.VerifierParameters) func(verifierParams) *eds.Verifier {
+	return func(p verifierParams) *eds.Verifier {
+		var refetch eds.RefetchFn
+		if p.ShrexGetter != nil {
+			refetch = p.ShrexGetter.GetEDSByHash
+		}
+		return eds.NewVerifier(params, p.Store, refetch)
+	}
 }
 
 // ensureEmptyCARExists adds an empty EDS to the provided EDS store.
-func ensureEmptyCARExists(ctx context.Context, store *eds.Store) error {
+func ensureEmptyCARExists(ctx context.Context, store eds.EDSStore) error {
 	emptyEDS := share.EmptyExtendedDataSquare()
 	emptyDAH, err := da.NewDataAvailabilityHeader(emptyEDS)
 	if err != nil {
@@ -84,11 +123,11 @@ func lightGetter(
 		cascade = append(cascade, shrexGetter)
 	}
 	cascade = append(cascade, ipldGetter)
-	return getters.NewCascadeGetter(cascade)
+	return getters.NewCascadeGetter(cascade, cfg.CascadeGetterParams)
 }
 
 func fullGetter(
-	store *eds.Store,
+	store eds.EDSStore,
 	storeGetter *getters.StoreGetter,
 	shrexGetter *getters.ShrexGetter,
 	ipldGetter *getters.IPLDGetter,
@@ -100,5 +139,5 @@ func fullGetter(
 		cascade = append(cascade, getters.NewTeeGetter(shrexGetter, store))
 	}
 	cascade = append(cascade, getters.NewTeeGetter(ipldGetter, store))
-	return getters.NewCascadeGetter(cascade)
+	return getters.NewCascadeGetter(cascade, cfg.CascadeGetterParams)
 }
@@ -8,11 +8,10 @@ import (
 	context "context"
 	reflect "reflect"
 
-	gomock "github.com/golang/mock/gomock"
-
 	da "github.com/celestiaorg/celestia-app/pkg/da"
 	share "github.com/celestiaorg/celestia-node/share"
 	rsmt2d "github.com/celestiaorg/rsmt2d"
+	gomock "github.com/golang/mock/gomock"
 )
 
 // MockModule is a mock of Module interface.
@@ -38,6 +37,21 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 	return m.recorder
 }
 
+// ExportEDS mocks base method.
+func (m *MockModule) ExportEDS(arg0 context.Context, arg1 uint64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEDS", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEDS indicates an expected call of ExportEDS.
+func (mr *MockModuleMockRecorder) ExportEDS(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEDS", reflect.TypeOf((*MockModule)(nil).ExportEDS), arg0, arg1)
+}
+
 // GetEDS mocks base method.
 func (m *MockModule) GetEDS(arg0 context.Context, arg1 *da.DataAvailabilityHeader) (*rsmt2d.ExtendedDataSquare, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +97,20 @@ func (mr *MockModuleMockRecorder) GetSharesByNamespace(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespace", reflect.TypeOf((*MockModule)(nil).GetSharesByNamespace), arg0, arg1, arg2)
 }
 
+// ImportEDS mocks base method.
+func (m *MockModule) ImportEDS(arg0 context.Context, arg1 uint64, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEDS", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportEDS indicates an expected call of ImportEDS.
+func (mr *MockModuleMockRecorder) ImportEDS(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEDS", reflect.TypeOf((*MockModule)(nil).ImportEDS), arg0, arg1, arg2)
+}
+
 // ProbabilityOfAvailability mocks base method.
 func (m *MockModule) ProbabilityOfAvailability(arg0 context.Context) float64 {
 	m.ctrl.T.Helper()
@@ -52,7 +52,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 		fx.Provide(getters.NewStoreGetter),
 		fx.Invoke(func(edsSrv *shrexeds.Server, ndSrc *shrexnd.Server) {}),
 		fx.Provide(fx.Annotate(
-			func(host host.Host, store *eds.Store, network modp2p.Network) (*shrexeds.Server, error) {
+			func(host host.Host, store eds.EDSStore, network modp2p.Network) (*shrexeds.Server, error) {
 				cfg.ShrExEDSParams.WithNetworkID(network.String())
 				return shrexeds.NewServer(cfg.ShrExEDSParams, host, store)
 			},
@@ -66,7 +66,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 		fx.Provide(fx.Annotate(
 			func(
 				host host.Host,
-				store *eds.Store,
+				store eds.EDSStore,
 				getter *getters.StoreGetter,
 				network modp2p.Network,
 			) (*shrexnd.Server, error) {
@@ -80,21 +80,7 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 				return server.Stop(ctx)
 			}),
 		)),
-		fx.Provide(fx.Annotate(
-			func(path node.StorePath, ds datastore.Batching) (*eds.Store, error) {
-				return eds.NewStore(string(path), ds)
-			},
-			fx.OnStart(func(ctx context.Context, store *eds.Store) error {
-				err := store.Start(ctx)
-				if err != nil {
-					return err
-				}
-				return ensureEmptyCARExists(ctx, store)
-			}),
-			fx.OnStop(func(ctx context.Context, store *eds.Store) error {
-				return store.Stop(ctx)
-			}),
-		)),
+		edsStoreComponents(cfg),
 		fx.Provide(fx.Annotate(
 			full.NewShareAvailability,
 			fx.OnStart(func(ctx context.Context, avail *full.ShareAvailability) error {
@@ -130,7 +116,13 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 			},
 		),
 		fx.Provide(fx.Annotate(
-			getters.NewShrexGetter,
+			func(
+				edsClient *shrexeds.Client,
+				ndClient *shrexnd.Client,
+				peerManager *peers.Manager,
+			) *getters.ShrexGetter {
+				return getters.NewShrexGetter(edsClient, ndClient, peerManager, cfg.ShrexGetterParams)
+			},
 			fx.OnStart(func(ctx context.Context, getter *getters.ShrexGetter) error {
 				return getter.Start(ctx)
 			}),
@@ -194,3 +186,62 @@ func ConstructModule(tp node.Type, cfg *Config, options ...fx.Option) fx.Option
 		panic("invalid node type")
 	}
 }
+
+// edsStoreComponents provides the eds.EDSStore of the backend selected in the Config.
+func edsStoreComponents(cfg *Config) fx.Option {
+	switch cfg.EDSStoreBackend {
+	case ODSBackend:
+		return fx.Options(
+			fx.Provide(fx.Annotate(
+				func(path node.StorePath, ds datastore.Batching) (*eds.ODSStore, error) {
+					return eds.NewODSStore(string(path), ds)
+				},
+				fx.OnStart(func(ctx context.Context, store *eds.ODSStore) error {
+					err := store.Start(ctx)
+					if err != nil {
+						return err
+					}
+					return ensureEmptyCARExists(ctx, store)
+				}),
+				fx.OnStop(func(ctx context.Context, store *eds.ODSStore) error {
+					return store.Stop(ctx)
+				}),
+			)),
+			fx.Provide(func(store *eds.ODSStore) eds.EDSStore {
+				return store
+			}),
+		)
+	default:
+		return fx.Options(
+			fx.Provide(fx.Annotate(
+				func(path node.StorePath, ds datastore.Batching) (*eds.Store, error) {
+					return eds.NewStore(string(path), ds, eds.WithODSOnly(cfg.EDSStoreParams.ODSOnly))
+				},
+				fx.OnStart(func(ctx context.Context, store *eds.Store) error {
+					err := store.Start(ctx)
+					if err != nil {
+						return err
+					}
+					return ensureEmptyCARExists(ctx, store)
+				}),
+				fx.OnStop(func(ctx context.Context, store *eds.Store) error {
+					return store.Stop(ctx)
+				}),
+			)),
+			fx.Provide(func(store *eds.Store) eds.EDSStore {
+				return store
+			}),
+			// the verifier relies on the internals of the DAGStore
+			fx.Invoke(func(*eds.Verifier) {}),
+			fx.Provide(fx.Annotate(
+				newVerifier(cfg.EDSStoreVerifier),
+				fx.OnStart(func(ctx context.Context, verifier *eds.Verifier) error {
+					return verifier.Start(ctx)
+				}),
+				fx.OnStop(func(ctx context.Context, verifier *eds.Verifier) error {
+					return verifier.Stop(ctx)
+				}),
+			)),
+		)
+	}
+}
@@ -1,6 +1,7 @@
 package share
 
 import (
+	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/getters"
 	disc "github.com/celestiaorg/celestia-node/share/p2p/discovery"
@@ -43,6 +44,18 @@ func WithShrexGetterMetrics(sg *getters.ShrexGetter) error {
 	return sg.WithMetrics()
 }
 
-func WithStoreMetrics(s *eds.Store) error {
-	return s.WithMetrics()
+// WithCascadeGetterMetrics enables the per-getter metrics of the cascade getter, if it is used.
+func WithCascadeGetterMetrics(g share.Getter) error {
+	if cg, ok := g.(*getters.CascadeGetter); ok {
+		return cg.WithMetrics()
+	}
+	return nil
+}
+
+// WithStoreMetrics enables metrics of the EDS store, if its backend supports them.
+func WithStoreMetrics(s eds.EDSStore) error {
+	if store, ok := s.(*eds.Store); ok {
+		return store.WithMetrics()
+	}
+	return nil
 }
@@ -1,14 +1,22 @@
 package share
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filecoin-project/dagstore"
 
 	"github.com/celestiaorg/rsmt2d"
 
+	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
 )
 
-var _ Module = (*API)(nil)
+// errImportNotSupported is returned by ImportEDS on nodes that do not keep an EDS store.
+var errImportNotSupported = errors.New("share: importing EDS is only supported by bridge and full nodes")
 
 // Module provides access to any data square or block share on the network.
 //
@@ -23,77 +31,87 @@ var _ Module = (*API)(nil)
 //     * Store the Share
 //     * Return
 //
-// Any method signature changed here needs to also be changed in the API struct.
+// The API struct is generated from it by `go generate ./api`.
 //
+//version:1
 //go:generate mockgen -destination=mocks/api.go -package=mocks . Module
 type Module interface {
 	// SharesAvailable subjectively validates if Shares committed to the given Root are available on
 	// the Network.
-	SharesAvailable(context.Context, *share.Root) error
+	SharesAvailable(context.Context, *share.Root) error //perm:public
 	// ProbabilityOfAvailability calculates the probability of the data square
 	// being available based on the number of samples collected.
-	ProbabilityOfAvailability(context.Context) float64
+	ProbabilityOfAvailability(context.Context) float64 //perm:public
 	// GetShare gets a Share by coordinates in EDS.
-	GetShare(ctx context.Context, dah *share.Root, row, col int) (share.Share, error)
+	GetShare(ctx context.Context, dah *share.Root, row, col int) (share.Share, error) //perm:public
 	// GetEDS gets the full EDS identified by the given root.
-	GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error)
+	GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error) //perm:public
 	// GetSharesByNamespace gets all shares from an EDS within the given namespace.
 	// Shares are returned in a row-by-row order if the namespace spans multiple rows.
-	GetSharesByNamespace(ctx context.Context, root *share.Root, namespace share.Namespace) (share.NamespacedShares, error)
+	GetSharesByNamespace(
+		ctx context.Context,
+		root *share.Root,
+		namespace share.Namespace,
+	) (share.NamespacedShares, error) //perm:public
+	// ExportEDS returns the EDS committed to by the header at the given height, serialized as a
+	// CARv1 file containing the CAR header and the original data square (first quadrant).
+	ExportEDS(ctx context.Context, height uint64) ([]byte, error) //perm:admin
+	// ImportEDS validates the given CARv1 file against the DataHash of the header at the given
+	// height and stores the EDS in the local EDS store. Only supported by bridge and full nodes.
+	ImportEDS(ctx context.Context, height uint64, car []byte) error //perm:admin
 }
 
-// API is a wrapper around Module for the RPC.
-// TODO(@distractedm1nd): These structs need to be autogenerated.
-type API struct {
-	Internal struct {
-		SharesAvailable           func(context.Context, *share.Root) error `perm:"public"`
-		ProbabilityOfAvailability func(context.Context) float64            `perm:"public"`
-		GetShare                  func(
-			ctx context.Context,
-			dah *share.Root,
-			row, col int,
-		) (share.Share, error) `perm:"public"`
-		GetEDS func(
-			ctx context.Context,
-			root *share.Root,
-		) (*rsmt2d.ExtendedDataSquare, error) `perm:"public"`
-		GetSharesByNamespace func(
-			ctx context.Context,
-			root *share.Root,
-			namespace share.Namespace,
-		) (share.NamespacedShares, error) `perm:"public"`
-	}
-}
+type module struct {
+	share.Getter
+	share.Availability
 
-func (api *API) SharesAvailable(ctx context.Context, root *share.Root) error {
-	return api.Internal.SharesAvailable(ctx, root)
+	getByHeight func(context.Context, uint64) (*header.ExtendedHeader, error)
+	// store is only available on bridge and full nodes.
+	store eds.EDSStore
 }
 
-func (api *API) ProbabilityOfAvailability(ctx context.Context) float64 {
-	return api.Internal.ProbabilityOfAvailability(ctx)
+func (m module) SharesAvailable(ctx context.Context, root *share.Root) error {
+	return m.Availability.SharesAvailable(ctx, root)
 }
 
-func (api *API) GetShare(ctx context.Context, dah *share.Root, row, col int) (share.Share, error) {
-	return api.Internal.GetShare(ctx, dah, row, col)
-}
+func (m module) ExportEDS(ctx context.Context, height uint64) ([]byte, error) {
+	hdr, err := m.getByHeight(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("share: getting header at height %d: %w", height, err)
+	}
+	square, err := m.Getter.GetEDS(ctx, hdr.DAH)
+	if err != nil {
+		return nil, fmt.Errorf("share: getting EDS at height %d: %w", height, err)
+	}
 
-func (api *API) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error) {
-	return api.Internal.GetEDS(ctx, root)
+	// parity shares and proofs are omitted, as they are recomputed on import anyway
+	buf := new(bytes.Buffer)
+	if err = eds.WriteODS(ctx, square, buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
-func (api *API) GetSharesByNamespace(
-	ctx context.Context,
-	root *share.Root,
-	namespace share.Namespace,
-) (share.NamespacedShares, error) {
-	return api.Internal.GetSharesByNamespace(ctx, root, namespace)
-}
+func (m module) ImportEDS(ctx context.Context, height uint64, car []byte) error {
+	if m.store == nil {
+		return errImportNotSupported
+	}
 
-type module struct {
-	share.Getter
-	share.Availability
-}
+	hdr, err := m.getByHeight(ctx, height)
+	if err != nil {
+		return fmt.Errorf("share: getting header at height %d: %w", height, err)
+	}
 
-func (m module) SharesAvailable(ctx context.Context, root *share.Root) error {
-	return m.Availability.SharesAvailable(ctx, root)
+	root := share.DataHash(hdr.DataHash)
+	// ReadEDS recomputes the EDS and fails if it does not match the given root
+	square, err := eds.ReadEDS(ctx, bytes.NewReader(car), root)
+	if err != nil {
+		return err
+	}
+
+	err = m.store.Put(ctx, root, square)
+	if errors.Is(err, dagstore.ErrShardExists) {
+		return nil
+	}
+	return err
 }
@@ -11,8 +11,12 @@ import (
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
 
+	"github.com/celestiaorg/celestia-node/header"
+	"github.com/celestiaorg/celestia-node/header/headertest"
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/getters"
 )
 
 func Test_EmptyCARExists(t *testing.T) {
@@ -44,3 +48,61 @@ func Test_EmptyCARExists(t *testing.T) {
 	assert.Equal(t, eds.Flattened(), emptyEds.Flattened())
 	assert.NoError(t, err)
 }
+
+func Test_ExportImportEDS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	square := edstest.RandEDS(t, 8)
+	eh := headertest.ExtendedHeaderFromEDS(t, 1, square)
+	getByHeight := func(context.Context, uint64) (*header.ExtendedHeader, error) {
+		return eh, nil
+	}
+
+	srcStore := newTestStore(ctx, t)
+	err := srcStore.Put(ctx, eh.DAH.Hash(), square)
+	require.NoError(t, err)
+	src := &module{
+		Getter:      getters.NewStoreGetter(srcStore),
+		getByHeight: getByHeight,
+	}
+
+	car, err := src.ExportEDS(ctx, 1)
+	require.NoError(t, err)
+
+	dstStore := newTestStore(ctx, t)
+	dst := &module{
+		getByHeight: getByHeight,
+		store:       dstStore,
+	}
+
+	err = dst.ImportEDS(ctx, 1, car)
+	require.NoError(t, err)
+	imported, err := dstStore.Get(ctx, eh.DAH.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, square.Flattened(), imported.Flattened())
+
+	// importing an EDS which does not match the header should fail
+	dst.getByHeight = func(context.Context, uint64) (*header.ExtendedHeader, error) {
+		return headertest.ExtendedHeaderFromEDS(t, 2, edstest.RandEDS(t, 8)), nil
+	}
+	err = dst.ImportEDS(ctx, 2, car)
+	assert.Error(t, err)
+
+	// importing on a node without EDS store should fail
+	light := &module{getByHeight: getByHeight}
+	err = light.ImportEDS(ctx, 1, car)
+	assert.ErrorIs(t, err, errImportNotSupported)
+}
+
+func newTestStore(ctx context.Context, t *testing.T) *eds.Store {
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	edsStore, err := eds.NewStore(t.TempDir(), ds)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = edsStore.Stop(ctx)
+	})
+	return edsStore
+}
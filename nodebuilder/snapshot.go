@@ -0,0 +1,106 @@
+package nodebuilder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/celestiaorg/celestia-node/libs/snapshot"
+	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+	"github.com/celestiaorg/celestia-node/nodebuilder/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+)
+
+// RestoreSnapshot restores the snapshot created by node.Module.SnapshotCreate in the directory
+// under 'snapshotPath' into the Node Store of the given Node Type 'tp' under 'path'. Checksums of
+// all the files are verified before anything is restored. The Store must be initialized and must
+// not be in use. Snapshots are only restored into a Store with an empty datastore, unless forced,
+// in which case the snapshot is merged into the existing data.
+func RestoreSnapshot(ctx context.Context, path string, tp node.Type, snapshotPath string, force bool) error {
+	dir, err := homedir.Expand(filepath.Clean(snapshotPath))
+	if err != nil {
+		return err
+	}
+
+	manifest, err := snapshot.ReadManifest(dir)
+	if err != nil {
+		return err
+	}
+	if manifest.NodeType != tp.String() {
+		return fmt.Errorf("node: snapshot of %s node can't be restored into %s node", manifest.NodeType, tp)
+	}
+	log.Infow("Verifying snapshot", "path", dir, "files", len(manifest.Files))
+	if err = manifest.Verify(dir); err != nil {
+		return err
+	}
+
+	store, err := OpenStore(path, nil)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	cfg, err := store.Config()
+	if err != nil {
+		return err
+	}
+	if cfg.Share.EDSStoreBackend == share.ODSBackend {
+		return errors.New("node: snapshots are only supported by the dagstore EDS store backend")
+	}
+
+	ds, err := store.Datastore()
+	if err != nil {
+		return err
+	}
+	if !force {
+		empty, err := isEmpty(ctx, ds)
+		if err != nil {
+			return err
+		}
+		if !empty {
+			return fmt.Errorf("node: store under %s already contains data, restoring requires force", path)
+		}
+	}
+
+	f, err := os.Open(filepath.Join(dir, node.DatastoreDumpName))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	err = snapshot.LoadDatastore(ctx, ds, f)
+	if err != nil {
+		return fmt.Errorf("node: restoring datastore: %w", err)
+	}
+
+	// light nodes don't have dagstore paths
+	if tp != node.Light {
+		err = eds.RestoreSnapshot(ctx, dir, store.Path(), ds)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infow("Restored snapshot", "from", manifest.FromHeight, "to", manifest.ToHeight,
+		"eds", manifest.EDSCount)
+	return nil
+}
+
+// isEmpty reports whether the datastore has no entries.
+func isEmpty(ctx context.Context, ds datastore.Read) (bool, error) {
+	results, err := ds.Query(ctx, query.Query{KeysOnly: true, Limit: 1})
+	if err != nil {
+		return false, fmt.Errorf("node: querying datastore: %w", err)
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return false, fmt.Errorf("node: querying datastore: %w", err)
+	}
+	return len(entries) == 0, nil
+}
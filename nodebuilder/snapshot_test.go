@@ -0,0 +1,61 @@
+package nodebuilder
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/libs/snapshot"
+	"github.com/celestiaorg/celestia-node/nodebuilder/node"
+)
+
+func TestRestoreSnapshot_NonEmptyStore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	key, value := datastore.NewKey("/key"), []byte("value")
+	src := datastore.NewMapDatastore()
+	err := src.Put(ctx, key, value)
+	require.NoError(t, err)
+
+	snapshotDir := t.TempDir()
+	dsFile, err := snapshot.WriteFile(snapshotDir, node.DatastoreDumpName, func(w io.Writer) error {
+		return snapshot.DumpDatastore(ctx, src, w)
+	})
+	require.NoError(t, err)
+	err = snapshot.WriteManifest(snapshotDir, &snapshot.Manifest{
+		Version:  snapshot.Version,
+		NodeType: node.Light.String(),
+		Files:    []snapshot.File{dsFile},
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	err = Init(*DefaultConfig(node.Light), dir, node.Light)
+	require.NoError(t, err)
+
+	err = RestoreSnapshot(ctx, dir, node.Light, snapshotDir, false)
+	require.NoError(t, err)
+
+	// the store is not empty anymore, so restoring again requires force
+	err = RestoreSnapshot(ctx, dir, node.Light, snapshotDir, false)
+	require.Error(t, err)
+	err = RestoreSnapshot(ctx, dir, node.Light, snapshotDir, true)
+	require.NoError(t, err)
+
+	store, err := OpenStore(dir, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, store.Close())
+	})
+	ds, err := store.Datastore()
+	require.NoError(t, err)
+	got, err := ds.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, value, got)
+}
@@ -0,0 +1,94 @@
+// Code generated by api/gen. DO NOT EDIT.
+
+package state
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/celestiaorg/celestia-node/blob"
+	"github.com/celestiaorg/celestia-node/state"
+)
+
+// ModuleVersion is the version of the Module API. It is served under the "state.v1"
+// RPC namespace.
+const ModuleVersion = 1
+
+var _ Module = (*API)(nil)
+
+// API is a wrapper around Module for the RPC.
+type API struct {
+	Internal struct {
+		IsStopped                 func(ctx context.Context) bool                                                                                                                                 `perm:"public"`
+		AccountAddress            func(ctx context.Context) (state.Address, error)                                                                                                               `perm:"read"`
+		Balance                   func(ctx context.Context) (*state.Balance, error)                                                                                                              `perm:"read"`
+		BalanceForAddress         func(ctx context.Context, addr state.Address) (*state.Balance, error)                                                                                          `perm:"public"`
+		Transfer                  func(ctx context.Context, to state.AccAddress, amount state.Int, fee state.Int, gasLimit uint64) (*state.TxResponse, error)                                    `perm:"write"`
+		SubmitTx                  func(ctx context.Context, tx state.Tx) (*state.TxResponse, error)                                                                                              `perm:"write"`
+		SubmitPayForBlob          func(ctx context.Context, fee state.Int, gasLim uint64, blobs []*blob.Blob) (*state.TxResponse, error)                                                         `perm:"write"`
+		CancelUnbondingDelegation func(ctx context.Context, valAddr state.ValAddress, amount state.Int, height state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error)               `perm:"write"`
+		BeginRedelegate           func(ctx context.Context, srcValAddr state.ValAddress, dstValAddr state.ValAddress, amount state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error) `perm:"write"`
+		Undelegate                func(ctx context.Context, delAddr state.ValAddress, amount state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error)                                 `perm:"write"`
+		Delegate                  func(ctx context.Context, delAddr state.ValAddress, amount state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error)                                 `perm:"write"`
+		QueryDelegation           func(ctx context.Context, valAddr state.ValAddress) (*types.QueryDelegationResponse, error)                                                                    `perm:"public"`
+		QueryUnbonding            func(ctx context.Context, valAddr state.ValAddress) (*types.QueryUnbondingDelegationResponse, error)                                                           `perm:"public"`
+		QueryRedelegations        func(ctx context.Context, srcValAddr state.ValAddress, dstValAddr state.ValAddress) (*types.QueryRedelegationsResponse, error)                                 `perm:"public"`
+	}
+}
+
+func (api *API) IsStopped(ctx context.Context) bool {
+	return api.Internal.IsStopped(ctx)
+}
+
+func (api *API) AccountAddress(ctx context.Context) (state.Address, error) {
+	return api.Internal.AccountAddress(ctx)
+}
+
+func (api *API) Balance(ctx context.Context) (*state.Balance, error) {
+	return api.Internal.Balance(ctx)
+}
+
+func (api *API) BalanceForAddress(ctx context.Context, addr state.Address) (*state.Balance, error) {
+	return api.Internal.BalanceForAddress(ctx, addr)
+}
+
+func (api *API) Transfer(ctx context.Context, to state.AccAddress, amount state.Int, fee state.Int, gasLimit uint64) (*state.TxResponse, error) {
+	return api.Internal.Transfer(ctx, to, amount, fee, gasLimit)
+}
+
+func (api *API) SubmitTx(ctx context.Context, tx state.Tx) (*state.TxResponse, error) {
+	return api.Internal.SubmitTx(ctx, tx)
+}
+
+func (api *API) SubmitPayForBlob(ctx context.Context, fee state.Int, gasLim uint64, blobs []*blob.Blob) (*state.TxResponse, error) {
+	return api.Internal.SubmitPayForBlob(ctx, fee, gasLim, blobs)
+}
+
+func (api *API) CancelUnbondingDelegation(ctx context.Context, valAddr state.ValAddress, amount state.Int, height state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error) {
+	return api.Internal.CancelUnbondingDelegation(ctx, valAddr, amount, height, fee, gasLim)
+}
+
+func (api *API) BeginRedelegate(ctx context.Context, srcValAddr state.ValAddress, dstValAddr state.ValAddress, amount state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error) {
+	return api.Internal.BeginRedelegate(ctx, srcValAddr, dstValAddr, amount, fee, gasLim)
+}
+
+func (api *API) Undelegate(ctx context.Context, delAddr state.ValAddress, amount state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error) {
+	return api.Internal.Undelegate(ctx, delAddr, amount, fee, gasLim)
+}
+
+func (api *API) Delegate(ctx context.Context, delAddr state.ValAddress, amount state.Int, fee state.Int, gasLim uint64) (*state.TxResponse, error) {
+	return api.Internal.Delegate(ctx, delAddr, amount, fee, gasLim)
+}
+
+func (api *API) QueryDelegation(ctx context.Context, valAddr state.ValAddress) (*types.QueryDelegationResponse, error) {
+	return api.Internal.QueryDelegation(ctx, valAddr)
+}
+
+func (api *API) QueryUnbonding(ctx context.Context, valAddr state.ValAddress) (*types.QueryUnbondingDelegationResponse, error) {
+	return api.Internal.QueryUnbonding(ctx, valAddr)
+}
+
+func (api *API) QueryRedelegations(ctx context.Context, srcValAddr state.ValAddress, dstValAddr state.ValAddress) (*types.QueryRedelegationsResponse, error) {
+	return api.Internal.QueryRedelegations(ctx, srcValAddr, dstValAddr)
+}
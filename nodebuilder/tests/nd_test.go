@@ -197,7 +197,7 @@ func replaceShareGetter() fx.Option {
 			cascade := make([]share.Getter, 0, 2)
 			cascade = append(cascade, storeGetter)
 			cascade = append(cascade, getters.NewTeeGetter(shrexGetter, store))
-			return getters.NewCascadeGetter(cascade)
+			return getters.NewCascadeGetter(cascade, getters.DefaultCascadeParameters())
 		},
 	))
 }
@@ -18,7 +18,7 @@ var log = logging.Logger("share/full")
 // recovery technique. It is considered "full" because it is required
 // to download enough shares to fully reconstruct the data square.
 type ShareAvailability struct {
-	store  *eds.Store
+	store  eds.EDSStore
 	getter share.Getter
 	disc   *discovery.Discovery
 
@@ -27,7 +27,7 @@ type ShareAvailability struct {
 
 // NewShareAvailability creates a new full ShareAvailability.
 func NewShareAvailability(
-	store *eds.Store,
+	store eds.EDSStore,
 	getter share.Getter,
 	disc *discovery.Discovery,
 ) *ShareAvailability {
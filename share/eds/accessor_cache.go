@@ -116,13 +116,30 @@ func (bc *blockstoreCache) unsafeAdd(
 	if err != nil {
 		return nil, fmt.Errorf("failed to get blockstore from accessor: %w", err)
 	}
+	return bc.unsafeAddBlockstore(shardContainingCid, accessor, blockStore), nil
+}
 
+// unsafeAddBlockstore adds the given blockstore of a shard accessor to the cache.
+func (bc *blockstoreCache) unsafeAddBlockstore(
+	shardContainingCid shard.Key,
+	accessor *dagstore.ShardAccessor,
+	blockStore dagstore.ReadBlockstore,
+) *accessorWithBlockstore {
 	newAccessor := &accessorWithBlockstore{
 		bs: blockStore,
 		sa: accessor,
 	}
 	bc.cache.Add(shardContainingCid, newAccessor)
-	return newAccessor, nil
+	return newAccessor
+}
+
+// Remove evicts the blockstore for a given shard key from the cache, closing its accessor.
+func (bc *blockstoreCache) Remove(shardContainingCid shard.Key) {
+	lk := &bc.stripedLocks[shardKeyToStriped(shardContainingCid)]
+	lk.Lock()
+	defer lk.Unlock()
+
+	bc.cache.Remove(shardContainingCid)
 }
 
 // shardKeyToStriped returns the index of the lock to use for a given shard key. We use the last
@@ -13,6 +13,7 @@ import (
 	"github.com/ipfs/go-datastore/namespace"
 	dshelp "github.com/ipfs/go-ipfs-ds-help"
 	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/multiformats/go-multihash"
 )
 
 var _ bstore.Blockstore = (*blockstore)(nil)
@@ -31,34 +32,41 @@ var (
 // The intuition here is that each CAR file is its own blockstore, so we need this top level
 // implementation to allow for the blockstore operations to be routed to the underlying stores.
 type blockstore struct {
-	store *Store
-	cache *blockstoreCache
-	ds    datastore.Batching
+	source shardSource
+	ds     datastore.Batching
 }
 
-func newBlockstore(store *Store, cache *blockstoreCache, ds datastore.Batching) *blockstore {
+// shardSource routes blockstore operations to the read-only blockstores of the stored EDSes.
+type shardSource interface {
+	// hasMultihash reports whether any of the stored EDSes contains the given multihash.
+	hasMultihash(ctx context.Context, mh multihash.Multihash) (bool, error)
+	// readBlockstore returns the read-only blockstore of an EDS containing the given multihash, or
+	// ErrNotFound.
+	readBlockstore(ctx context.Context, mh multihash.Multihash) (dagstore.ReadBlockstore, error)
+}
+
+func newBlockstore(source shardSource, ds datastore.Batching) *blockstore {
 	return &blockstore{
-		store: store,
-		cache: cache,
-		ds:    namespace.Wrap(ds, blockstoreCacheKey),
+		source: source,
+		ds:     namespace.Wrap(ds, blockstoreCacheKey),
 	}
 }
 
 func (bs *blockstore) Has(ctx context.Context, cid cid.Cid) (bool, error) {
-	keys, err := bs.store.dgstr.ShardsContainingMultihash(ctx, cid.Hash())
-	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrNotFoundInIndex) {
-		// key wasn't found in top level blockstore, but could be in datastore while being reconstructed
-		dsHas, dsErr := bs.ds.Has(ctx, dshelp.MultihashToDsKey(cid.Hash()))
-		if dsErr != nil {
-			return false, nil
-		}
-		return dsHas, nil
-	}
+	has, err := bs.source.hasMultihash(ctx, cid.Hash())
 	if err != nil {
 		return false, err
 	}
+	if has {
+		return true, nil
+	}
 
-	return len(keys) > 0, nil
+	// key wasn't found in top level blockstore, but could be in datastore while being reconstructed
+	dsHas, dsErr := bs.ds.Has(ctx, dshelp.MultihashToDsKey(cid.Hash()))
+	if dsErr != nil {
+		return false, nil
+	}
+	return dsHas, nil
 }
 
 func (bs *blockstore) Get(ctx context.Context, cid cid.Cid) (blocks.Block, error) {
@@ -140,7 +148,25 @@ func (bs *blockstore) HashOnRead(bool) {
 
 // getReadOnlyBlockstore finds the underlying blockstore of the shard that contains the given CID.
 func (bs *blockstore) getReadOnlyBlockstore(ctx context.Context, cid cid.Cid) (dagstore.ReadBlockstore, error) {
-	keys, err := bs.store.dgstr.ShardsContainingMultihash(ctx, cid.Hash())
+	return bs.source.readBlockstore(ctx, cid.Hash())
+}
+
+// hasMultihash implements shardSource over the DAGStore inverted index.
+func (s *Store) hasMultihash(ctx context.Context, mh multihash.Multihash) (bool, error) {
+	keys, err := s.dgstr.ShardsContainingMultihash(ctx, mh)
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrNotFoundInIndex) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return len(keys) > 0, nil
+}
+
+// readBlockstore implements shardSource over the DAGStore inverted index and shard accessors.
+func (s *Store) readBlockstore(ctx context.Context, mh multihash.Multihash) (dagstore.ReadBlockstore, error) {
+	keys, err := s.dgstr.ShardsContainingMultihash(ctx, mh)
 	if errors.Is(err, datastore.ErrNotFound) || errors.Is(err, ErrNotFoundInIndex) {
 		return nil, ErrNotFound
 	}
@@ -150,7 +176,7 @@ func (bs *blockstore) getReadOnlyBlockstore(ctx context.Context, cid cid.Cid) (d
 
 	// a share can exist in multiple EDSes, so just take the first one.
 	shardKey := keys[0]
-	accessor, err := bs.store.getCachedAccessor(ctx, shardKey)
+	accessor, err := s.getCachedAccessor(ctx, shardKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get accessor for shard %s: %w", shardKey, err)
 	}
@@ -54,6 +54,27 @@ func WriteEDS(ctx context.Context, eds *rsmt2d.ExtendedDataSquare, w io.Writer)
 	return nil
 }
 
+// WriteODS writes only the ODS of the EDS into the given io.Writer as CARv1 file. Parity shares
+// and inner nodes of the NMT tree are omitted, so they have to be recomputed out of the ODS.
+// Order: [ Carv1Header | Q1 ]
+func WriteODS(ctx context.Context, eds *rsmt2d.ExtendedDataSquare, w io.Writer) (err error) {
+	_, span := tracer.Start(ctx, "write-ods")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	err = writeHeader(eds, w)
+	if err != nil {
+		return fmt.Errorf("share: writing carv1 header: %w", err)
+	}
+	odsWidth := eds.Width() / 2
+	err = writeLeaves(quadrantOrder(eds)[:odsWidth*odsWidth], w)
+	if err != nil {
+		return fmt.Errorf("share: writing shares: %w", err)
+	}
+	return nil
+}
+
 // writeHeader creates a CarV1 header using the EDS's Row and Column roots as the list of DAG roots.
 func writeHeader(eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
 	rootCids, err := rootsToCids(eds)
@@ -69,16 +90,16 @@ func writeHeader(eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
 
 // writeQuadrants reorders the shares to quadrant order and writes them to the CARv1 file.
 func writeQuadrants(eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
+	return writeLeaves(quadrantOrder(eds), w)
+}
+
+// writeLeaves writes the given namespace-prefixed shares to the CARv1 file as NMT leaves.
+func writeLeaves(shares [][]byte, w io.Writer) error {
 	hasher := nmt.NewNmtHasher(sha256.New(), share.NamespaceSize, ipld.NMTIgnoreMaxNamespace)
-	shares := quadrantOrder(eds)
 	for _, share := range shares {
-		leaf, err := hasher.HashLeaf(share)
-		if err != nil {
-			return fmt.Errorf("hashing share: %w", err)
-		}
-		cid, err := ipld.CidFromNamespacedSha256(leaf)
+		cid, err := leafCid(hasher, share)
 		if err != nil {
-			return fmt.Errorf("getting cid from share: %w", err)
+			return err
 		}
 		err = util.LdWrite(w, cid.Bytes(), share)
 		if err != nil {
@@ -88,6 +109,19 @@ func writeQuadrants(eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
 	return nil
 }
 
+// leafCid computes the CID of the NMT leaf of the given namespace-prefixed share.
+func leafCid(hasher *nmt.NmtHasher, share []byte) (cid.Cid, error) {
+	leaf, err := hasher.HashLeaf(share)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("hashing share: %w", err)
+	}
+	id, err := ipld.CidFromNamespacedSha256(leaf)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("getting cid from share: %w", err)
+	}
+	return id, nil
+}
+
 // writeProofs iterates over the in-memory blockstore's keys and writes all inner nodes to the
 // CARv1 file.
 func writeProofs(ctx context.Context, eds *rsmt2d.ExtendedDataSquare, w io.Writer) error {
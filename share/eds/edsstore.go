@@ -0,0 +1,97 @@
+package eds
+
+import (
+	"context"
+	"io"
+
+	"github.com/filecoin-project/dagstore"
+	bstore "github.com/ipfs/boxo/blockstore"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+var (
+	_ EDSStore = (*Store)(nil)
+	_ EDSStore = (*ODSStore)(nil)
+)
+
+// EDSStore is a storage backend of EDSes keyed by their DataHash. Methods return ErrNotFound if
+// the requested EDS is not stored.
+type EDSStore interface {
+	// Put stores the given EDS, trusting it is committed to by the given DataHash. It returns
+	// dagstore.ErrShardExists if the EDS is already stored.
+	Put(ctx context.Context, root share.DataHash, square *rsmt2d.ExtendedDataSquare) error
+	// Get reads the whole EDS out of the store.
+	Get(ctx context.Context, root share.DataHash) (*rsmt2d.ExtendedDataSquare, error)
+	// GetCAR returns a reader of a CARv1 file starting with the header and the ODS shares of the
+	// EDS, as served over shrex/eds.
+	GetCAR(ctx context.Context, root share.DataHash) (io.Reader, error)
+	// GetDAH returns the DataAvailabilityHeader of the EDS.
+	GetDAH(ctx context.Context, root share.DataHash) (*share.Root, error)
+	// GetSharesByNamespace returns all the shares of the EDS within the given namespace, together
+	// with their inclusion proofs.
+	GetSharesByNamespace(
+		ctx context.Context,
+		root *share.Root,
+		namespace share.Namespace,
+	) (share.NamespacedShares, error)
+	// GetRowsByNamespace passes the rows of the EDS within the range [fromRow, toRow) containing
+	// the given namespace to put one by one, together with their inclusion proofs. Zero toRow means
+	// the end of the square.
+	GetRowsByNamespace(
+		ctx context.Context,
+		root *share.Root,
+		namespace share.Namespace,
+		fromRow, toRow int,
+		put func(row int, nr share.NamespacedRow) error,
+	) error
+	// GetShare returns the share of the EDS under the given coordinates.
+	GetShare(ctx context.Context, root *share.Root, row, col int) (share.Share, error)
+	// Has checks whether the EDS is stored.
+	Has(ctx context.Context, root share.DataHash) (bool, error)
+	// Remove removes the EDS from the store.
+	Remove(ctx context.Context, root share.DataHash) error
+	// List lists the DataHashes of all the stored EDSes.
+	List() ([]share.DataHash, error)
+	// Blockstore returns an IPFS blockstore providing access to the shares and the NMT nodes of all
+	// the stored EDSes.
+	Blockstore() bstore.Blockstore
+}
+
+// getShare reads the share under the given coordinates out of the blockstore of a single EDS.
+func getShare(
+	ctx context.Context,
+	bs dagstore.ReadBlockstore,
+	root *share.Root,
+	row, col int,
+) (share.Share, error) {
+	rootCid, leaf := ipld.Translate(root, row, col)
+	return ipld.GetShare(ctx, NewBlockGetter(bs), rootCid, leaf, len(root.RowRoots))
+}
+
+// getSharesByNamespace reads the shares within the given namespace out of the blockstore of a
+// single EDS.
+func getSharesByNamespace(
+	ctx context.Context,
+	bs dagstore.ReadBlockstore,
+	root *share.Root,
+	namespace share.Namespace,
+) (share.NamespacedShares, error) {
+	return ipld.CollectSharesByNamespace(ctx, NewBlockGetter(bs), root, namespace)
+}
+
+// getRowsByNamespace reads the rows within the given namespace out of the blockstore of a single
+// EDS one by one.
+func getRowsByNamespace(
+	ctx context.Context,
+	bs dagstore.ReadBlockstore,
+	root *share.Root,
+	namespace share.Namespace,
+	fromRow, toRow int,
+	put func(row int, nr share.NamespacedRow) error,
+) error {
+	return ipld.CollectRowsByNamespace(ctx, NewBlockGetter(bs), root, namespace, fromRow, toRow, put)
+}
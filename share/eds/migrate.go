@@ -0,0 +1,65 @@
+package eds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/filecoin-project/dagstore"
+)
+
+// MigrateReport summarizes a migration done by Migrate.
+type MigrateReport struct {
+	// Migrated is the amount of EDSes copied into the destination store.
+	Migrated int
+	// Skipped is the amount of EDSes already present in the destination store.
+	Skipped int
+	// Removed is the amount of EDSes removed from the source store.
+	Removed int
+}
+
+// Migrate copies all the EDSes of one EDSStore into another, skipping the ones already stored in
+// the destination. If remove is set, EDSes are removed from the source once they are stored in the
+// destination. Both stores must be started.
+func Migrate(ctx context.Context, from, to EDSStore, remove bool) (*MigrateReport, error) {
+	hashes, err := from.List()
+	if err != nil {
+		return nil, fmt.Errorf("eds/migrate: listing EDSes: %w", err)
+	}
+
+	report := &MigrateReport{}
+	for _, hash := range hashes {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		square, err := from.Get(ctx, hash)
+		if errors.Is(err, ErrNotFound) {
+			// removed in the meantime
+			continue
+		}
+		if err != nil {
+			return report, fmt.Errorf("eds/migrate: reading %s: %w", hash, err)
+		}
+
+		err = to.Put(ctx, hash, square)
+		switch {
+		case errors.Is(err, dagstore.ErrShardExists):
+			report.Skipped++
+		case err != nil:
+			return report, fmt.Errorf("eds/migrate: storing %s: %w", hash, err)
+		default:
+			report.Migrated++
+		}
+
+		if !remove {
+			continue
+		}
+		err = from.Remove(ctx, hash)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return report, fmt.Errorf("eds/migrate: removing %s: %w", hash, err)
+		}
+		report.Removed++
+	}
+	return report, nil
+}
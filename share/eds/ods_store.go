@@ -0,0 +1,563 @@
+package eds
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/filecoin-project/dagstore"
+	"github.com/filecoin-project/dagstore/shard"
+	lru "github.com/hashicorp/golang-lru"
+	bstore "github.com/ipfs/boxo/blockstore"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipld/go-car"
+	"github.com/multiformats/go-multihash"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/libs/utils"
+	"github.com/celestiaorg/celestia-node/share"
+	shareipld "github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+const (
+	odsPath = "/ods/"
+
+	// odsFileVersion is the version of the ODS file format:
+	// [ version | ODS width | row roots | column roots | ODS shares in row-major order ]
+	odsFileVersion = 1
+	// odsHeaderSize is the size of the version and the ODS width in the ODS file.
+	odsHeaderSize = 1 + 4
+	// rootSize is the size of the NMT roots of the EDS rows and columns.
+	rootSize = 2*share.NamespaceSize + sha256.Size
+
+	// odsTmpSuffix is the suffix of ODS files being written.
+	odsTmpSuffix = ".tmp"
+
+	// defaultSquareCacheSize is the amount of recomputed EDSes cached in memory.
+	defaultSquareCacheSize = 16
+)
+
+// ODSStore is an EDSStore keeping only the ODS of each EDS in a compact flat file, together with
+// its DataAvailabilityHeader. Parity shares and NMT proofs are recomputed on the fly, and the most
+// recently recomputed EDSes are cached in memory.
+//
+// The EDSes are indexed by the multihashes of their NMT nodes in the same way as in Store, so
+// that the Blockstore can serve them over Bitswap.
+type ODSStore struct {
+	basepath string
+
+	invertedIdx *simpleInvertedIndex
+	// cache maps DataHashes to recently recomputed squares.
+	cache *lru.Cache
+	bs    bstore.Blockstore
+}
+
+// recomputedSquare is an EDS recomputed out of its ODS file.
+type recomputedSquare struct {
+	eds *rsmt2d.ExtendedDataSquare
+
+	// lk guards the blockstore of the square. Failed computations are retried on the next request.
+	lk     sync.Mutex
+	blocks squareBlockstore
+}
+
+// NewODSStore creates a new ODSStore under the given basepath and datastore.
+func NewODSStore(basepath string, ds datastore.Batching) (*ODSStore, error) {
+	basepath += odsPath
+	err := os.MkdirAll(basepath+blocksPath, os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ods directory: %w", err)
+	}
+
+	invertedIdx, err := newSimpleInvertedIndex(basepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create index: %w", err)
+	}
+
+	cache, err := lru.New(defaultSquareCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create square cache: %w", err)
+	}
+
+	store := &ODSStore{
+		basepath:    basepath,
+		invertedIdx: invertedIdx,
+		cache:       cache,
+	}
+	store.bs = newBlockstore(store, ds)
+	return store, nil
+}
+
+func (s *ODSStore) Start(context.Context) error {
+	return nil
+}
+
+// Stop closes the inverted index.
+func (s *ODSStore) Stop(context.Context) error {
+	return s.invertedIdx.close()
+}
+
+// Put stores the ODS of the given data square with DataRoot's hash as a key, and indexes the
+// NMT nodes of the whole square.
+func (s *ODSStore) Put(ctx context.Context, root share.DataHash, square *rsmt2d.ExtendedDataSquare) (err error) {
+	ctx, span := tracer.Start(ctx, "ods-store/put", trace.WithAttributes(
+		attribute.Int("width", int(square.Width())),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	if has, _ := s.Has(ctx, root); has {
+		return dagstore.ErrShardExists
+	}
+
+	dah, err := da.NewDataAvailabilityHeader(square)
+	if err != nil {
+		return err
+	}
+
+	path := s.path(root)
+	f, err := os.CreateTemp(s.basepath+blocksPath, root.String()+"*"+odsTmpSuffix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	w := bufio.NewWriter(f)
+	err = writeODSFile(w, square, &dah)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err = errors.Join(err, f.Close()); err != nil {
+		return fmt.Errorf("failed to write ODS file: %w", err)
+	}
+
+	// proofs collected in the context, if any, belong to this square
+	bs, err := newSquareBlockstore(ctx, square)
+	if err != nil {
+		return err
+	}
+	// the file is only moved into place once indexed, as Has reports the square as stored from then
+	err = s.invertedIdx.AddMultihashesForShard(ctx, bs, shard.KeyFromString(root.String()))
+	if err != nil {
+		return fmt.Errorf("failed to index ODS file: %w", err)
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to write ODS file: %w", err)
+	}
+	s.cache.Add(root.String(), &recomputedSquare{eds: square, blocks: bs})
+	return nil
+}
+
+// Get reads the ODS out of the store and recomputes the EDS, verifying its integrity.
+func (s *ODSStore) Get(ctx context.Context, root share.DataHash) (eds *rsmt2d.ExtendedDataSquare, err error) {
+	ctx, span := tracer.Start(ctx, "ods-store/get")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	sq, err := s.getSquare(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	return sq.eds, nil
+}
+
+// GetCAR returns a reader of a CARv1 file with the header and the ODS shares of the EDS, read
+// directly out of the ODS file.
+func (s *ODSStore) GetCAR(ctx context.Context, root share.DataHash) (r io.Reader, err error) {
+	_, span := tracer.Start(ctx, "ods-store/get-car")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	dah, shares, err := s.readODSFile(root)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCids := make([]cid.Cid, 0, len(dah.RowRoots)+len(dah.ColumnRoots))
+	for _, r := range roots(dah) {
+		id, err := shareipld.CidFromNamespacedSha256(r)
+		if err != nil {
+			return nil, err
+		}
+		rootCids = append(rootCids, id)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	err = car.WriteHeader(&car.CarHeader{Roots: rootCids, Version: 1}, buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write car header: %w", err)
+	}
+	leaves := make([][]byte, len(shares))
+	for i, shr := range shares {
+		leaves[i] = prependNamespace(0, shr)
+	}
+	if err = writeLeaves(leaves, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// GetDAH returns the DataAvailabilityHeader stored in the ODS file.
+func (s *ODSStore) GetDAH(ctx context.Context, root share.DataHash) (dah *share.Root, err error) {
+	_, span := tracer.Start(ctx, "ods-store/get-dah")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	f, err := s.open(root)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	_, dah, err = readODSHeader(bufio.NewReader(f))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(dah.Hash(), root) {
+		return nil, fmt.Errorf("eds/ods-store: content integrity mismatch from ODS file for root %x", root)
+	}
+	return dah, nil
+}
+
+// GetSharesByNamespace returns all the shares within the given namespace, with the proofs
+// recomputed out of the ODS.
+func (s *ODSStore) GetSharesByNamespace(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+) (shares share.NamespacedShares, err error) {
+	ctx, span := tracer.Start(ctx, "ods-store/get-shares-by-namespace", trace.WithAttributes(
+		attribute.String("namespace", namespace.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	sq, err := s.getSquare(ctx, root.Hash())
+	if err != nil {
+		return nil, err
+	}
+	bs, err := sq.blockstore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return getSharesByNamespace(ctx, bs, root, namespace)
+}
+
+// GetRowsByNamespace passes the rows within the given namespace and row range of the recomputed
+// EDS to put one by one.
+func (s *ODSStore) GetRowsByNamespace(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+	fromRow, toRow int,
+	put func(row int, nr share.NamespacedRow) error,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "ods-store/get-rows-by-namespace", trace.WithAttributes(
+		attribute.String("namespace", namespace.String()),
+		attribute.Int("from_row", fromRow),
+		attribute.Int("to_row", toRow),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	sq, err := s.getSquare(ctx, root.Hash())
+	if err != nil {
+		return err
+	}
+	bs, err := sq.blockstore(ctx)
+	if err != nil {
+		return err
+	}
+	return getRowsByNamespace(ctx, bs, root, namespace, fromRow, toRow, put)
+}
+
+// GetShare returns the share under the given coordinates of the recomputed EDS.
+func (s *ODSStore) GetShare(ctx context.Context, root *share.Root, row, col int) (sh share.Share, err error) {
+	ctx, span := tracer.Start(ctx, "ods-store/get-share", trace.WithAttributes(
+		attribute.Int("row", row),
+		attribute.Int("col", col),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	sq, err := s.getSquare(ctx, root.Hash())
+	if err != nil {
+		return nil, err
+	}
+	width := int(sq.eds.Width())
+	if row < 0 || row >= width || col < 0 || col >= width {
+		return nil, share.ErrOutOfBounds
+	}
+	return sq.eds.GetCell(uint(row), uint(col)), nil
+}
+
+// Has checks if the ODS file exists for the given share.Root hash.
+func (s *ODSStore) Has(_ context.Context, root share.DataHash) (bool, error) {
+	_, err := os.Stat(s.path(root))
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, os.ErrNotExist):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Remove removes the ODS file of the given share.Root hash. Similarly to Store, the inverted
+// index entries are left behind.
+func (s *ODSStore) Remove(ctx context.Context, root share.DataHash) (err error) {
+	_, span := tracer.Start(ctx, "ods-store/remove")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	s.cache.Remove(root.String())
+	err = os.Remove(s.path(root))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove ODS file: %w", err)
+	}
+	return nil
+}
+
+// List lists all the stored EDSes.
+func (s *ODSStore) List() ([]share.DataHash, error) {
+	entries, err := os.ReadDir(s.basepath + blocksPath)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]share.DataHash, 0, len(entries))
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), odsTmpSuffix) {
+			continue
+		}
+		hash, err := hex.DecodeString(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// Blockstore returns an IPFS blockstore providing access to the shares and the NMT nodes of all
+// the stored EDSes.
+func (s *ODSStore) Blockstore() bstore.Blockstore {
+	return s.bs
+}
+
+// hasMultihash implements shardSource over the inverted index.
+func (s *ODSStore) hasMultihash(ctx context.Context, mh multihash.Multihash) (bool, error) {
+	_, err := s.invertedIdx.GetShardsForMultihash(ctx, mh)
+	if errors.Is(err, ErrNotFoundInIndex) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// readBlockstore implements shardSource over the inverted index and the recomputed squares.
+func (s *ODSStore) readBlockstore(ctx context.Context, mh multihash.Multihash) (dagstore.ReadBlockstore, error) {
+	keys, err := s.invertedIdx.GetShardsForMultihash(ctx, mh)
+	if errors.Is(err, ErrNotFoundInIndex) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ODS containing multihash: %w", err)
+	}
+
+	hash, err := hex.DecodeString(keys[0].String())
+	if err != nil {
+		return nil, err
+	}
+	sq, err := s.getSquare(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return sq.blockstore(ctx)
+}
+
+// getSquare returns the cached square or recomputes it out of the ODS file.
+func (s *ODSStore) getSquare(ctx context.Context, root share.DataHash) (*recomputedSquare, error) {
+	if sq, ok := s.cache.Get(root.String()); ok {
+		return sq.(*recomputedSquare), nil
+	}
+
+	dah, shares, err := s.readODSFile(root)
+	if err != nil {
+		return nil, err
+	}
+	odsWidth := uint64(len(dah.RowRoots) / 2)
+	eds, err := rsmt2d.ComputeExtendedDataSquare(
+		shares,
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(odsWidth),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("eds/ods-store: computing eds: %w", err)
+	}
+
+	newDah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(newDah.Hash(), root) {
+		return nil, fmt.Errorf("eds/ods-store: content integrity mismatch from ODS file for root %x", root)
+	}
+
+	sq := &recomputedSquare{eds: eds}
+	s.cache.Add(root.String(), sq)
+	return sq, nil
+}
+
+// readODSFile reads the DataAvailabilityHeader and the ODS shares out of the ODS file.
+func (s *ODSStore) readODSFile(root share.DataHash) (*share.Root, [][]byte, error) {
+	f, err := s.open(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	odsWidth, dah, err := readODSHeader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shares := make([][]byte, odsWidth*odsWidth)
+	for i := range shares {
+		shares[i] = make([]byte, share.Size)
+		if _, err = io.ReadFull(r, shares[i]); err != nil {
+			return nil, nil, fmt.Errorf("eds/ods-store: reading share %d: %w", i, err)
+		}
+	}
+	return dah, shares, nil
+}
+
+func (s *ODSStore) open(root share.DataHash) (*os.File, error) {
+	f, err := os.Open(s.path(root))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (s *ODSStore) path(root share.DataHash) string {
+	return s.basepath + blocksPath + root.String()
+}
+
+// writeODSFile writes the ODS of the given square with its DataAvailabilityHeader in the ODS file
+// format.
+func writeODSFile(w io.Writer, square *rsmt2d.ExtendedDataSquare, dah *share.Root) error {
+	odsWidth := square.Width() / 2
+	header := make([]byte, odsHeaderSize)
+	header[0] = odsFileVersion
+	binary.BigEndian.PutUint32(header[1:], uint32(odsWidth))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, root := range roots(dah) {
+		if len(root) != rootSize {
+			return fmt.Errorf("eds/ods-store: invalid root size %d", len(root))
+		}
+		if _, err := w.Write(root); err != nil {
+			return err
+		}
+	}
+
+	for row := uint(0); row < odsWidth; row++ {
+		for col := uint(0); col < odsWidth; col++ {
+			shr := square.GetCell(row, col)
+			if len(shr) != share.Size {
+				return fmt.Errorf("eds/ods-store: invalid share size %d", len(shr))
+			}
+			if _, err := w.Write(shr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// roots returns the row roots followed by the column roots of the DataAvailabilityHeader.
+func roots(dah *share.Root) [][]byte {
+	roots := make([][]byte, 0, len(dah.RowRoots)+len(dah.ColumnRoots))
+	roots = append(roots, dah.RowRoots...)
+	return append(roots, dah.ColumnRoots...)
+}
+
+// readODSHeader reads the ODS width and the DataAvailabilityHeader out of the ODS file.
+func readODSHeader(r io.Reader) (int, *share.Root, error) {
+	header := make([]byte, odsHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, fmt.Errorf("eds/ods-store: reading header: %w", err)
+	}
+	if header[0] != odsFileVersion {
+		return 0, nil, fmt.Errorf("eds/ods-store: unsupported ODS file version %d", header[0])
+	}
+	odsWidth := int(binary.BigEndian.Uint32(header[1:]))
+	if odsWidth == 0 || odsWidth > share.MaxSquareSize {
+		return 0, nil, fmt.Errorf("eds/ods-store: invalid ODS width %d", odsWidth)
+	}
+
+	roots := make([][]byte, 4*odsWidth)
+	for i := range roots {
+		roots[i] = make([]byte, rootSize)
+		if _, err := io.ReadFull(r, roots[i]); err != nil {
+			return 0, nil, fmt.Errorf("eds/ods-store: reading roots: %w", err)
+		}
+	}
+	return odsWidth, &share.Root{
+		RowRoots:    roots[:2*odsWidth],
+		ColumnRoots: roots[2*odsWidth:],
+	}, nil
+}
+
+// blockstore returns the blockstore with all the NMT nodes of the square, computing it on first
+// use.
+func (sq *recomputedSquare) blockstore(ctx context.Context) (squareBlockstore, error) {
+	sq.lk.Lock()
+	defer sq.lk.Unlock()
+	if sq.blocks != nil {
+		return sq.blocks, nil
+	}
+
+	// the blockstore is cached for other requests, so it is neither cut short by the cancellation of
+	// this one nor built out of proofs collected in its context, which belong to another EDS
+	ctx = shareipld.CtxWithProofsAdder(context.WithoutCancel(ctx), nil)
+	blocks, err := newSquareBlockstore(ctx, sq.eds)
+	if err != nil {
+		return nil, fmt.Errorf("eds/ods-store: recomputing proofs: %w", err)
+	}
+	sq.blocks = blocks
+	return blocks, nil
+}
@@ -0,0 +1,204 @@
+package eds
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/filecoin-project/dagstore"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+	"github.com/celestiaorg/celestia-node/share/sharetest"
+)
+
+func TestODSStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	store, err := NewODSStore(t.TempDir(), ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+	err = store.Start(ctx)
+	require.NoError(t, err)
+
+	t.Run("PutGet", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+
+		has, err := store.Has(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		err = store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+		err = store.Put(ctx, dah.Hash(), eds)
+		assert.ErrorIs(t, err, dagstore.ErrShardExists)
+
+		has, err = store.Has(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, has)
+
+		got, err := store.Get(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, eds.Equals(got))
+
+		gotDAH, err := store.GetDAH(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, dah.Equals(gotDAH))
+	})
+
+	t.Run("GetShare", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err := store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// parity shares are recomputed out of the ODS
+		width := int(eds.Width())
+		for _, coords := range [][2]int{{0, 0}, {width - 1, width - 1}, {1, width - 1}} {
+			sh, err := store.GetShare(ctx, &dah, coords[0], coords[1])
+			require.NoError(t, err)
+			assert.Equal(t, eds.GetCell(uint(coords[0]), uint(coords[1])), sh)
+		}
+	})
+
+	t.Run("GetSharesByNamespace", func(t *testing.T) {
+		namespace := sharetest.RandV0Namespace()
+		eds, dah := edstest.RandEDSWithNamespace(t, namespace, 4)
+		err := store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		shares, err := store.GetSharesByNamespace(ctx, &dah, namespace)
+		require.NoError(t, err)
+		require.NoError(t, shares.Verify(&dah, namespace))
+		assert.Len(t, shares.Flatten(), 16)
+	})
+
+	t.Run("GetCAR", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err := store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		r, err := store.GetCAR(ctx, dah.Hash())
+		require.NoError(t, err)
+		odsR, err := ODSReader(r)
+		require.NoError(t, err)
+		got, err := ReadEDS(ctx, odsR, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, eds.Equals(got))
+	})
+
+	t.Run("Blockstore", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err := store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		rootCid, leaf := ipld.Translate(&dah, 2, 5)
+		sh, err := ipld.GetShare(ctx, NewBlockGetter(store.Blockstore()), rootCid, leaf, len(dah.RowRoots))
+		require.NoError(t, err)
+		assert.Equal(t, eds.GetCell(2, 5), sh)
+	})
+
+	t.Run("BlockstoreCanceledRequest", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		sq := &recomputedSquare{eds: eds}
+
+		// the blockstore is cached for later requests, so the cancellation of the first must not fail it
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		bs, err := sq.blockstore(canceled)
+		require.NoError(t, err)
+
+		rootCid, leaf := ipld.Translate(&dah, 2, 5)
+		sh, err := ipld.GetShare(ctx, NewBlockGetter(bs), rootCid, leaf, len(dah.RowRoots))
+		require.NoError(t, err)
+		assert.Equal(t, eds.GetCell(2, 5), sh)
+	})
+
+	t.Run("RemoveList", func(t *testing.T) {
+		eds, dah := randomEDS(t)
+		err := store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		hashes, err := store.List()
+		require.NoError(t, err)
+		assert.Contains(t, hashes, share.DataHash(dah.Hash()))
+
+		err = store.Remove(ctx, dah.Hash())
+		require.NoError(t, err)
+
+		hashes, err = store.List()
+		require.NoError(t, err)
+		assert.NotContains(t, hashes, share.DataHash(dah.Hash()))
+		_, err = store.Get(ctx, dah.Hash())
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = store.GetShare(ctx, &dah, 0, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestODSStore_PutIndexFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	store, err := NewODSStore(t.TempDir(), ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+	// indexing fails once the inverted index is closed
+	err = store.Stop(ctx)
+	require.NoError(t, err)
+
+	eds, dah := randomEDS(t)
+	err = store.Put(ctx, dah.Hash(), eds)
+	require.Error(t, err)
+
+	// squares failing to be indexed are not stored, so that Put can be retried
+	has, err := store.Has(ctx, dah.Hash())
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestMigrate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	from, err := newStore(t)
+	require.NoError(t, err)
+	err = from.Start(ctx)
+	require.NoError(t, err)
+	to, err := NewODSStore(t.TempDir(), ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+	err = to.Start(ctx)
+	require.NoError(t, err)
+
+	var dahs []share.Root
+	for i := 0; i < 3; i++ {
+		eds, dah := randomEDS(t)
+		err = from.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+		dahs = append(dahs, dah)
+	}
+	// already present in the destination
+	eds, err := from.Get(ctx, dahs[0].Hash())
+	require.NoError(t, err)
+	err = to.Put(ctx, dahs[0].Hash(), eds)
+	require.NoError(t, err)
+
+	report, err := Migrate(ctx, from, to, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Migrated)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 3, report.Removed)
+
+	for _, dah := range dahs {
+		has, err := from.Has(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		_, err = to.Get(ctx, dah.Hash())
+		assert.False(t, errors.Is(err, ErrNotFound))
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,26 @@
+package eds
+
+// Parameters is the set of Parameters that must be configured for the Store.
+type Parameters struct {
+	// ODSOnly makes the Store persist only the ODS of newly stored EDSes, trading CPU for disk
+	// space. Parity shares and NMT proofs are recomputed on demand, when requested over the
+	// blockstore, and kept in the accessor cache.
+	ODSOnly bool
+}
+
+// Option is a function that configures Store Parameters.
+type Option func(*Parameters)
+
+// DefaultParameters returns the default Parameters' configuration values for the Store.
+func DefaultParameters() Parameters {
+	return Parameters{
+		ODSOnly: false,
+	}
+}
+
+// WithODSOnly is a functional option that configures the Store to persist only the ODS of EDSes.
+func WithODSOnly(odsOnly bool) Option {
+	return func(p *Parameters) {
+		p.ODSOnly = odsOnly
+	}
+}
@@ -0,0 +1,81 @@
+package eds
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// ErrRowRootMismatch is returned by ExtendRow if the recomputed row does not match its row root.
+var ErrRowRootMismatch = errors.New("recomputed row root does not match the row root")
+
+// ExtendRow recomputes the EDS row with the given index out of its left half and verifies it
+// against the row root.
+func ExtendRow(root *share.Root, rowIdx int, half []share.Share) ([]share.Share, error) {
+	odsWidth := len(root.RowRoots) / 2
+	if rowIdx < 0 || rowIdx >= len(root.RowRoots) {
+		return nil, fmt.Errorf("row index %d out of range for width %d", rowIdx, len(root.RowRoots))
+	}
+	if len(half) != odsWidth {
+		return nil, fmt.Errorf("expected %d shares in the row half, got %d", odsWidth, len(half))
+	}
+
+	parity, err := share.DefaultRSMT2DCodec().Encode(half)
+	if err != nil {
+		return nil, fmt.Errorf("extending row %d: %w", rowIdx, err)
+	}
+	row := make([]share.Share, 0, 2*odsWidth)
+	row = append(row, half...)
+	row = append(row, parity...)
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(odsWidth), uint(rowIdx))
+	for _, sh := range row {
+		if err = tree.Push(sh); err != nil {
+			return nil, fmt.Errorf("computing root of row %d: %w", rowIdx, err)
+		}
+	}
+	rowRoot, err := tree.Root()
+	if err != nil {
+		return nil, fmt.Errorf("computing root of row %d: %w", rowIdx, err)
+	}
+	if !bytes.Equal(rowRoot, root.RowRoots[rowIdx]) {
+		return nil, fmt.Errorf("row %d: %w", rowIdx, ErrRowRootMismatch)
+	}
+	return row, nil
+}
+
+// ImportRows rebuilds the EDS out of the given full rows, keyed by their index, and verifies it
+// against the root. Any half of the rows is enough to recompute the missing ones.
+func ImportRows(root *share.Root, rows map[int][]share.Share) (*rsmt2d.ExtendedDataSquare, error) {
+	width := len(root.RowRoots)
+	if len(rows) < width/2 {
+		return nil, fmt.Errorf("not enough rows to rebuild the EDS: %d out of %d", len(rows), width/2)
+	}
+
+	shares := make([][]byte, width*width)
+	for rowIdx, row := range rows {
+		if rowIdx < 0 || rowIdx >= width || len(row) != width {
+			return nil, fmt.Errorf("invalid row %d of %d shares for width %d", rowIdx, len(row), width)
+		}
+		copy(shares[rowIdx*width:], row)
+	}
+
+	square, err := rsmt2d.ImportExtendedDataSquare(
+		shares,
+		share.DefaultRSMT2DCodec(),
+		wrapper.NewConstructor(uint64(width/2)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("importing rows: %w", err)
+	}
+	err = square.Repair(root.RowRoots, root.ColumnRoots)
+	if err != nil {
+		return nil, fmt.Errorf("repairing EDS: %w", err)
+	}
+	return square, nil
+}
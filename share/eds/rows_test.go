@@ -0,0 +1,42 @@
+package eds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+func TestExtendRow(t *testing.T) {
+	eds, dah := randomEDS(t)
+	odsWidth := int(eds.Width() / 2)
+
+	for _, rowIdx := range []int{0, odsWidth, 2*odsWidth - 1} {
+		row, err := ExtendRow(&dah, rowIdx, eds.Row(uint(rowIdx))[:odsWidth])
+		require.NoError(t, err)
+		assert.Equal(t, eds.Row(uint(rowIdx)), row)
+	}
+
+	_, err := ExtendRow(&dah, 1, eds.Row(0)[:odsWidth])
+	assert.ErrorIs(t, err, ErrRowRootMismatch)
+}
+
+func TestImportRows(t *testing.T) {
+	eds, dah := randomEDS(t)
+	odsWidth := int(eds.Width() / 2)
+
+	// the parity rows are enough to rebuild the EDS
+	rows := make(map[int][]share.Share)
+	for rowIdx := odsWidth; rowIdx < 2*odsWidth; rowIdx++ {
+		rows[rowIdx] = eds.Row(uint(rowIdx))
+	}
+	got, err := ImportRows(&dah, rows)
+	require.NoError(t, err)
+	assert.True(t, eds.Equals(got))
+
+	delete(rows, odsWidth)
+	_, err = ImportRows(&dah, rows)
+	assert.Error(t, err)
+}
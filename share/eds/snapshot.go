@@ -0,0 +1,173 @@
+package eds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/filecoin-project/dagstore"
+	"github.com/filecoin-project/dagstore/mount"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+
+	"github.com/celestiaorg/celestia-node/libs/snapshot"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+const (
+	// invertedIndexDumpName is the name of the inverted index dump in a snapshot directory.
+	invertedIndexDumpName = "inverted_index.dump"
+	// indexSuffix is the suffix used by the DAGStore for CAR index files.
+	indexSuffix = ".full.idx"
+)
+
+// Barrier blocks all new Put and Remove operations and waits for in-flight ones to finish. The
+// returned function lifts the barrier. It allows taking a consistent view of the Store while the
+// node keeps running.
+func (s *Store) Barrier() (release func()) {
+	s.barrier.Lock()
+	return s.barrier.Unlock
+}
+
+// Snapshot copies the CAR files of all the registered EDSes, their indices and a dump of the
+// inverted index into the given directory. The list of EDSes and the inverted index are captured
+// behind the Barrier, while the immutable CAR and index files are copied afterwards. The given
+// capture function, if any, is called behind the Barrier as well, so that other components are
+// captured consistently with the Store. Files already present in the directory are skipped, so an
+// interrupted Snapshot can be resumed.
+func (s *Store) Snapshot(
+	ctx context.Context,
+	dir string,
+	capture func() error,
+) ([]share.DataHash, []snapshot.File, error) {
+	release := s.Barrier()
+	hashes, err := s.list()
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	idxFile, err := snapshot.WriteFile(dir, invertedIndexDumpName, func(w io.Writer) error {
+		return snapshot.DumpDatastore(ctx, s.invertedIdx.ds, w)
+	})
+	if err != nil {
+		release()
+		return nil, nil, fmt.Errorf("eds/store: dumping inverted index: %w", err)
+	}
+	if capture != nil {
+		err = capture()
+	}
+	release()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := make([]snapshot.File, 0, len(hashes)*2+1)
+	files = append(files, idxFile)
+	for _, hash := range hashes {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+
+		key := hash.String()
+		carFile, err := snapshot.CopyFile(s.basepath+blocksPath+key, dir, snapshotPath(blocksPath, key))
+		if err != nil {
+			return nil, nil, fmt.Errorf("eds/store: copying CAR file %s: %w", key, err)
+		}
+		idxFile, err := snapshot.CopyFile(s.basepath+indexPath+key+indexSuffix, dir, snapshotPath(indexPath, key+indexSuffix))
+		if err != nil {
+			return nil, nil, fmt.Errorf("eds/store: copying index file %s: %w", key, err)
+		}
+		files = append(files, carFile, idxFile)
+	}
+	return hashes, files, nil
+}
+
+// RestoreSnapshot restores EDSes captured by Store.Snapshot in the given directory into the Store
+// under the given basepath and datastore. The Store must not be running.
+func RestoreSnapshot(ctx context.Context, dir, basepath string, ds datastore.Batching) error {
+	err := setupPath(basepath)
+	if err != nil {
+		return fmt.Errorf("failed to setup eds.Store directories: %w", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, snapshotPath(blocksPath, "")))
+	if err != nil {
+		return fmt.Errorf("eds/store: reading snapshot blocks: %w", err)
+	}
+
+	registry := mount.NewRegistry()
+	err = registry.Register("fs", &inMemoryOnceMount{})
+	if err != nil {
+		return fmt.Errorf("failed to register memory mount on the registry: %w", err)
+	}
+	shards := namespace.Wrap(ds, dagstore.StoreNamespace)
+	batch, err := shards.Batch(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		key := entry.Name()
+		_, err = snapshot.CopyFile(filepath.Join(dir, snapshotPath(blocksPath, key)), basepath, snapshotPath(blocksPath, key))
+		if err != nil {
+			return fmt.Errorf("eds/store: restoring CAR file %s: %w", key, err)
+		}
+		_, err = snapshot.CopyFile(
+			filepath.Join(dir, snapshotPath(indexPath, key+indexSuffix)),
+			basepath,
+			snapshotPath(indexPath, key+indexSuffix),
+		)
+		if err != nil {
+			return fmt.Errorf("eds/store: restoring index file %s: %w", key, err)
+		}
+
+		// register the shard as available, pointing to the restored CAR file
+		u, err := registry.Represent(&inMemoryOnceMount{
+			FileMount: mount.FileMount{Path: basepath + blocksPath + key},
+		})
+		if err != nil {
+			return err
+		}
+		state, err := json.Marshal(dagstore.PersistedShard{
+			Key:   key,
+			URL:   u.String(),
+			State: dagstore.ShardStateAvailable,
+		})
+		if err != nil {
+			return err
+		}
+		if err = batch.Put(ctx, datastore.NewKey(key), state); err != nil {
+			return fmt.Errorf("eds/store: restoring shard state %s: %w", key, err)
+		}
+	}
+	if err = batch.Commit(ctx); err != nil {
+		return err
+	}
+
+	invertedIdx, err := newSimpleInvertedIndex(basepath)
+	if err != nil {
+		return fmt.Errorf("failed to create index: %w", err)
+	}
+	f, err := os.Open(filepath.Join(dir, invertedIndexDumpName))
+	if err != nil {
+		return fmt.Errorf("eds/store: opening inverted index dump: %w", err)
+	}
+	defer f.Close()
+
+	err = snapshot.LoadDatastore(ctx, invertedIdx.ds, f)
+	if err != nil {
+		invertedIdx.close() //nolint:errcheck
+		return fmt.Errorf("eds/store: restoring inverted index: %w", err)
+	}
+	return invertedIdx.close()
+}
+
+// snapshotPath returns the path of the file with the given name, relative to the snapshot
+// directory.
+func snapshotPath(dir, name string) string {
+	return strings.Trim(dir, "/") + "/" + name
+}
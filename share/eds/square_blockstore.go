@@ -0,0 +1,188 @@
+package eds
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/filecoin-project/dagstore"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/multiformats/go-multihash"
+
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/share"
+	shareipld "github.com/celestiaorg/celestia-node/share/ipld"
+)
+
+var (
+	_ dagstore.ReadBlockstore = (squareBlockstore)(nil)
+	_ dagstore.ReadBlockstore = (*parityBlockstore)(nil)
+)
+
+// squareBlockstore is a read-only blockstore over the NMT nodes of a single EDS, keyed by their
+// multihashes.
+type squareBlockstore map[string][]byte
+
+// newSquareBlockstore computes all the NMT nodes of the given EDS.
+func newSquareBlockstore(ctx context.Context, eds *rsmt2d.ExtendedDataSquare) (squareBlockstore, error) {
+	proofs, err := getProofs(ctx, eds)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := quadrantOrder(eds)
+	bs := make(squareBlockstore, len(proofs)+len(leaves))
+	for id, proof := range proofs {
+		bs[string(id.Hash())] = proof
+	}
+	hasher := nmt.NewNmtHasher(sha256.New(), share.NamespaceSize, shareipld.NMTIgnoreMaxNamespace)
+	for _, leaf := range leaves {
+		id, err := leafCid(hasher, leaf)
+		if err != nil {
+			return nil, err
+		}
+		bs[string(id.Hash())] = leaf
+	}
+	return bs, nil
+}
+
+func (bs squareBlockstore) Has(_ context.Context, id cid.Cid) (bool, error) {
+	_, ok := bs[string(id.Hash())]
+	return ok, nil
+}
+
+func (bs squareBlockstore) Get(_ context.Context, id cid.Cid) (blocks.Block, error) {
+	data, ok := bs[string(id.Hash())]
+	if !ok {
+		return nil, ipld.ErrNotFound{Cid: id}
+	}
+	return blocks.NewBlockWithCid(data, id)
+}
+
+func (bs squareBlockstore) GetSize(_ context.Context, id cid.Cid) (int, error) {
+	data, ok := bs[string(id.Hash())]
+	if !ok {
+		return 0, ipld.ErrNotFound{Cid: id}
+	}
+	return len(data), nil
+}
+
+func (bs squareBlockstore) AllKeysChan(context.Context) (<-chan cid.Cid, error) {
+	return nil, errUnsupportedOperation
+}
+
+func (bs squareBlockstore) HashOnRead(bool) {}
+
+// ForEach implements index.MultihashIterator, so that the square can be added to the inverted
+// index.
+func (bs squareBlockstore) ForEach(f func(mh multihash.Multihash) error) error {
+	for mh := range bs {
+		if err := f(multihash.Multihash(mh)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parityBlockstore is the blockstore of an EDS stored in a CAR file without parity shares and
+// NMT proofs. The ODS shares are served straight from the CAR file, while the rest of the EDS is
+// recomputed on the first request for a block missing in the file and kept until the blockstore
+// is evicted from the accessor cache.
+type parityBlockstore struct {
+	car    dagstore.ReadBlockstore
+	reader func() io.Reader
+	root   share.DataHash
+
+	// lk guards the recomputed square. Failed recomputations are retried on the next request.
+	lk     sync.Mutex
+	square squareBlockstore
+}
+
+func newParityBlockstore(
+	car dagstore.ReadBlockstore,
+	reader func() io.Reader,
+	root share.DataHash,
+) *parityBlockstore {
+	return &parityBlockstore{
+		car:    car,
+		reader: reader,
+		root:   root,
+	}
+}
+
+func (bs *parityBlockstore) Has(ctx context.Context, id cid.Cid) (bool, error) {
+	has, err := bs.car.Has(ctx, id)
+	if err != nil || has {
+		return has, err
+	}
+
+	square, err := bs.recompute(ctx)
+	if err != nil {
+		return false, err
+	}
+	return square.Has(ctx, id)
+}
+
+func (bs *parityBlockstore) Get(ctx context.Context, id cid.Cid) (blocks.Block, error) {
+	block, err := bs.car.Get(ctx, id)
+	if !ipld.IsNotFound(err) {
+		return block, err
+	}
+
+	square, err := bs.recompute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return square.Get(ctx, id)
+}
+
+func (bs *parityBlockstore) GetSize(ctx context.Context, id cid.Cid) (int, error) {
+	size, err := bs.car.GetSize(ctx, id)
+	if !ipld.IsNotFound(err) {
+		return size, err
+	}
+
+	square, err := bs.recompute(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return square.GetSize(ctx, id)
+}
+
+func (bs *parityBlockstore) AllKeysChan(context.Context) (<-chan cid.Cid, error) {
+	return nil, errUnsupportedOperation
+}
+
+func (bs *parityBlockstore) HashOnRead(bool) {}
+
+// recompute reads the ODS out of the CAR file and recomputes all the NMT nodes of the EDS, unless
+// already done.
+func (bs *parityBlockstore) recompute(ctx context.Context) (squareBlockstore, error) {
+	bs.lk.Lock()
+	defer bs.lk.Unlock()
+	if bs.square != nil {
+		return bs.square, nil
+	}
+
+	ctx, span := tracer.Start(ctx, "parity-blockstore/recompute")
+	defer span.End()
+	// proofs collected in the request context, if any, belong to another EDS
+	ctx = shareipld.CtxWithProofsAdder(ctx, nil)
+
+	eds, err := ReadEDS(ctx, bs.reader(), bs.root)
+	if err != nil {
+		return nil, fmt.Errorf("eds/store: reading ODS: %w", err)
+	}
+	square, err := newSquareBlockstore(ctx, eds)
+	if err != nil {
+		return nil, fmt.Errorf("eds/store: recomputing proofs: %w", err)
+	}
+	bs.square = square
+	return square, nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -59,6 +60,10 @@ type Store struct {
 	carIdx      index.FullIndexRepo
 	invertedIdx *simpleInvertedIndex
 
+	// barrier is held for reading by Put and Remove, and for writing by Barrier.
+	barrier sync.RWMutex
+
+	params     Parameters
 	basepath   string
 	gcInterval time.Duration
 	// lastGCResult is only stored on the store for testing purposes.
@@ -68,7 +73,12 @@ type Store struct {
 }
 
 // NewStore creates a new EDS Store under the given basepath and datastore.
-func NewStore(basepath string, ds datastore.Batching) (*Store, error) {
+func NewStore(basepath string, ds datastore.Batching, opts ...Option) (*Store, error) {
+	params := DefaultParameters()
+	for _, opt := range opts {
+		opt(&params)
+	}
+
 	err := setupPath(basepath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup eds.Store directories: %w", err)
@@ -111,6 +121,7 @@ func NewStore(basepath string, ds datastore.Batching) (*Store, error) {
 	}
 
 	store := &Store{
+		params:      params,
 		basepath:    basepath,
 		dgstr:       dagStore,
 		carIdx:      fsRepo,
@@ -119,7 +130,7 @@ func NewStore(basepath string, ds datastore.Batching) (*Store, error) {
 		mounts:      r,
 		cache:       cache,
 	}
-	store.bs = newBlockstore(store, cache, ds)
+	store.bs = newBlockstore(store, ds)
 	return store, nil
 }
 
@@ -175,13 +186,17 @@ func (s *Store) gc(ctx context.Context) {
 // Put stores the given data square with DataRoot's hash as a key.
 //
 // The square is verified on the Exchange level, and Put only stores the square, trusting it.
-// The resulting file stores all the shares and NMT Merkle Proofs of the EDS.
-// Additionally, the file gets indexed s.t. store.Blockstore can access them.
+// The resulting file stores all the shares and NMT Merkle Proofs of the EDS, or only the ODS shares
+// if the Store is configured with WithODSOnly. Additionally, the file gets indexed s.t.
+// store.Blockstore can access them.
 func (s *Store) Put(ctx context.Context, root share.DataHash, square *rsmt2d.ExtendedDataSquare) error {
 	ctx, span := tracer.Start(ctx, "store/put", trace.WithAttributes(
 		attribute.Int("width", int(square.Width())),
 	))
 
+	s.barrier.RLock()
+	defer s.barrier.RUnlock()
+
 	tnow := time.Now()
 	err := s.put(ctx, root, square)
 	result := putOK
@@ -215,7 +230,11 @@ func (s *Store) put(ctx context.Context, root share.DataHash, square *rsmt2d.Ext
 		buf:       bytes.NewBuffer(nil),
 		FileMount: mount.FileMount{Path: s.basepath + blocksPath + key},
 	}
-	err = WriteEDS(ctx, square, mount)
+	if s.params.ODSOnly {
+		err = WriteODS(ctx, square, mount)
+	} else {
+		err = WriteEDS(ctx, square, mount)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to write EDS to file: %w", err)
 	}
@@ -240,8 +259,22 @@ func (s *Store) put(ctx context.Context, root share.DataHash, square *rsmt2d.Ext
 		if result.Error != nil {
 			return fmt.Errorf("failed to register shard: %w", result.Error)
 		}
+	}
+
+	if !s.params.ODSOnly {
 		return nil
 	}
+	// the DAGStore indexes only the ODS shares present in the file, so the rest of the square has to
+	// be indexed separately for the blockstore to find it
+	blocks, err := newSquareBlockstore(ctx, square)
+	if err != nil {
+		return fmt.Errorf("failed to compute proofs: %w", err)
+	}
+	err = s.invertedIdx.AddMultihashesForShard(ctx, blocks, shard.KeyFromString(key))
+	if err != nil {
+		return fmt.Errorf("failed to index EDS: %w", err)
+	}
+	return nil
 }
 
 // waitForResult waits for a result from the res channel for a maximum duration specified by
@@ -333,6 +366,71 @@ func (s *Store) carBlockstore(
 	return accessor.bs, nil
 }
 
+// GetShare returns the share under the given coordinates of the EDS identified by the given root
+// through the corresponding CAR-level blockstore.
+func (s *Store) GetShare(ctx context.Context, root *share.Root, row, col int) (sh share.Share, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-share", trace.WithAttributes(
+		attribute.Int("row", row),
+		attribute.Int("col", col),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	bs, err := s.carBlockstore(ctx, root.Hash())
+	if err != nil {
+		return nil, err
+	}
+	return getShare(ctx, bs, root, row, col)
+}
+
+// GetSharesByNamespace returns all the shares within the given namespace of the EDS identified by
+// the given root through the corresponding CAR-level blockstore.
+func (s *Store) GetSharesByNamespace(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+) (shares share.NamespacedShares, err error) {
+	ctx, span := tracer.Start(ctx, "store/get-shares-by-namespace", trace.WithAttributes(
+		attribute.String("namespace", namespace.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	bs, err := s.carBlockstore(ctx, root.Hash())
+	if err != nil {
+		return nil, err
+	}
+	return getSharesByNamespace(ctx, bs, root, namespace)
+}
+
+// GetRowsByNamespace passes the rows within the given namespace and row range of the EDS
+// identified by the given root to put one by one, reading them through the corresponding
+// CAR-level blockstore.
+func (s *Store) GetRowsByNamespace(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+	fromRow, toRow int,
+	put func(row int, nr share.NamespacedRow) error,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "store/get-rows-by-namespace", trace.WithAttributes(
+		attribute.String("namespace", namespace.String()),
+		attribute.Int("from_row", fromRow),
+		attribute.Int("to_row", toRow),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	bs, err := s.carBlockstore(ctx, root.Hash())
+	if err != nil {
+		return err
+	}
+	return getRowsByNamespace(ctx, bs, root, namespace, fromRow, toRow, put)
+}
+
 // GetDAH returns the DataAvailabilityHeader for the EDS identified by DataHash.
 func (s *Store) GetDAH(ctx context.Context, root share.DataHash) (*share.Root, error) {
 	ctx, span := tracer.Start(ctx, "store/car-dah")
@@ -419,15 +517,52 @@ func (s *Store) getCachedAccessor(ctx context.Context, key shard.Key) (*accessor
 		return nil, err
 	}
 
-	a, err := s.cache.unsafeAdd(key, shardAccessor)
-	s.metrics.observeGetAccessor(ctx, time.Since(tnow), false, err != nil)
-	return a, err
+	bs, err := s.shardBlockstore(key, shardAccessor)
+	if err != nil {
+		s.metrics.observeGetAccessor(ctx, time.Since(tnow), false, true)
+		return nil, errors.Join(err, shardAccessor.Close())
+	}
+	a := s.cache.unsafeAddBlockstore(key, shardAccessor, bs)
+	s.metrics.observeGetAccessor(ctx, time.Since(tnow), false, false)
+	return a, nil
+}
+
+// shardBlockstore returns the blockstore of the given shard. Shards stored without parity shares
+// and NMT proofs get a blockstore recomputing them on demand.
+func (s *Store) shardBlockstore(key shard.Key, accessor *dagstore.ShardAccessor) (dagstore.ReadBlockstore, error) {
+	bs, err := accessor.Blockstore()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blockstore from accessor: %w", err)
+	}
+
+	carHeader, err := carv1.ReadHeader(bufio.NewReader(accessor.Reader()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read car header: %w", err)
+	}
+	// files with proofs contain the NMT roots
+	dah := dahFromCARHeader(carHeader)
+	has, err := bs.Has(context.Background(), ipld.MustCidFromNamespacedSha256(dah.RowRoots[0]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for proofs: %w", err)
+	}
+	if has {
+		return bs, nil
+	}
+
+	root, err := hex.DecodeString(key.String())
+	if err != nil {
+		return nil, err
+	}
+	return newParityBlockstore(bs, accessor.Reader, root), nil
 }
 
 // Remove removes EDS from Store by the given share.Root hash and cleans up all
 // the indexing.
 func (s *Store) Remove(ctx context.Context, root share.DataHash) error {
 	ctx, span := tracer.Start(ctx, "store/remove")
+	s.barrier.RLock()
+	defer s.barrier.RUnlock()
+
 	tnow := time.Now()
 	err := s.remove(ctx, root)
 	s.metrics.observeRemove(ctx, time.Since(tnow), err != nil)
@@ -437,6 +572,8 @@ func (s *Store) Remove(ctx context.Context, root share.DataHash) error {
 
 func (s *Store) remove(ctx context.Context, root share.DataHash) (err error) {
 	key := root.String()
+	// the cached accessor holds a reference on the shard, preventing its destruction
+	s.cache.Remove(shard.KeyFromString(key))
 	ch := make(chan dagstore.ShardResult, 1)
 	err = s.dgstr.DestroyShard(ctx, shard.KeyFromString(key), ch, dagstore.DestroyOpts{})
 	if err != nil {
@@ -2,6 +2,7 @@ package eds
 
 import (
 	"context"
+	"io"
 	"os"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/ipld"
 )
 
 func TestEDSStore(t *testing.T) {
@@ -270,6 +272,202 @@ func Test_CachedAccessor(t *testing.T) {
 	assert.Equal(t, firstBlock, secondBlock)
 }
 
+// TestEDSStore_Snapshot verifies that EDSes captured by Store.Snapshot are accessible after being
+// restored into a fresh Store.
+func TestEDSStore_Snapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	roots := make([]share.Root, 3)
+	for i := range roots {
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+		roots[i] = dah
+	}
+
+	dir := t.TempDir()
+	var captured bool
+	hashes, files, err := edsStore.Snapshot(ctx, dir, func() error {
+		captured = true
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, captured)
+	assert.Len(t, hashes, len(roots))
+	// inverted index dump, plus a CAR and index file per EDS
+	assert.Len(t, files, len(roots)*2+1)
+
+	// resuming over the same directory skips the existing files
+	_, resumed, err := edsStore.Snapshot(ctx, dir, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, files, resumed)
+
+	basepath := t.TempDir()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	err = RestoreSnapshot(ctx, dir, basepath, ds)
+	require.NoError(t, err)
+
+	restored, err := NewStore(basepath, ds)
+	require.NoError(t, err)
+	err = restored.Start(ctx)
+	require.NoError(t, err)
+
+	for _, dah := range roots {
+		has, err := restored.Has(ctx, dah.Hash())
+		require.NoError(t, err)
+		assert.True(t, has)
+
+		_, err = restored.Get(ctx, dah.Hash())
+		require.NoError(t, err)
+
+		// the inverted index is restored as well
+		rowRoot := ipld.MustCidFromNamespacedSha256(dah.RowRoots[0])
+		has, err = restored.Blockstore().Has(ctx, rowRoot)
+		require.NoError(t, err)
+		assert.True(t, has)
+	}
+}
+
+func TestEDSStore_Verify(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	t.Cleanup(cancel)
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	squares := make(map[string]*rsmt2d.ExtendedDataSquare)
+	roots := make([]share.Root, 4)
+	for i := range roots {
+		eds, dah := randomEDS(t)
+		err = edsStore.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+		roots[i] = dah
+		squares[dah.String()] = eds
+	}
+
+	report, err := edsStore.Verify(ctx, VerifyOptions{CheckInvertedIndex: true})
+	require.NoError(t, err)
+	assert.Equal(t, len(roots), report.Checked)
+	assert.Empty(t, report.Broken)
+	assert.Zero(t, report.DanglingIndexEntries)
+
+	// truncate the CAR file, remove the index file and the CAR file of the first three EDSes
+	carPath := edsStore.basepath + blocksPath + roots[0].String()
+	err = os.Truncate(carPath, 1024)
+	require.NoError(t, err)
+	err = os.Remove(edsStore.basepath + indexPath + roots[1].String() + indexSuffix)
+	require.NoError(t, err)
+	err = os.Remove(edsStore.basepath + blocksPath + roots[2].String())
+	require.NoError(t, err)
+	// removal leaves the inverted index entries of the last EDS behind
+	err = edsStore.Remove(ctx, roots[3].Hash())
+	require.NoError(t, err)
+
+	report, err = edsStore.Verify(ctx, VerifyOptions{CheckInvertedIndex: true})
+	require.NoError(t, err)
+	assert.Equal(t, len(roots)-1, report.Checked)
+	require.Len(t, report.Broken, 3)
+	assert.Zero(t, report.Repaired)
+	assert.NotZero(t, report.DanglingIndexEntries)
+	assert.Zero(t, report.PrunedIndexEntries)
+
+	issues := make(map[string][]Issue)
+	for _, res := range report.Broken {
+		assert.False(t, res.Repaired)
+		issues[res.Hash.String()] = res.Issues
+	}
+	assert.Equal(t, []Issue{IssueCorruptedCAR}, issues[roots[0].String()])
+	assert.Equal(t, []Issue{IssueMissingIndex}, issues[roots[1].String()])
+	assert.Equal(t, []Issue{IssueMissingCAR}, issues[roots[2].String()])
+
+	refetch := func(_ context.Context, hash share.DataHash) (*rsmt2d.ExtendedDataSquare, error) {
+		return squares[hash.String()], nil
+	}
+	report, err = edsStore.Verify(ctx, VerifyOptions{
+		RebuildIndices:     true,
+		Refetch:            refetch,
+		CheckInvertedIndex: true,
+		PruneInvertedIndex: true,
+	})
+	require.NoError(t, err)
+	assert.Len(t, report.Broken, 3)
+	assert.Equal(t, 3, report.Repaired)
+	assert.Equal(t, report.DanglingIndexEntries, report.PrunedIndexEntries)
+
+	report, err = edsStore.Verify(ctx, VerifyOptions{CheckInvertedIndex: true})
+	require.NoError(t, err)
+	assert.Empty(t, report.Broken)
+	assert.Zero(t, report.DanglingIndexEntries)
+
+	for _, dah := range roots[:3] {
+		_, err = edsStore.Get(ctx, dah.Hash())
+		require.NoError(t, err)
+	}
+}
+
+// TestEDSStore_ODSOnly ensures that EDSes stored without parity shares and proofs are fully
+// served by the Store.
+func TestEDSStore_ODSOnly(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	t.Cleanup(cancel)
+
+	edsStore, err := NewStore(t.TempDir(), ds_sync.MutexWrap(datastore.NewMapDatastore()), WithODSOnly(true))
+	require.NoError(t, err)
+	err = edsStore.Start(ctx)
+	require.NoError(t, err)
+
+	eds, dah := randomEDS(t)
+	err = edsStore.Put(ctx, dah.Hash(), eds)
+	require.NoError(t, err)
+
+	// the file holds only the ODS
+	carReader, err := car.NewCarReader(mustOpen(t, edsStore.basepath+blocksPath+dah.String()))
+	require.NoError(t, err)
+	odsWidth := int(eds.Width() / 2)
+	for i := 0; i < odsWidth*odsWidth; i++ {
+		_, err = carReader.Next()
+		require.NoError(t, err)
+	}
+	_, err = carReader.Next()
+	require.ErrorIs(t, err, io.EOF)
+
+	got, err := edsStore.Get(ctx, dah.Hash())
+	require.NoError(t, err)
+	assert.True(t, eds.Equals(got))
+
+	// parity shares are recomputed
+	width := int(eds.Width())
+	sh, err := edsStore.GetShare(ctx, &dah, width-1, width-1)
+	require.NoError(t, err)
+	assert.Equal(t, eds.GetCell(uint(width-1), uint(width-1)), sh)
+
+	// the recomputed square is kept in the accessor cache
+	accessor, err := edsStore.cache.Get(shard.KeyFromString(dah.String()))
+	require.NoError(t, err)
+	require.IsType(t, &parityBlockstore{}, accessor.bs)
+	assert.NotNil(t, accessor.bs.(*parityBlockstore).square)
+
+	// inner nodes are indexed and served over the top-level blockstore
+	rowRoot := ipld.MustCidFromNamespacedSha256(dah.RowRoots[width-1])
+	has, err := edsStore.Blockstore().Has(ctx, rowRoot)
+	require.NoError(t, err)
+	assert.True(t, has)
+	_, err = edsStore.Blockstore().Get(ctx, rowRoot)
+	require.NoError(t, err)
+
+	report, err := edsStore.Verify(ctx, VerifyOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, report.Broken)
+}
+
 func BenchmarkStore(b *testing.B) {
 	ctx, cancel := context.WithCancel(context.Background())
 	b.Cleanup(cancel)
@@ -315,6 +513,15 @@ func BenchmarkStore(b *testing.B) {
 	})
 }
 
+func mustOpen(t *testing.T, path string) *os.File {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		f.Close()
+	})
+	return f
+}
+
 func newStore(t *testing.T) (*Store, error) {
 	t.Helper()
 
@@ -0,0 +1,140 @@
+package eds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// VerifierParameters configures the background verification of the Store.
+type VerifierParameters struct {
+	// Interval is the interval between background verifications of the whole Store. Zero
+	// disables background verification.
+	Interval time.Duration
+	// RebuildIndices re-registers EDSes that have a valid CAR file, but an errored shard or a
+	// missing index.
+	RebuildIndices bool
+	// Refetch enables refetching EDSes with missing or corrupted CAR files from the network.
+	Refetch bool
+	// PruneInvertedIndex enables removal of inverted index entries pointing to unknown shards.
+	PruneInvertedIndex bool
+}
+
+// DefaultVerifierParameters returns the default configuration values for the Verifier.
+func DefaultVerifierParameters() VerifierParameters {
+	return VerifierParameters{
+		// background verification reads every stored EDS, so it is off by default
+		Interval:           0,
+		RebuildIndices:     true,
+		Refetch:            true,
+		PruneInvertedIndex: false,
+	}
+}
+
+// Validate validates the values in VerifierParameters.
+func (p *VerifierParameters) Validate() error {
+	if p.Interval < 0 {
+		return fmt.Errorf("eds/verifier: interval must not be negative")
+	}
+	return nil
+}
+
+// Verifier periodically verifies and repairs the Store in the background.
+type Verifier struct {
+	params  VerifierParameters
+	store   *Store
+	refetch RefetchFn
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewVerifier creates a new Verifier of the given Store. The given RefetchFn is used to refetch
+// broken EDSes if enabled by the parameters and can be nil.
+func NewVerifier(params VerifierParameters, store *Store, refetch RefetchFn) *Verifier {
+	return &Verifier{
+		params:  params,
+		store:   store,
+		refetch: refetch,
+		done:    make(chan struct{}),
+	}
+}
+
+// Start starts the background verification, if enabled.
+func (v *Verifier) Start(context.Context) error {
+	if v.params.Interval == 0 {
+		close(v.done)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v.cancel = cancel
+	go v.run(ctx)
+	return nil
+}
+
+// Stop stops the background verification.
+func (v *Verifier) Stop(ctx context.Context) error {
+	if v.cancel != nil {
+		v.cancel()
+	}
+
+	select {
+	case <-v.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (v *Verifier) run(ctx context.Context) {
+	defer close(v.done)
+
+	opts := VerifyOptions{
+		RebuildIndices:     v.params.RebuildIndices,
+		CheckInvertedIndex: v.params.PruneInvertedIndex,
+		PruneInvertedIndex: v.params.PruneInvertedIndex,
+	}
+	if v.params.Refetch {
+		opts.Refetch = v.refetch
+	}
+
+	ticker := time.NewTicker(v.params.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		report, err := v.store.Verify(ctx, opts)
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		if err != nil {
+			log.Errorw("verifying eds store", "err", err)
+		}
+		if report == nil {
+			continue
+		}
+
+		for _, res := range report.Broken {
+			log.Warnw("found broken eds",
+				"hash", res.Hash.String(),
+				"issues", res.Issues,
+				"errors", res.Errors,
+				"repaired", res.Repaired,
+				"repair_err", res.RepairError,
+			)
+		}
+		log.Infow("verified eds store",
+			"checked", report.Checked,
+			"broken", len(report.Broken),
+			"repaired", report.Repaired,
+			"pruned_index_entries", report.PrunedIndexEntries,
+			"took", report.Duration,
+		)
+	}
+}
@@ -0,0 +1,344 @@
+package eds
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/filecoin-project/dagstore"
+	"github.com/filecoin-project/dagstore/shard"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/ipld/go-car"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/libs/utils"
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// Issue is a kind of problem found with a stored EDS by Store.Verify.
+type Issue string
+
+const (
+	// IssueShardErrored means the DAGStore shard of the EDS is in the errored state.
+	IssueShardErrored Issue = "shard_errored"
+	// IssueMissingIndex means the CAR index of the EDS is missing.
+	IssueMissingIndex Issue = "missing_index"
+	// IssueMissingCAR means the CAR file of the EDS is missing.
+	IssueMissingCAR Issue = "missing_car"
+	// IssueCorruptedCAR means the content of the CAR file doesn't match the DataHash of the EDS.
+	IssueCorruptedCAR Issue = "corrupted_car"
+)
+
+// RefetchFn retrieves the EDS identified by the given DataHash from outside the Store.
+type RefetchFn func(context.Context, share.DataHash) (*rsmt2d.ExtendedDataSquare, error)
+
+// VerifyOptions configures the checks and the repairs done by Store.Verify.
+type VerifyOptions struct {
+	// RebuildIndices re-registers EDSes that have a valid CAR file, but an errored shard or a
+	// missing index.
+	RebuildIndices bool
+	// Refetch, if set, is used to retrieve EDSes with missing or corrupted CAR files, which are
+	// then stored again.
+	Refetch RefetchFn
+	// CheckInvertedIndex enables the lookup for inverted index entries pointing to unknown shards.
+	CheckInvertedIndex bool
+	// PruneInvertedIndex removes the inverted index entries found by CheckInvertedIndex.
+	PruneInvertedIndex bool
+}
+
+// VerifyResult describes a broken EDS found by Store.Verify.
+type VerifyResult struct {
+	Hash   share.DataHash
+	Issues []Issue
+	// Errors holds the errors the issues were found with.
+	Errors []string
+	// Repaired is true when all the issues were repaired.
+	Repaired    bool
+	RepairError string `json:",omitempty"`
+}
+
+// VerifyReport summarizes a verification done by Store.Verify.
+type VerifyReport struct {
+	// Checked is the amount of verified EDSes.
+	Checked int
+	// Broken lists all the EDSes with issues.
+	Broken []VerifyResult
+	// Repaired is the amount of repaired EDSes.
+	Repaired int
+	// DanglingIndexEntries is the amount of inverted index entries pointing to unknown shards.
+	DanglingIndexEntries int
+	// PrunedIndexEntries is the amount of removed inverted index entries.
+	PrunedIndexEntries int
+	Duration           time.Duration
+}
+
+// Verify walks all the EDSes registered on the Store and checks their shard states, their CAR
+// indices and the content of their CAR files, recomputing the roots against the DataHash. Broken
+// EDSes are repaired according to the given options. The Store keeps serving requests meanwhile.
+func (s *Store) Verify(ctx context.Context, opts VerifyOptions) (report *VerifyReport, err error) {
+	ctx, span := tracer.Start(ctx, "store/verify")
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	tnow := time.Now()
+	hashes, err := s.List()
+	if err != nil {
+		return nil, fmt.Errorf("eds/store: listing EDSes: %w", err)
+	}
+
+	report = &VerifyReport{}
+	for _, hash := range hashes {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		res, eds := s.verify(ctx, hash)
+		report.Checked++
+		if len(res.Issues) == 0 {
+			continue
+		}
+
+		res.Repaired, err = s.repair(ctx, hash, eds, opts)
+		if err != nil {
+			res.RepairError = err.Error()
+		}
+		if res.Repaired {
+			report.Repaired++
+		}
+		report.Broken = append(report.Broken, res)
+	}
+
+	if opts.CheckInvertedIndex {
+		dangling, pruned, err := s.verifyInvertedIndex(ctx, opts.PruneInvertedIndex)
+		report.DanglingIndexEntries, report.PrunedIndexEntries = dangling, pruned
+		if err != nil {
+			return report, err
+		}
+	}
+	report.Duration = time.Since(tnow)
+	return report, nil
+}
+
+// verify checks a single EDS. It returns the EDS read out of its CAR file if the file is intact.
+func (s *Store) verify(ctx context.Context, hash share.DataHash) (VerifyResult, *rsmt2d.ExtendedDataSquare) {
+	res := VerifyResult{Hash: hash}
+	key := shard.KeyFromString(hash.String())
+
+	info, err := s.dgstr.GetShardInfo(key)
+	if err != nil {
+		// removed in the meantime
+		return res, nil
+	}
+	switch info.ShardState {
+	case dagstore.ShardStateErrored:
+		res.add(IssueShardErrored, info.Error)
+	case dagstore.ShardStateAvailable, dagstore.ShardStateServing:
+	default:
+		// being registered or recovered, so indices might not be there yet
+		return res, nil
+	}
+
+	stat, err := s.carIdx.StatFullIndex(key)
+	if err == nil && !stat.Exists {
+		err = errors.New("index file doesn't exist")
+	}
+	if err != nil {
+		res.add(IssueMissingIndex, err)
+	}
+
+	eds, err := verifyCAR(ctx, s.basepath+blocksPath+key.String(), hash)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		res.add(IssueMissingCAR, err)
+	case err != nil:
+		res.add(IssueCorruptedCAR, err)
+	}
+	return res, eds
+}
+
+func (r *VerifyResult) add(issue Issue, err error) {
+	r.Issues = append(r.Issues, issue)
+	if err != nil {
+		r.Errors = append(r.Errors, err.Error())
+	}
+}
+
+// verifyCAR recomputes the EDS out of the ODS in the CAR file under the given path against the
+// DataHash, and then ensures the rest of the file matches the recomputed EDS. Files holding only
+// the ODS are valid.
+func verifyCAR(ctx context.Context, path string, hash share.DataHash) (*rsmt2d.ExtendedDataSquare, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	eds, err := ReadEDS(ctx, bufio.NewReader(f), hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	carReader, err := car.NewCarReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(dahFromCARHeader(carReader.Header).Hash(), hash) {
+		return nil, fmt.Errorf("roots in CAR header don't match %s", hash)
+	}
+	odsWidth := int(eds.Width() / 2)
+	for i, shr := range quadrantOrder(eds) {
+		block, err := carReader.Next()
+		if i == odsWidth*odsWidth && errors.Is(err, io.EOF) {
+			// stored without parity shares and proofs
+			return eds, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading share %d: %w", i, err)
+		}
+		if !bytes.Equal(block.RawData(), shr) {
+			return nil, fmt.Errorf("share %d doesn't match the recomputed EDS", i)
+		}
+	}
+	// ensure the proofs are not truncated
+	for {
+		_, err = carReader.Next()
+		if errors.Is(err, io.EOF) {
+			return eds, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading proofs: %w", err)
+		}
+	}
+}
+
+// repair stores the given EDS again, or the refetched one if the EDS is nil, rebuilding its
+// shard and indices. It reports whether the EDS was repaired.
+func (s *Store) repair(
+	ctx context.Context,
+	hash share.DataHash,
+	eds *rsmt2d.ExtendedDataSquare,
+	opts VerifyOptions,
+) (bool, error) {
+	switch {
+	case eds != nil && !opts.RebuildIndices:
+		return false, nil
+	case eds == nil && opts.Refetch == nil:
+		return false, nil
+	case eds == nil:
+		var err error
+		eds, err = opts.Refetch(ctx, hash)
+		if err != nil {
+			return false, fmt.Errorf("refetching: %w", err)
+		}
+	}
+
+	s.barrier.RLock()
+	defer s.barrier.RUnlock()
+
+	key := shard.KeyFromString(hash.String())
+	if _, err := s.dgstr.GetShardInfo(key); err != nil {
+		// removed in the meantime, so nothing to repair
+		return false, nil
+	}
+
+	// the cached accessor holds a reference on the shard, preventing its destruction
+	s.cache.Remove(key)
+	ch := make(chan dagstore.ShardResult, 1)
+	err := s.dgstr.DestroyShard(ctx, key, ch, dagstore.DestroyOpts{})
+	if err != nil {
+		return false, fmt.Errorf("destroying shard: %w", err)
+	}
+	select {
+	case result := <-ch:
+		if result.Error != nil {
+			return false, fmt.Errorf("destroying shard: %w", result.Error)
+		}
+	case <-ctx.Done():
+		go trackLateResult("repair", ch, s.metrics, time.Minute)
+		return false, ctx.Err()
+	}
+
+	_, err = s.carIdx.DropFullIndex(key)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("dropping index: %w", err)
+	}
+	err = os.Remove(s.basepath + blocksPath + key.String())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("removing CAR file: %w", err)
+	}
+	if err = s.put(ctx, hash, eds); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyInvertedIndex counts the inverted index entries pointing to shards unknown to the
+// DAGStore and removes them, if prune is set.
+func (s *Store) verifyInvertedIndex(ctx context.Context, prune bool) (dangling, pruned int, err error) {
+	results, err := s.invertedIdx.ds.Query(ctx, query.Query{})
+	if err != nil {
+		return 0, 0, fmt.Errorf("eds/store: querying inverted index: %w", err)
+	}
+	defer results.Close()
+
+	known := make(map[string]bool)
+	isKnown := func(key string) bool {
+		if ok, cached := known[key]; cached {
+			return ok
+		}
+		_, err := s.dgstr.GetShardInfo(shard.KeyFromString(key))
+		known[key] = err == nil
+		return known[key]
+	}
+
+	// entries to prune, grouped by shard key
+	entries := make(map[string][]datastore.Key)
+	for res := range results.Next() {
+		if res.Error != nil {
+			return dangling, 0, fmt.Errorf("eds/store: iterating inverted index: %w", res.Error)
+		}
+		if isKnown(string(res.Value)) {
+			continue
+		}
+
+		dangling++
+		if prune {
+			entries[string(res.Value)] = append(entries[string(res.Value)], datastore.RawKey(res.Key))
+		}
+	}
+	if len(entries) == 0 {
+		return dangling, 0, nil
+	}
+
+	batch, err := s.invertedIdx.ds.Batch(ctx)
+	if err != nil {
+		return dangling, 0, err
+	}
+	for key, keys := range entries {
+		// the shard could have been stored again during the iteration
+		if _, err := s.dgstr.GetShardInfo(shard.KeyFromString(key)); err == nil {
+			continue
+		}
+		for _, k := range keys {
+			if err = batch.Delete(ctx, k); err != nil {
+				return dangling, 0, err
+			}
+		}
+		pruned += len(keys)
+	}
+	if err = batch.Commit(ctx); err != nil {
+		return dangling, 0, fmt.Errorf("eds/store: pruning inverted index: %w", err)
+	}
+	return dangling, pruned, nil
+}
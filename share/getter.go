@@ -34,6 +34,11 @@ type Getter interface {
 	// If no shares are found for target namespace non-inclusion could be also verified by calling
 	// Verify method.
 	GetSharesByNamespace(context.Context, *Root, Namespace) (NamespacedShares, error)
+
+	// GetSharesByNamespaces gets all shares from an EDS within each of the given namespaces at once.
+	// NamespacedShares are returned in the order of the given namespaces and could be verified the
+	// same way as the ones returned by GetSharesByNamespace.
+	GetSharesByNamespaces(context.Context, *Root, []Namespace) ([]NamespacedShares, error)
 }
 
 // NamespacedShares represents all shares with proofs within a specific namespace of an EDS.
@@ -77,6 +82,17 @@ func (ns NamespacedShares) Verify(root *Root, namespace Namespace) error {
 	return nil
 }
 
+// Verify validates the NamespacedRow of the row with the given index against the Root.
+func (row *NamespacedRow) Verify(root *Root, rowIdx int, namespace Namespace) error {
+	if rowIdx < 0 || rowIdx >= len(root.RowRoots) {
+		return ErrOutOfBounds
+	}
+	if !row.verify(root.RowRoots[rowIdx], namespace) {
+		return fmt.Errorf("row verification failed: row %d doesn't match original root: %s", rowIdx, root.String())
+	}
+	return nil
+}
+
 // verify validates the row using nmt inclusion proof.
 func (row *NamespacedRow) verify(rowRoot []byte, namespace Namespace) bool {
 	// construct nmt leaves from shares by prepending namespace
@@ -3,8 +3,11 @@ package getters
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/celestiaorg/rsmt2d"
@@ -15,21 +18,149 @@ import (
 
 var _ share.Getter = (*CascadeGetter)(nil)
 
+const (
+	opGetShare              = "get_share"
+	opGetEDS                = "get_eds"
+	opGetSharesByNamespace  = "get_shares_by_namespace"
+	opGetSharesByNamespaces = "get_shares_by_namespaces"
+)
+
+// HedgeParameters configures hedging of a single CascadeGetter operation.
+type HedgeParameters struct {
+	// Enabled turns on hedging. Otherwise, getters are tried one-by-one, splitting the timeout
+	// between them.
+	Enabled bool
+	// Delay is the time given to a getter before the next one is launched alongside it.
+	Delay time.Duration
+}
+
+// Validate validates the values in HedgeParameters.
+func (p HedgeParameters) Validate() error {
+	if p.Delay < 0 {
+		return fmt.Errorf("hedge delay must not be negative")
+	}
+	return nil
+}
+
+// CascadeParameters is the set of parameters of the CascadeGetter, configured per operation.
+type CascadeParameters struct {
+	GetShare HedgeParameters
+	GetEDS   HedgeParameters
+	// GetSharesByNamespace configures both single and batched namespace requests.
+	GetSharesByNamespace HedgeParameters
+}
+
+// DefaultCascadeParameters returns the default CascadeParameters. Hedging is disabled, but the
+// delays are tuned for the typical time each operation takes.
+func DefaultCascadeParameters() CascadeParameters {
+	return CascadeParameters{
+		GetShare:             HedgeParameters{Delay: time.Second},
+		GetEDS:               HedgeParameters{Delay: 10 * time.Second},
+		GetSharesByNamespace: HedgeParameters{Delay: 3 * time.Second},
+	}
+}
+
+// Validate validates the values in CascadeParameters.
+func (p CascadeParameters) Validate() error {
+	for op, params := range map[string]HedgeParameters{
+		opGetShare:             p.GetShare,
+		opGetEDS:               p.GetEDS,
+		opGetSharesByNamespace: p.GetSharesByNamespace,
+	} {
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("cascade-getter: %s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+type cascadeMetrics struct {
+	results metric.Int64Counter
+	latency metric.Float64Histogram
+}
+
+// observe records the outcome of a single getter attempt.
+func (m *cascadeMetrics) observe(ctx context.Context, getter, op string, latency time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	result := "success"
+	switch {
+	case err == nil:
+	case errors.Is(err, errOperationNotSupported):
+		result = "not_supported"
+	case errors.Is(err, context.Canceled):
+		result = "canceled"
+	default:
+		result = "failure"
+	}
+
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("getter", getter),
+		attribute.String("operation", op),
+		attribute.String("result", result),
+	)
+	m.results.Add(ctx, 1, attrs)
+	m.latency.Record(ctx, latency.Seconds(), attrs)
+}
+
 // CascadeGetter implements custom share.Getter that composes multiple Getter implementations in
 // "cascading" order.
 //
-// See cascade func for details on cascading.
+// See cascade func for details on cascading, and hedge func for details on hedging.
 type CascadeGetter struct {
 	getters []share.Getter
+	// names holds the names of the getters used in metrics.
+	names map[share.Getter]string
+
+	params CascadeParameters
+
+	metrics *cascadeMetrics
 }
 
-// NewCascadeGetter instantiates a new CascadeGetter from given share.Getters with given interval.
-func NewCascadeGetter(getters []share.Getter) *CascadeGetter {
+// NewCascadeGetter instantiates a new CascadeGetter from given share.Getters with given
+// parameters.
+func NewCascadeGetter(getters []share.Getter, params CascadeParameters) *CascadeGetter {
+	names := make(map[share.Getter]string, len(getters))
+	for i, getter := range getters {
+		names[getter] = fmt.Sprintf("%d_%s", i, getterName(getter))
+	}
 	return &CascadeGetter{
 		getters: getters,
+		names:   names,
+		params:  params,
 	}
 }
 
+// WithMetrics turns on the per-getter metrics of the CascadeGetter.
+func (cg *CascadeGetter) WithMetrics() error {
+	results, err := meter.Int64Counter(
+		"getters_cascade_results",
+		metric.WithDescription("Number of attempts of the cascaded getters by result"),
+	)
+	if err != nil {
+		return err
+	}
+
+	latency, err := meter.Float64Histogram(
+		"getters_cascade_latency_seconds",
+		metric.WithDescription("Time taken by the attempts of the cascaded getters"),
+	)
+	if err != nil {
+		return err
+	}
+
+	cg.metrics = &cascadeMetrics{
+		results: results,
+		latency: latency,
+	}
+	return nil
+}
+
 // GetShare gets a share from any of registered share.Getters in cascading order.
 func (cg *CascadeGetter) GetShare(ctx context.Context, root *share.Root, row, col int) (share.Share, error) {
 	ctx, span := tracer.Start(ctx, "cascade/get-share", trace.WithAttributes(
@@ -48,7 +179,7 @@ func (cg *CascadeGetter) GetShare(ctx context.Context, root *share.Root, row, co
 		return get.GetShare(ctx, root, row, col)
 	}
 
-	return cascadeGetters(ctx, cg.getters, get)
+	return runGetters(ctx, cg, opGetShare, cg.params.GetShare, get)
 }
 
 // GetEDS gets a full EDS from any of registered share.Getters in cascading order.
@@ -60,7 +191,7 @@ func (cg *CascadeGetter) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.
 		return get.GetEDS(ctx, root)
 	}
 
-	return cascadeGetters(ctx, cg.getters, get)
+	return runGetters(ctx, cg, opGetEDS, cg.params.GetEDS, get)
 }
 
 // GetSharesByNamespace gets NamespacedShares from any of registered share.Getters in cascading
@@ -79,7 +210,64 @@ func (cg *CascadeGetter) GetSharesByNamespace(
 		return get.GetSharesByNamespace(ctx, root, namespace)
 	}
 
-	return cascadeGetters(ctx, cg.getters, get)
+	return runGetters(ctx, cg, opGetSharesByNamespace, cg.params.GetSharesByNamespace, get)
+}
+
+// GetSharesByNamespaces gets NamespacedShares of all the given namespaces from any of registered
+// share.Getters in cascading order.
+func (cg *CascadeGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	ctx, span := tracer.Start(ctx, "cascade/get-shares-by-namespaces", trace.WithAttributes(
+		attribute.Int("namespaces", len(namespaces)),
+	))
+	defer span.End()
+
+	get := func(ctx context.Context, get share.Getter) ([]share.NamespacedShares, error) {
+		return get.GetSharesByNamespaces(ctx, root, namespaces)
+	}
+
+	return runGetters(ctx, cg, opGetSharesByNamespaces, cg.params.GetSharesByNamespace, get)
+}
+
+// runGetters gets a value from the getters of the CascadeGetter either hedging or cascading,
+// depending on the parameters of the operation.
+func runGetters[V any](
+	ctx context.Context,
+	cg *CascadeGetter,
+	op string,
+	params HedgeParameters,
+	get func(context.Context, share.Getter) (V, error),
+) (V, error) {
+	observed := func(ctx context.Context, getter share.Getter) (V, error) {
+		start := time.Now()
+		val, err := get(ctx, getter)
+		cg.metrics.observe(ctx, cg.names[getter], op, time.Since(start), err)
+		return val, err
+	}
+
+	if params.Enabled {
+		return hedgeGetters(ctx, cg.getters, params.Delay, observed)
+	}
+	return cascadeGetters(ctx, cg.getters, observed)
+}
+
+// getterName returns a short name of the getter for metrics.
+func getterName(getter share.Getter) string {
+	switch g := getter.(type) {
+	case *StoreGetter:
+		return "store"
+	case *ShrexGetter:
+		return "shrex"
+	case *IPLDGetter:
+		return "ipld"
+	case *TeeGetter:
+		return getterName(g.getter)
+	default:
+		return fmt.Sprintf("%T", getter)
+	}
 }
 
 // cascade implements a cascading retry algorithm for getting a value from multiple sources.
@@ -138,3 +326,103 @@ func cascadeGetters[V any](
 	}
 	return zero, err
 }
+
+// hedgeResult is the outcome of a single getter attempt in hedgeGetters.
+type hedgeResult[V any] struct {
+	idx int
+	val V
+	err error
+}
+
+// hedge implements a hedged algorithm for getting a value from multiple sources.
+// Hedging implies launching the sources in the given order, each after the given delay or right
+// after all running sources failed, while the earlier ones keep running, until either:
+//   - One of the sources returns the value, which cancels the rest
+//   - All of the sources errors
+//   - Context is canceled
+//
+// Values are expected to be verified by the sources, so the first one returned is used.
+func hedgeGetters[V any](
+	ctx context.Context,
+	getters []share.Getter,
+	delay time.Duration,
+	get func(context.Context, share.Getter) (V, error),
+) (V, error) {
+	var (
+		zero V
+		err  error
+	)
+
+	if len(getters) == 0 {
+		return zero, errors.New("no getters provided")
+	}
+
+	ctx, span := tracer.Start(ctx, "hedge", trace.WithAttributes(
+		attribute.Int("total-getters", len(getters)),
+		attribute.String("delay", delay.String()),
+	))
+	defer func() {
+		if err != nil {
+			utils.SetStatusAndEnd(span, errors.New("all getters failed"))
+			return
+		}
+		span.End()
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	// cancels the attempts still running once the result is known
+	defer cancel()
+
+	results := make(chan hedgeResult[V], len(getters))
+	var launched, running int
+	launch := func() {
+		idx := launched
+		launched++
+		running++
+		log.Debugf("hedge: launching getter #%d", idx)
+		span.AddEvent("getter launched", trace.WithAttributes(attribute.Int("getter_idx", idx)))
+		go func() {
+			val, err := get(ctx, getters[idx])
+			results <- hedgeResult[V]{idx: idx, val: val, err: err}
+		}()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	launch()
+	for running > 0 {
+		select {
+		case <-timer.C:
+			if launched < len(getters) {
+				launch()
+				timer.Reset(delay)
+			}
+		case res := <-results:
+			running--
+			if res.err == nil {
+				span.AddEvent("getter succeeded", trace.WithAttributes(attribute.Int("getter_idx", res.idx)))
+				return res.val, nil
+			}
+
+			if !errors.Is(res.err, errOperationNotSupported) {
+				err = errors.Join(err, res.err)
+				span.RecordError(res.err, trace.WithAttributes(attribute.Int("getter_idx", res.idx)))
+			}
+			if ctx.Err() != nil {
+				continue
+			}
+			if running == 0 && launched < len(getters) {
+				// no need to wait for the delay when there is nothing running
+				launch()
+				if !timer.Stop() {
+					select {
+					case <-timer.C:
+					default:
+					}
+				}
+				timer.Reset(delay)
+			}
+		}
+	}
+	return zero, err
+}
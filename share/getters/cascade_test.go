@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -26,22 +28,34 @@ func TestCascadeGetter(t *testing.T) {
 		getters[i], roots[i] = TestGetter(t)
 	}
 
-	getter := NewCascadeGetter(getters)
-	t.Run("GetShare", func(t *testing.T) {
-		for _, r := range roots {
-			sh, err := getter.GetShare(ctx, r, 0, 0)
-			assert.NoError(t, err)
-			assert.NotEmpty(t, sh)
-		}
-	})
-
-	t.Run("GetEDS", func(t *testing.T) {
-		for _, r := range roots {
-			sh, err := getter.GetEDS(ctx, r)
-			assert.NoError(t, err)
-			assert.NotEmpty(t, sh)
-		}
-	})
+	hedged := DefaultCascadeParameters()
+	hedged.GetShare.Enabled = true
+	hedged.GetEDS.Enabled = true
+	hedged.GetEDS.Delay = 0
+
+	for name, params := range map[string]CascadeParameters{
+		"Cascading": DefaultCascadeParameters(),
+		"Hedged":    hedged,
+	} {
+		getter := NewCascadeGetter(getters, params)
+		t.Run(name, func(t *testing.T) {
+			t.Run("GetShare", func(t *testing.T) {
+				for _, r := range roots {
+					sh, err := getter.GetShare(ctx, r, 0, 0)
+					assert.NoError(t, err)
+					assert.NotEmpty(t, sh)
+				}
+			})
+
+			t.Run("GetEDS", func(t *testing.T) {
+				for _, r := range roots {
+					sh, err := getter.GetEDS(ctx, r)
+					assert.NoError(t, err)
+					assert.NotEmpty(t, sh)
+				}
+			})
+		})
+	}
 }
 
 func TestCascade(t *testing.T) {
@@ -116,3 +130,77 @@ func TestCascade(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestHedge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	const delay = time.Millisecond * 50
+
+	var slowCanceled atomic.Int32
+	slowGetter := mocks.NewMockGetter(ctrl)
+	immediateFailGetter := mocks.NewMockGetter(ctrl)
+	successGetter := mocks.NewMockGetter(ctrl)
+	notSupportedGetter := mocks.NewMockGetter(ctrl)
+	slowGetter.EXPECT().GetEDS(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *share.Root) (*rsmt2d.ExtendedDataSquare, error) {
+			<-ctx.Done()
+			slowCanceled.Add(1)
+			return nil, ctx.Err()
+		}).AnyTimes()
+	immediateFailGetter.EXPECT().GetEDS(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("getter fails immediately")).AnyTimes()
+	successGetter.EXPECT().GetEDS(gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+	notSupportedGetter.EXPECT().GetEDS(gomock.Any(), gomock.Any()).
+		Return(nil, errOperationNotSupported).AnyTimes()
+
+	get := func(ctx context.Context, get share.Getter) (*rsmt2d.ExtendedDataSquare, error) {
+		return get.GetEDS(ctx, nil)
+	}
+
+	t.Run("SuccessFirst", func(t *testing.T) {
+		getters := []share.Getter{successGetter, slowGetter}
+		_, err := hedgeGetters(ctx, getters, time.Hour, get)
+		assert.NoError(t, err)
+	})
+
+	t.Run("SuccessAfterDelay", func(t *testing.T) {
+		slowCanceled.Store(0)
+		getters := []share.Getter{slowGetter, successGetter}
+		start := time.Now()
+		_, err := hedgeGetters(ctx, getters, delay, get)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), delay)
+		// the slow getter must be canceled once the result is known
+		assert.Eventually(t, func() bool {
+			return slowCanceled.Load() == 1
+		}, time.Second, time.Millisecond*10)
+	})
+
+	t.Run("NoDelayAfterFailure", func(t *testing.T) {
+		// a hedge waiting for the delay after any of the failures takes at least the delay
+		const hedgeDelay = time.Second
+		getters := []share.Getter{immediateFailGetter, notSupportedGetter, successGetter}
+		start := time.Now()
+		_, err := hedgeGetters(ctx, getters, hedgeDelay, get)
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(start), hedgeDelay)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		getters := []share.Getter{immediateFailGetter, notSupportedGetter, immediateFailGetter}
+		_, err := hedgeGetters(ctx, getters, delay, get)
+		assert.Error(t, err)
+		assert.Equal(t, strings.Count(err.Error(), "\n"), 1)
+	})
+
+	t.Run("Context Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, delay*3)
+		defer cancel()
+		getters := []share.Getter{slowGetter, slowGetter}
+		_, err := hedgeGetters(ctx, getters, delay, get)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
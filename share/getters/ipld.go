@@ -106,7 +106,7 @@ func (ig *IPLDGetter) GetSharesByNamespace(
 
 	// wrap the blockservice in a session if it has been signaled in the context.
 	blockGetter := getGetter(ctx, ig.bServ)
-	shares, err = collectSharesByNamespace(ctx, blockGetter, root, namespace)
+	shares, err = ipld.CollectSharesByNamespace(ctx, blockGetter, root, namespace)
 	if errors.Is(err, ipld.ErrNodeNotFound) {
 		// convert error to satisfy getter interface contract
 		err = share.ErrNotFound
@@ -117,6 +117,14 @@ func (ig *IPLDGetter) GetSharesByNamespace(
 	return shares, nil
 }
 
+func (ig *IPLDGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	return getSharesByNamespaces(ctx, root, namespaces, ig.GetSharesByNamespace)
+}
+
 var sessionKey = &session{}
 
 // session is a struct that can optionally be passed by context to the share.Getter methods using
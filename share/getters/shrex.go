@@ -15,6 +15,7 @@ import (
 
 	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/ipld"
 	"github.com/celestiaorg/celestia-node/share/p2p"
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
@@ -98,16 +99,24 @@ type ShrexGetter struct {
 	// attempt multiple peers in scope of one request before context timeout is reached
 	minAttemptsCount int
 
+	params ShrexParameters
+
 	metrics *metrics
 }
 
-func NewShrexGetter(edsClient *shrexeds.Client, ndClient *shrexnd.Client, peerManager *peers.Manager) *ShrexGetter {
+func NewShrexGetter(
+	edsClient *shrexeds.Client,
+	ndClient *shrexnd.Client,
+	peerManager *peers.Manager,
+	params ShrexParameters,
+) *ShrexGetter {
 	return &ShrexGetter{
 		edsClient:         edsClient,
 		ndClient:          ndClient,
 		peerManager:       peerManager,
 		minRequestTimeout: defaultMinRequestTimeout,
 		minAttemptsCount:  defaultMinAttemptsCount,
+		params:            params,
 	}
 }
 
@@ -123,7 +132,18 @@ func (sg *ShrexGetter) GetShare(context.Context, *share.Root, int, int) (share.S
 	return nil, fmt.Errorf("getter/shrex: GetShare %w", errOperationNotSupported)
 }
 
+// GetEDS retrieves the EDS of the given root. Big enough squares are downloaded in row ranges from
+// multiple peers at once, if enabled by ShrexParameters.
 func (sg *ShrexGetter) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error) {
+	if sg.params.ParallelRanges > 1 && len(root.RowRoots)/2 >= sg.params.MinParallelODSWidth {
+		return sg.getEDSByRows(ctx, root)
+	}
+	return sg.GetEDSByHash(ctx, root.Hash())
+}
+
+// GetEDSByHash retrieves the EDS identified by the given DataHash. Unlike GetEDS, it does not
+// require the DataAvailabilityHeader, which allows refetching EDSes known only by their hash.
+func (sg *ShrexGetter) GetEDSByHash(ctx context.Context, hash share.DataHash) (*rsmt2d.ExtendedDataSquare, error) {
 	var (
 		attempt int
 		err     error
@@ -140,10 +160,10 @@ func (sg *ShrexGetter) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.Ex
 		}
 		attempt++
 		start := time.Now()
-		peer, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash())
+		peer, setStatus, getErr := sg.peerManager.Peer(ctx, hash, peers.ProtocolEDS)
 		if getErr != nil {
 			log.Debugw("eds: couldn't find peer",
-				"hash", root.String(),
+				"hash", hash.String(),
 				"err", getErr,
 				"finished (s)", time.Since(start))
 			sg.metrics.recordEDSAttempt(ctx, attempt, false)
@@ -152,11 +172,13 @@ func (sg *ShrexGetter) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.Ex
 
 		reqStart := time.Now()
 		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
-		eds, getErr := sg.edsClient.RequestEDS(reqCtx, root.Hash(), peer)
+		eds, getErr := sg.edsClient.RequestEDS(reqCtx, hash, peer)
 		cancel()
 		switch {
 		case getErr == nil:
-			setStatus(peers.ResultSynced)
+			// only the ODS is sent, the parity shares are recomputed
+			odsWidth := int(eds.Width() / 2)
+			setStatus(peers.ResultSynced, peers.WithReceived(odsWidth*odsWidth*share.Size))
 			sg.metrics.recordEDSAttempt(ctx, attempt, true)
 			return eds, nil
 		case errors.Is(getErr, context.DeadlineExceeded),
@@ -175,7 +197,7 @@ func (sg *ShrexGetter) GetEDS(ctx context.Context, root *share.Root) (*rsmt2d.Ex
 			err = errors.Join(err, getErr)
 		}
 		log.Debugw("eds: request failed",
-			"hash", root.String(),
+			"hash", hash.String(),
 			"peer", peer.String(),
 			"attempt", attempt,
 			"err", getErr,
@@ -188,9 +210,30 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 	root *share.Root,
 	namespace share.Namespace,
 ) (share.NamespacedShares, error) {
-	if err := namespace.ValidateForData(); err != nil {
+	var shares share.NamespacedShares
+	err := sg.GetRowsByNamespace(ctx, root, namespace, func(_ int, nr share.NamespacedRow) error {
+		shares = append(shares, nr)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	return shares, nil
+}
+
+// GetRowsByNamespace passes the rows within the given namespace to put one by one, as soon as each
+// of them arrives and gets verified. If a peer fails to send all the rows, the request is resumed
+// from the next row with another peer. An error returned by put aborts the request and is
+// returned as is.
+func (sg *ShrexGetter) GetRowsByNamespace(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+	put func(row int, nr share.NamespacedRow) error,
+) error {
+	if err := namespace.ValidateForData(); err != nil {
+		return err
+	}
 	var (
 		attempt int
 		err     error
@@ -203,19 +246,22 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 	}()
 
 	// verify that the namespace could exist inside the roots before starting network requests
-	roots := filterRootsByNamespace(root, namespace)
-	if len(roots) == 0 {
-		return nil, nil
+	rows := ipld.FilterRowsByNamespace(root, namespace, 0, 0)
+	if len(rows) == 0 {
+		return nil
 	}
 
+	// delivered is the amount of the rows verified and passed to put so far
+	var delivered int
 	for {
 		if ctx.Err() != nil {
 			sg.metrics.recordNDAttempt(ctx, attempt, false)
-			return nil, errors.Join(err, ctx.Err())
+			err = errors.Join(err, ctx.Err())
+			return err
 		}
 		attempt++
 		start := time.Now()
-		peer, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash())
+		peer, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash(), peers.ProtocolND)
 		if getErr != nil {
 			log.Debugw("nd: couldn't find peer",
 				"hash", root.String(),
@@ -223,24 +269,172 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 				"err", getErr,
 				"finished (s)", time.Since(start))
 			sg.metrics.recordNDAttempt(ctx, attempt, false)
+			err = errors.Join(err, getErr)
+			return err
+		}
+
+		var (
+			received       int
+			verErr, putErr error
+		)
+		verifyAndPut := func(row int, nr share.NamespacedRow) error {
+			// both inclusion and non-inclusion cases needs verification
+			if verErr = nr.Verify(root, row, namespace); verErr != nil {
+				return verErr
+			}
+			if putErr = put(row, nr); putErr != nil {
+				return putErr
+			}
+			delivered++
+			received += len(nr.Shares) * share.Size
+			return nil
+		}
+
+		reqStart := time.Now()
+		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
+		getErr = sg.ndClient.RequestNDRows(reqCtx, root, namespace, rows[delivered], 0, peer, verifyAndPut)
+		cancel()
+		switch {
+		case putErr != nil:
+			setStatus(peers.ResultNoop, peers.WithReceived(received))
+			err = putErr
+			return err
+		case getErr == nil && delivered < len(rows):
+			getErr = fmt.Errorf("%w: received %d rows out of %d", p2p.ErrInvalidResponse, delivered, len(rows))
+			setStatus(peers.ResultBlacklistPeer)
+		case getErr == nil:
+			setStatus(peers.ResultNoop, peers.WithReceived(received))
+			sg.metrics.recordNDAttempt(ctx, attempt, true)
+			return nil
+		case verErr != nil:
+			setStatus(peers.ResultBlacklistPeer)
+		case errors.Is(getErr, context.DeadlineExceeded),
+			errors.Is(getErr, context.Canceled):
+			setStatus(peers.ResultCooldownPeer, peers.WithReceived(received))
+		case errors.Is(getErr, p2p.ErrNotFound):
+			getErr = share.ErrNotFound
+			setStatus(peers.ResultCooldownPeer)
+		case errors.Is(getErr, p2p.ErrInvalidResponse):
+			setStatus(peers.ResultBlacklistPeer)
+		default:
+			setStatus(peers.ResultCooldownPeer, peers.WithReceived(received))
+		}
+
+		if !ErrorContains(err, getErr) {
+			err = errors.Join(err, getErr)
+		}
+		log.Debugw("nd: request failed",
+			"hash", root.String(),
+			"namespace", namespace.String(),
+			"peer", peer.String(),
+			"attempt", attempt,
+			"delivered rows", delivered,
+			"err", getErr,
+			"finished (s)", time.Since(reqStart))
+	}
+}
+
+func (sg *ShrexGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	for _, namespace := range namespaces {
+		if err := namespace.ValidateForData(); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	ctx, span := tracer.Start(ctx, "shrex/get-shares-by-namespaces", trace.WithAttributes(
+		attribute.Int("namespaces", len(namespaces)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	// request only the namespaces that could exist inside the roots
+	shares := make([]share.NamespacedShares, len(namespaces))
+	requested := make([]int, 0, len(namespaces))
+	for i, namespace := range namespaces {
+		if len(ipld.FilterRootsByNamespace(root, namespace)) != 0 {
+			requested = append(requested, i)
+		}
+	}
+
+	for len(requested) > 0 {
+		batch := requested
+		if len(batch) > shrexnd.MaxNamespacesPerRequest {
+			batch = batch[:shrexnd.MaxNamespacesPerRequest]
+		}
+		requested = requested[len(batch):]
+
+		batchNamespaces := make([]share.Namespace, len(batch))
+		for i, idx := range batch {
+			batchNamespaces[i] = namespaces[idx]
+		}
+		var batchShares []share.NamespacedShares
+		batchShares, err = sg.getSharesByNamespacesBatch(ctx, root, batchNamespaces)
+		if err != nil {
+			return nil, err
+		}
+		for i, idx := range batch {
+			shares[idx] = batchShares[i]
+		}
+	}
+	return shares, nil
+}
+
+// getSharesByNamespacesBatch requests the shares of all the given namespaces from a single peer at
+// once, retrying with other peers until succeeded or the context is done.
+func (sg *ShrexGetter) getSharesByNamespacesBatch(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	var (
+		attempt int
+		err     error
+	)
+	for {
+		if ctx.Err() != nil {
+			sg.metrics.recordNDAttempt(ctx, attempt, false)
+			return nil, errors.Join(err, ctx.Err())
+		}
+		attempt++
+		start := time.Now()
+		peer, setStatus, getErr := sg.peerManager.Peer(ctx, root.Hash(), peers.ProtocolND)
+		if getErr != nil {
+			log.Debugw("nd: couldn't find peer",
+				"hash", root.String(),
+				"namespaces", len(namespaces),
+				"err", getErr,
+				"finished (s)", time.Since(start))
+			sg.metrics.recordNDAttempt(ctx, attempt, false)
 			return nil, errors.Join(err, getErr)
 		}
 
 		reqStart := time.Now()
 		reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount-attempt+1, sg.minRequestTimeout)
-		nd, getErr := sg.ndClient.RequestND(reqCtx, root, namespace, peer)
+		nds, getErr := sg.ndClient.RequestNDBatch(reqCtx, root, namespaces, peer)
 		cancel()
 		switch {
 		case getErr == nil:
 			// both inclusion and non-inclusion cases needs verification
-			if verErr := nd.Verify(root, namespace); verErr != nil {
-				getErr = verErr
+			var received int
+			for i, nd := range nds {
+				if verErr := nd.Verify(root, namespaces[i]); verErr != nil {
+					getErr = fmt.Errorf("namespace %s: %w", namespaces[i].String(), verErr)
+					break
+				}
+				received += len(nd.Flatten()) * share.Size
+			}
+			if getErr != nil {
 				setStatus(peers.ResultBlacklistPeer)
 				break
 			}
-			setStatus(peers.ResultNoop)
+			setStatus(peers.ResultNoop, peers.WithReceived(received))
 			sg.metrics.recordNDAttempt(ctx, attempt, true)
-			return nd, nil
+			return nds, nil
 		case errors.Is(getErr, context.DeadlineExceeded),
 			errors.Is(getErr, context.Canceled):
 			setStatus(peers.ResultCooldownPeer)
@@ -256,9 +450,9 @@ func (sg *ShrexGetter) GetSharesByNamespace(
 		if !ErrorContains(err, getErr) {
 			err = errors.Join(err, getErr)
 		}
-		log.Debugw("nd: request failed",
+		log.Debugw("nd: batch request failed",
 			"hash", root.String(),
-			"namespace", namespace.String(),
+			"namespaces", len(namespaces),
 			"peer", peer.String(),
 			"attempt", attempt,
 			"err", getErr,
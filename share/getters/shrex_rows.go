@@ -0,0 +1,313 @@
+package getters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-node/libs/utils"
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/p2p"
+	"github.com/celestiaorg/celestia-node/share/p2p/peers"
+	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
+)
+
+// ShrexParameters is the set of parameters of the ShrexGetter.
+type ShrexParameters struct {
+	// ParallelRanges is the number of row ranges an EDS is split into to be downloaded from
+	// different peers at once. Values below 2 disable parallel downloads.
+	ParallelRanges int
+	// MinParallelODSWidth is the minimal width of the ODS to be downloaded in parallel. Smaller
+	// squares are downloaded from a single peer.
+	MinParallelODSWidth int
+}
+
+// DefaultShrexParameters returns the default ShrexParameters.
+func DefaultShrexParameters() ShrexParameters {
+	return ShrexParameters{
+		ParallelRanges:      4,
+		MinParallelODSWidth: 32,
+	}
+}
+
+// Validate validates the values in ShrexParameters.
+func (p ShrexParameters) Validate() error {
+	if p.ParallelRanges < 0 {
+		return fmt.Errorf("shrex-getter: parallel ranges must not be negative")
+	}
+	if p.MinParallelODSWidth < 0 {
+		return fmt.Errorf("shrex-getter: min parallel ODS width must not be negative")
+	}
+	return nil
+}
+
+// rowRange is the range of EDS rows [from, to) covering the columns of a part of the ODS.
+type rowRange struct {
+	part     int
+	from, to int
+}
+
+// alternative returns the range of rows in the other half of the EDS, which allows recomputing
+// the same part of the ODS.
+func (r rowRange) alternative(odsWidth int) rowRange {
+	if r.from < odsWidth {
+		return rowRange{part: r.part, from: r.from + odsWidth, to: r.to + odsWidth}
+	}
+	return rowRange{part: r.part, from: r.from - odsWidth, to: r.to - odsWidth}
+}
+
+// getEDSByRows downloads the EDS in row ranges from multiple peers at once. Each range is verified
+// against the row roots as it arrives, and failed ranges are retried with the rows of the other
+// half of the EDS, which are enough to rebuild the same part of the ODS. The whole EDS is requested
+// from the peers not supporting requests of rows yet instead.
+func (sg *ShrexGetter) getEDSByRows(ctx context.Context, root *share.Root) (*rsmt2d.ExtendedDataSquare, error) {
+	var err error
+	ctx, span := tracer.Start(ctx, "shrex/get-eds-by-rows", trace.WithAttributes(
+		attribute.String("root", root.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	odsWidth := len(root.RowRoots) / 2
+	parts := sg.params.ParallelRanges
+	if parts > odsWidth {
+		parts = odsWidth
+	}
+
+	dl := &rowsDownload{
+		root:    root,
+		rows:    make(map[int][]share.Share, odsWidth),
+		ranges:  make(chan rowRange, parts),
+		left:    parts,
+		done:    make(chan struct{}),
+		working: make(map[peer.ID]int),
+	}
+	for part := 0; part < parts; part++ {
+		dl.ranges <- rowRange{part: part, from: part * odsWidth / parts, to: (part + 1) * odsWidth / parts}
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	for i := 0; i < parts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sg.downloadRows(workerCtx, dl)
+		}()
+	}
+
+	select {
+	case <-dl.done:
+	case <-ctx.Done():
+	}
+	cancel()
+	wg.Wait()
+
+	dl.lk.Lock()
+	defer dl.lk.Unlock()
+	if dl.square != nil {
+		sg.metrics.recordEDSAttempt(ctx, dl.attempts, true)
+		return dl.square, nil
+	}
+	if dl.left > 0 {
+		err = errors.Join(dl.err, ctx.Err())
+		sg.metrics.recordEDSAttempt(ctx, dl.attempts, false)
+		return nil, err
+	}
+
+	square, err := eds.ImportRows(root, dl.rows)
+	if err != nil {
+		sg.metrics.recordEDSAttempt(ctx, dl.attempts, false)
+		return nil, err
+	}
+	sg.metrics.recordEDSAttempt(ctx, dl.attempts, true)
+	return square, nil
+}
+
+// rowsDownload is the state of a parallel EDS download.
+type rowsDownload struct {
+	root   *share.Root
+	ranges chan rowRange
+	done   chan struct{}
+
+	lk       sync.Mutex
+	rows     map[int][]share.Share
+	left     int
+	attempts int
+	err      error
+	// square is the whole EDS, if it was downloaded from a peer not supporting requests of rows
+	square *rsmt2d.ExtendedDataSquare
+	// working counts the ranges being downloaded from each peer
+	working map[peer.ID]int
+}
+
+// downloadRows downloads row ranges until all the parts of the ODS are downloaded or the context
+// is done.
+func (sg *ShrexGetter) downloadRows(ctx context.Context, dl *rowsDownload) {
+	for {
+		var rng rowRange
+		select {
+		case rng = <-dl.ranges:
+		case <-ctx.Done():
+			return
+		}
+
+		err := sg.requestRows(ctx, dl, rng)
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		dl.lk.Lock()
+		if !ErrorContains(dl.err, err) {
+			dl.err = errors.Join(dl.err, err)
+		}
+		dl.lk.Unlock()
+		// the part is retried with the other half of the rows by any worker
+		dl.ranges <- rng.alternative(len(dl.root.RowRoots) / 2)
+	}
+}
+
+func (sg *ShrexGetter) requestRows(ctx context.Context, dl *rowsDownload, rng rowRange) error {
+	dl.lk.Lock()
+	dl.attempts++
+	exclude := make([]peer.ID, 0, len(dl.working))
+	for id := range dl.working {
+		exclude = append(exclude, id)
+	}
+	dl.lk.Unlock()
+
+	start := time.Now()
+	peerID, setStatus, err := sg.peerManager.Peer(ctx, dl.root.Hash(), peers.ProtocolEDS, exclude...)
+	if err != nil {
+		log.Debugw("rows: couldn't find peer",
+			"hash", dl.root.String(),
+			"err", err,
+			"finished (s)", time.Since(start))
+		return err
+	}
+
+	dl.lk.Lock()
+	dl.working[peerID]++
+	dl.lk.Unlock()
+	defer func() {
+		dl.lk.Lock()
+		if dl.working[peerID]--; dl.working[peerID] == 0 {
+			delete(dl.working, peerID)
+		}
+		dl.lk.Unlock()
+	}()
+
+	reqStart := time.Now()
+	reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount, sg.minRequestTimeout)
+	rows, err := sg.edsClient.RequestRows(reqCtx, dl.root, rng.from, rng.to, peerID)
+	cancel()
+	if errors.Is(err, shrexeds.ErrRowsNotSupported) {
+		return sg.requestSquare(ctx, dl, peerID, setStatus)
+	}
+	switch {
+	case err == nil:
+		setStatus(peers.ResultNoop, peers.WithReceived(len(rows)*len(dl.root.RowRoots)/2*share.Size))
+		dl.addRows(rng, rows)
+		return nil
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, context.Canceled):
+		setStatus(peers.ResultCooldownPeer)
+	case errors.Is(err, p2p.ErrNotFound):
+		err = share.ErrNotFound
+		setStatus(peers.ResultCooldownPeer)
+	default:
+		// invalid responses are not punished by blacklisting, as they may come from peers
+		// misunderstanding the request rather than from malicious ones
+		setStatus(peers.ResultCooldownPeer)
+	}
+
+	log.Debugw("rows: request failed",
+		"hash", dl.root.String(),
+		"from", rng.from,
+		"to", rng.to,
+		"peer", peerID.String(),
+		"err", err,
+		"finished (s)", time.Since(reqStart))
+	return err
+}
+
+// requestSquare requests the whole EDS from the peer not supporting requests of rows, the same way
+// GetEDSByHash does.
+func (sg *ShrexGetter) requestSquare(
+	ctx context.Context,
+	dl *rowsDownload,
+	peerID peer.ID,
+	setStatus peers.DoneFunc,
+) error {
+	reqStart := time.Now()
+	reqCtx, cancel := ctxWithSplitTimeout(ctx, sg.minAttemptsCount, sg.minRequestTimeout)
+	square, err := sg.edsClient.RequestEDS(reqCtx, dl.root.Hash(), peerID)
+	cancel()
+	switch {
+	case err == nil:
+		odsWidth := len(dl.root.RowRoots) / 2
+		setStatus(peers.ResultSynced, peers.WithReceived(odsWidth*odsWidth*share.Size))
+		dl.setSquare(square)
+		return nil
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, context.Canceled):
+		setStatus(peers.ResultCooldownPeer)
+	case errors.Is(err, p2p.ErrNotFound):
+		err = share.ErrNotFound
+		setStatus(peers.ResultCooldownPeer)
+	case errors.Is(err, p2p.ErrInvalidResponse):
+		setStatus(peers.ResultBlacklistPeer)
+	default:
+		setStatus(peers.ResultCooldownPeer)
+	}
+
+	log.Debugw("rows: eds request failed",
+		"hash", dl.root.String(),
+		"peer", peerID.String(),
+		"err", err,
+		"finished (s)", time.Since(reqStart))
+	return err
+}
+
+func (dl *rowsDownload) setSquare(square *rsmt2d.ExtendedDataSquare) {
+	dl.lk.Lock()
+	defer dl.lk.Unlock()
+
+	if dl.left == 0 {
+		return
+	}
+	dl.square = square
+	dl.left = 0
+	close(dl.done)
+}
+
+func (dl *rowsDownload) addRows(rng rowRange, rows [][]share.Share) {
+	dl.lk.Lock()
+	defer dl.lk.Unlock()
+
+	if dl.left == 0 {
+		// the whole EDS was downloaded already
+		return
+	}
+
+	for i, row := range rows {
+		dl.rows[rng.from+i] = row
+	}
+	dl.left--
+	if dl.left == 0 {
+		close(dl.done)
+	}
+}
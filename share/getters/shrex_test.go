@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"io"
 	"math/rand"
 	"sort"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	ds_sync "github.com/ipfs/go-datastore/sync"
 	routinghelpers "github.com/libp2p/go-libp2p-routing-helpers"
 	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
 	routingdisc "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	"github.com/libp2p/go-libp2p/p2p/net/conngater"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
@@ -22,6 +24,7 @@ import (
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/wrapper"
 	libhead "github.com/celestiaorg/go-header"
+	"github.com/celestiaorg/go-libp2p-messenger/serde"
 	"github.com/celestiaorg/nmt"
 	"github.com/celestiaorg/rsmt2d"
 
@@ -30,9 +33,12 @@ import (
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/ipld"
+	"github.com/celestiaorg/celestia-node/share/p2p"
 	"github.com/celestiaorg/celestia-node/share/p2p/discovery"
 	"github.com/celestiaorg/celestia-node/share/p2p/peers"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexeds"
+	shrexeds_pb "github.com/celestiaorg/celestia-node/share/p2p/shrexeds/pb"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexnd"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
 	"github.com/celestiaorg/celestia-node/share/sharetest"
@@ -60,7 +66,7 @@ func TestShrexGetter(t *testing.T) {
 	sub := new(headertest.Subscriber)
 	peerManager, err := testManager(ctx, clHost, sub)
 	require.NoError(t, err)
-	getter := NewShrexGetter(edsClient, ndClient, peerManager)
+	getter := NewShrexGetter(edsClient, ndClient, peerManager, DefaultShrexParameters())
 	require.NoError(t, getter.Start(ctx))
 
 	t.Run("ND_Available, total data size > 1mb", func(t *testing.T) {
@@ -81,6 +87,36 @@ func TestShrexGetter(t *testing.T) {
 		require.NoError(t, got.Verify(&dah, namespace))
 	})
 
+	t.Run("ND_rows", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+		t.Cleanup(cancel)
+
+		// generate test data
+		namespace := sharetest.RandV0Namespace()
+		randEDS, dah := singleNamespaceEds(t, namespace, 16)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), randEDS))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		var rows []int
+		err := getter.GetRowsByNamespace(ctx, &dah, namespace, func(row int, nr share.NamespacedRow) error {
+			require.NoError(t, nr.Verify(&dah, row, namespace))
+			rows = append(rows, row)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, ipld.FilterRowsByNamespace(&dah, namespace, 0, 0), rows)
+
+		// an error returned by put aborts the request
+		errStop := errors.New("stop")
+		err = getter.GetRowsByNamespace(ctx, &dah, namespace, func(int, share.NamespacedRow) error {
+			return errStop
+		})
+		require.ErrorIs(t, err, errStop)
+	})
+
 	t.Run("ND_err_not_found", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 		t.Cleanup(cancel)
@@ -111,7 +147,7 @@ func TestShrexGetter(t *testing.T) {
 		namespace, err := addToNamespace(maxNamespace, -1)
 		require.NoError(t, err)
 		// check for namespace to be between max and min namespace in root
-		require.Len(t, filterRootsByNamespace(&dah, namespace), 1)
+		require.Len(t, ipld.FilterRootsByNamespace(&dah, namespace), 1)
 
 		emptyShares, err := getter.GetSharesByNamespace(ctx, &dah, namespace)
 		require.NoError(t, err)
@@ -135,7 +171,7 @@ func TestShrexGetter(t *testing.T) {
 		namespace, err := addToNamespace(maxNamesapce, 1)
 		require.NoError(t, err)
 		// check for namespace to be not in root
-		require.Len(t, filterRootsByNamespace(&dah, namespace), 0)
+		require.Len(t, ipld.FilterRootsByNamespace(&dah, namespace), 0)
 
 		emptyShares, err := getter.GetSharesByNamespace(ctx, &dah, namespace)
 		require.NoError(t, err)
@@ -144,6 +180,39 @@ func TestShrexGetter(t *testing.T) {
 		require.Nil(t, emptyShares.Verify(&dah, namespace))
 	})
 
+	t.Run("ND_batch", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		eds, dah, maxNamespace := generateTestEDS(t)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		notIncluded, err := addToNamespace(maxNamespace, -1)
+		require.NoError(t, err)
+		notInDAH, err := addToNamespace(maxNamespace, 1)
+		require.NoError(t, err)
+		namespaces := []share.Namespace{maxNamespace, notIncluded, notInDAH}
+
+		got, err := getter.GetSharesByNamespaces(ctx, &dah, namespaces)
+		require.NoError(t, err)
+		require.Len(t, got, len(namespaces))
+		for i, namespace := range namespaces {
+			require.NoError(t, got[i].Verify(&dah, namespace))
+		}
+		require.NotEmpty(t, got[0].Flatten())
+		require.Empty(t, got[1].Flatten())
+		require.Empty(t, got[2].Flatten())
+
+		single, err := getter.GetSharesByNamespace(ctx, &dah, maxNamespace)
+		require.NoError(t, err)
+		require.Equal(t, single.Flatten(), got[0].Flatten())
+	})
+
 	t.Run("EDS_Available", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 		t.Cleanup(cancel)
@@ -161,6 +230,27 @@ func TestShrexGetter(t *testing.T) {
 		require.Equal(t, randEDS.Flattened(), got.Flattened())
 	})
 
+	t.Run("EDS_Available_by_rows", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		t.Cleanup(cancel)
+
+		// generate test data
+		randEDS, dah, _ := generateTestEDS(t)
+		require.NoError(t, edsStore.Put(ctx, dah.Hash(), randEDS))
+		peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+			DataHash: dah.Hash(),
+			Height:   1,
+		})
+
+		rowsGetter := NewShrexGetter(edsClient, ndClient, peerManager, ShrexParameters{
+			ParallelRanges:      3,
+			MinParallelODSWidth: 1,
+		})
+		got, err := rowsGetter.GetEDS(ctx, &dah)
+		require.NoError(t, err)
+		require.Equal(t, randEDS.Flattened(), got.Flattened())
+	})
+
 	t.Run("EDS_ctx_deadline", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(ctx, time.Second)
 
@@ -192,6 +282,74 @@ func TestShrexGetter(t *testing.T) {
 	})
 }
 
+func TestShrexGetter_LegacyEDSServer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	net, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err)
+	clHost, srvHost := net.Hosts()[0], net.Hosts()[1]
+
+	edsStore, err := newStore(t)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Start(ctx))
+
+	// a peer not supporting requests of rows ignores the range and streams the whole ODS
+	params := shrexeds.DefaultParameters()
+	srvHost.SetStreamHandler(p2p.ProtocolID(params.NetworkID(), "/shrex/eds/v0.0.1"), func(stream network.Stream) {
+		req := new(shrexeds_pb.EDSRequest)
+		if _, err := serde.Read(stream, req); err != nil {
+			stream.Reset() //nolint:errcheck
+			return
+		}
+		edsReader, err := edsStore.GetCAR(ctx, req.Hash)
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			return
+		}
+		odsReader, err := eds.ODSReader(edsReader)
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			return
+		}
+		_, err = serde.Write(stream, &shrexeds_pb.EDSResponse{Status: shrexeds_pb.Status_OK})
+		if err == nil {
+			_, err = io.Copy(stream, odsReader)
+		}
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			return
+		}
+		stream.Close() //nolint:errcheck
+	})
+	edsClient, err := shrexeds.NewClient(params, clHost)
+	require.NoError(t, err)
+
+	peerManager, err := testManager(ctx, clHost, new(headertest.Subscriber))
+	require.NoError(t, err)
+	getter := NewShrexGetter(edsClient, nil, peerManager, ShrexParameters{
+		ParallelRanges:      3,
+		MinParallelODSWidth: 1,
+	})
+	require.NoError(t, getter.Start(ctx))
+	t.Cleanup(func() {
+		_ = getter.Stop(ctx)
+	})
+
+	randEDS, dah, _ := generateTestEDS(t)
+	require.NoError(t, edsStore.Put(ctx, dah.Hash(), randEDS))
+	peerManager.Validate(ctx, srvHost.ID(), shrexsub.Notification{
+		DataHash: dah.Hash(),
+		Height:   1,
+	})
+
+	// the whole EDS is requested from the peer instead of the rows
+	got, err := getter.GetEDS(ctx, &dah)
+	require.NoError(t, err)
+	require.Equal(t, randEDS.Flattened(), got.Flattened())
+	require.Empty(t, peerManager.Blacklist())
+}
+
 func newStore(t *testing.T) (*eds.Store, error) {
 	t.Helper()
 
@@ -232,6 +390,7 @@ func testManager(
 		disc,
 		host,
 		connGater,
+		ds_sync.MutexWrap(datastore.NewMapDatastore()),
 	)
 	return manager, err
 }
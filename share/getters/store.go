@@ -18,21 +18,20 @@ import (
 
 var _ share.Getter = (*StoreGetter)(nil)
 
-// StoreGetter is a share.Getter that retrieves shares from an eds.Store. No results are saved to
-// the eds.Store after retrieval.
+// StoreGetter is a share.Getter that retrieves shares from an eds.EDSStore. No results are saved
+// to the eds.EDSStore after retrieval.
 type StoreGetter struct {
-	store *eds.Store
+	store eds.EDSStore
 }
 
-// NewStoreGetter creates a new share.Getter that retrieves shares from an eds.Store.
-func NewStoreGetter(store *eds.Store) *StoreGetter {
+// NewStoreGetter creates a new share.Getter that retrieves shares from an eds.EDSStore.
+func NewStoreGetter(store eds.EDSStore) *StoreGetter {
 	return &StoreGetter{
 		store: store,
 	}
 }
 
-// GetShare gets a single share at the given EDS coordinates from the eds.Store through the
-// corresponding CAR-level blockstore.
+// GetShare gets a single share at the given EDS coordinates from the eds.EDSStore.
 func (sg *StoreGetter) GetShare(ctx context.Context, dah *share.Root, row, col int) (share.Share, error) {
 	var err error
 	ctx, span := tracer.Start(ctx, "store/get-share", trace.WithAttributes(
@@ -49,20 +48,9 @@ func (sg *StoreGetter) GetShare(ctx context.Context, dah *share.Root, row, col i
 		span.RecordError(err)
 		return nil, err
 	}
-	root, leaf := ipld.Translate(dah, row, col)
-	bs, err := sg.store.CARBlockstore(ctx, dah.Hash())
-	if errors.Is(err, eds.ErrNotFound) {
-		// convert error to satisfy getter interface contract
-		err = share.ErrNotFound
-	}
-	if err != nil {
-		return nil, fmt.Errorf("getter/store: failed to retrieve blockstore: %w", err)
-	}
 
-	// wrap the read-only CAR blockstore in a getter
-	blockGetter := eds.NewBlockGetter(bs)
-	s, err := ipld.GetShare(ctx, blockGetter, root, leaf, len(dah.RowRoots))
-	if errors.Is(err, ipld.ErrNodeNotFound) {
+	s, err := sg.store.GetShare(ctx, dah, row, col)
+	if errors.Is(err, eds.ErrNotFound) || errors.Is(err, ipld.ErrNodeNotFound) {
 		// convert error to satisfy getter interface contract
 		err = share.ErrNotFound
 	}
@@ -91,8 +79,7 @@ func (sg *StoreGetter) GetEDS(ctx context.Context, root *share.Root) (data *rsmt
 	return data, nil
 }
 
-// GetSharesByNamespace gets all EDS shares in the given namespace from the EDS store through the
-// corresponding CAR-level blockstore.
+// GetSharesByNamespace gets all EDS shares in the given namespace from the EDS store.
 func (sg *StoreGetter) GetSharesByNamespace(
 	ctx context.Context,
 	root *share.Root,
@@ -109,21 +96,56 @@ func (sg *StoreGetter) GetSharesByNamespace(
 		return nil, err
 	}
 
-	bs, err := sg.store.CARBlockstore(ctx, root.Hash())
+	shares, err = sg.store.GetSharesByNamespace(ctx, root, namespace)
 	if errors.Is(err, eds.ErrNotFound) {
 		// convert error to satisfy getter interface contract
 		err = share.ErrNotFound
 	}
-	if err != nil {
-		return nil, fmt.Errorf("getter/store: failed to retrieve blockstore: %w", err)
-	}
-
-	// wrap the read-only CAR blockstore in a getter
-	blockGetter := eds.NewBlockGetter(bs)
-	shares, err = collectSharesByNamespace(ctx, blockGetter, root, namespace)
 	if err != nil {
 		return nil, fmt.Errorf("getter/store: failed to retrieve shares by namespace: %w", err)
 	}
 
 	return shares, nil
 }
+
+// GetSharesByNamespaces gets all EDS shares in the given namespaces from the EDS store.
+func (sg *StoreGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	return getSharesByNamespaces(ctx, root, namespaces, sg.GetSharesByNamespace)
+}
+
+// GetRowsByNamespace passes the EDS rows within the given namespace and row range to put one by
+// one, as soon as each of them is read from the EDS store. Zero toRow means the end of the square.
+func (sg *StoreGetter) GetRowsByNamespace(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+	fromRow, toRow int,
+	put func(row int, nr share.NamespacedRow) error,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "store/get-rows-by-namespace", trace.WithAttributes(
+		attribute.String("namespace", namespace.String()),
+		attribute.Int("from_row", fromRow),
+		attribute.Int("to_row", toRow),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	if err = namespace.ValidateForData(); err != nil {
+		return err
+	}
+
+	err = sg.store.GetRowsByNamespace(ctx, root, namespace, fromRow, toRow, put)
+	if errors.Is(err, eds.ErrNotFound) {
+		// convert error to satisfy getter interface contract
+		err = share.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("getter/store: failed to retrieve rows by namespace: %w", err)
+	}
+	return nil
+}
@@ -23,11 +23,11 @@ var _ share.Getter = (*TeeGetter)(nil)
 // eds.Store.
 type TeeGetter struct {
 	getter share.Getter
-	store  *eds.Store
+	store  eds.EDSStore
 }
 
 // NewTeeGetter creates a new TeeGetter.
-func NewTeeGetter(getter share.Getter, store *eds.Store) *TeeGetter {
+func NewTeeGetter(getter share.Getter, store eds.EDSStore) *TeeGetter {
 	return &TeeGetter{
 		getter: getter,
 		store:  store,
@@ -83,3 +83,18 @@ func (tg *TeeGetter) GetSharesByNamespace(
 
 	return tg.getter.GetSharesByNamespace(ctx, root, namespace)
 }
+
+func (tg *TeeGetter) GetSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+) (shares []share.NamespacedShares, err error) {
+	ctx, span := tracer.Start(ctx, "tee/get-shares-by-namespaces", trace.WithAttributes(
+		attribute.Int("namespaces", len(namespaces)),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	return tg.getter.GetSharesByNamespaces(ctx, root, namespaces)
+}
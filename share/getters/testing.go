@@ -54,6 +54,12 @@ func (seg *SingleEDSGetter) GetSharesByNamespace(context.Context, *share.Root, s
 	panic("SingleEDSGetter: GetSharesByNamespace is not implemented")
 }
 
+// GetSharesByNamespaces returns NamespacedShares from a kept EDS if the correct root is given.
+func (seg *SingleEDSGetter) GetSharesByNamespaces(context.Context, *share.Root, []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	panic("SingleEDSGetter: GetSharesByNamespaces is not implemented")
+}
+
 func (seg *SingleEDSGetter) checkRoot(root *share.Root) error {
 	dah, err := da.NewDataAvailabilityHeader(seg.EDS)
 	if err != nil {
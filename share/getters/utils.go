@@ -3,20 +3,13 @@ package getters
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
-	"github.com/ipfs/go-blockservice"
-	"github.com/ipfs/go-cid"
 	logging "github.com/ipfs/go-log/v2"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 
-	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
-	"github.com/celestiaorg/celestia-node/share/ipld"
 )
 
 var (
@@ -26,62 +19,6 @@ var (
 	errOperationNotSupported = errors.New("operation is not supported")
 )
 
-// filterRootsByNamespace returns the row roots from the given share.Root that contain the passed
-// namespace.
-func filterRootsByNamespace(root *share.Root, namespace share.Namespace) []cid.Cid {
-	rowRootCIDs := make([]cid.Cid, 0, len(root.RowRoots))
-	for _, row := range root.RowRoots {
-		if !namespace.IsOutsideRange(row, row) {
-			rowRootCIDs = append(rowRootCIDs, ipld.MustCidFromNamespacedSha256(row))
-		}
-	}
-	return rowRootCIDs
-}
-
-// collectSharesByNamespace collects NamespaceShares within the given namespace from share.Root.
-func collectSharesByNamespace(
-	ctx context.Context,
-	bg blockservice.BlockGetter,
-	root *share.Root,
-	namespace share.Namespace,
-) (shares share.NamespacedShares, err error) {
-	ctx, span := tracer.Start(ctx, "collect-shares-by-namespace", trace.WithAttributes(
-		attribute.String("namespace", namespace.String()),
-	))
-	defer func() {
-		utils.SetStatusAndEnd(span, err)
-	}()
-
-	rootCIDs := filterRootsByNamespace(root, namespace)
-	if len(rootCIDs) == 0 {
-		return nil, nil
-	}
-
-	errGroup, ctx := errgroup.WithContext(ctx)
-	shares = make([]share.NamespacedRow, len(rootCIDs))
-	for i, rootCID := range rootCIDs {
-		// shadow loop variables, to ensure correct values are captured
-		i, rootCID := i, rootCID
-		errGroup.Go(func() error {
-			row, proof, err := ipld.GetSharesByNamespace(ctx, bg, rootCID, namespace, len(root.RowRoots))
-			shares[i] = share.NamespacedRow{
-				Shares: row,
-				Proof:  proof,
-			}
-			if err != nil {
-				return fmt.Errorf("retrieving shares by namespace %s for row %x: %w", namespace.String(), rootCID, err)
-			}
-			return nil
-		})
-	}
-
-	if err := errGroup.Wait(); err != nil {
-		return nil, err
-	}
-
-	return shares, nil
-}
-
 // ctxWithSplitTimeout will split timeout stored in context by splitFactor and return the result if
 // it is greater than minTimeout. minTimeout == 0 will be ignored, splitFactor <= 0 will be ignored
 func ctxWithSplitTimeout(
@@ -121,3 +58,30 @@ func ErrorContains(err, target error) bool {
 	}
 	return ErrorContains(err, target)
 }
+
+// getSharesByNamespaces gets the shares of every namespace concurrently using the given
+// single-namespace getter. It serves share.Getters without native support for batched requests.
+func getSharesByNamespaces(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+	get func(context.Context, *share.Root, share.Namespace) (share.NamespacedShares, error),
+) ([]share.NamespacedShares, error) {
+	shares := make([]share.NamespacedShares, len(namespaces))
+	errGr, ctx := errgroup.WithContext(ctx)
+	for i, namespace := range namespaces {
+		i, namespace := i, namespace
+		errGr.Go(func() error {
+			nsShares, err := get(ctx, root, namespace)
+			if err != nil {
+				return err
+			}
+			shares[i] = nsShares
+			return nil
+		})
+	}
+	if err := errGr.Wait(); err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
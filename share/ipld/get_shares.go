@@ -2,13 +2,18 @@ package ipld
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ipfs/go-blockservice"
 	"github.com/ipfs/go-cid"
 	format "github.com/ipfs/go-ipld-format"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/celestiaorg/nmt"
 
+	"github.com/celestiaorg/celestia-node/libs/utils"
 	"github.com/celestiaorg/celestia-node/share"
 )
 
@@ -77,3 +82,108 @@ func leafToShare(nd format.Node) share.Share {
 	// namespace, which we cut off
 	return share.GetData(nd.RawData())
 }
+
+// FilterRootsByNamespace returns the row roots from the given share.Root that contain the passed
+// namespace.
+func FilterRootsByNamespace(root *share.Root, namespace share.Namespace) []cid.Cid {
+	rowRootCIDs := make([]cid.Cid, 0, len(root.RowRoots))
+	for _, row := range root.RowRoots {
+		if !namespace.IsOutsideRange(row, row) {
+			rowRootCIDs = append(rowRootCIDs, MustCidFromNamespacedSha256(row))
+		}
+	}
+	return rowRootCIDs
+}
+
+// CollectSharesByNamespace collects NamespaceShares within the given namespace from share.Root
+// using the given BlockGetter.
+func CollectSharesByNamespace(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	root *share.Root,
+	namespace share.Namespace,
+) (shares share.NamespacedShares, err error) {
+	ctx, span := tracer.Start(ctx, "collect-shares-by-namespace", trace.WithAttributes(
+		attribute.String("namespace", namespace.String()),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	rootCIDs := FilterRootsByNamespace(root, namespace)
+	if len(rootCIDs) == 0 {
+		return nil, nil
+	}
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+	shares = make([]share.NamespacedRow, len(rootCIDs))
+	for i, rootCID := range rootCIDs {
+		// shadow loop variables, to ensure correct values are captured
+		i, rootCID := i, rootCID
+		errGroup.Go(func() error {
+			row, proof, err := GetSharesByNamespace(ctx, bg, rootCID, namespace, len(root.RowRoots))
+			shares[i] = share.NamespacedRow{
+				Shares: row,
+				Proof:  proof,
+			}
+			if err != nil {
+				return fmt.Errorf("retrieving shares by namespace %s for row %x: %w", namespace.String(), rootCID, err)
+			}
+			return nil
+		})
+	}
+
+	if err := errGroup.Wait(); err != nil {
+		return nil, err
+	}
+
+	return shares, nil
+}
+
+// CollectRowsByNamespace collects the rows within the range [fromRow, toRow) of the share.Root
+// that contain the given namespace one by one, passing each NamespacedRow to put together with its
+// row index as soon as it is collected. Zero toRow means the end of the square.
+func CollectRowsByNamespace(
+	ctx context.Context,
+	bg blockservice.BlockGetter,
+	root *share.Root,
+	namespace share.Namespace,
+	fromRow, toRow int,
+	put func(row int, nr share.NamespacedRow) error,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "collect-rows-by-namespace", trace.WithAttributes(
+		attribute.String("namespace", namespace.String()),
+		attribute.Int("from_row", fromRow),
+		attribute.Int("to_row", toRow),
+	))
+	defer func() {
+		utils.SetStatusAndEnd(span, err)
+	}()
+
+	for _, row := range FilterRowsByNamespace(root, namespace, fromRow, toRow) {
+		rootCID := MustCidFromNamespacedSha256(root.RowRoots[row])
+		shares, proof, err := GetSharesByNamespace(ctx, bg, rootCID, namespace, len(root.RowRoots))
+		if err != nil {
+			return fmt.Errorf("retrieving shares by namespace %s for row %x: %w", namespace.String(), rootCID, err)
+		}
+		if err = put(row, share.NamespacedRow{Shares: shares, Proof: proof}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FilterRowsByNamespace returns the indices of the rows within the range [fromRow, toRow) of the
+// share.Root that contain the passed namespace. Zero toRow means the end of the square.
+func FilterRowsByNamespace(root *share.Root, namespace share.Namespace, fromRow, toRow int) []int {
+	if toRow == 0 || toRow > len(root.RowRoots) {
+		toRow = len(root.RowRoots)
+	}
+	var rows []int
+	for row := fromRow; row < toRow; row++ {
+		if !namespace.IsOutsideRange(root.RowRoots[row], root.RowRoots[row]) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
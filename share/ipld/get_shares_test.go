@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/wrapper"
 	"github.com/celestiaorg/rsmt2d"
 
@@ -329,6 +330,45 @@ func TestCollectLeavesByNamespace_MultipleRowsContainingSameNamespaceId(t *testi
 	}
 }
 
+func TestCollectRowsByNamespace(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	bServ := mdutils.Bserv()
+
+	// spread a single namespace over all the ODS rows
+	shares := sharetest.RandShares(t, 16)
+	namespace := share.GetNamespace(shares[0])
+	for i := range shares {
+		copy(shares[i][:share.NamespaceSize], namespace)
+	}
+	eds, err := AddShares(ctx, shares, bServ)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	expected, err := CollectSharesByNamespace(ctx, bServ, &dah, namespace)
+	require.NoError(t, err)
+	require.Len(t, expected, 4)
+
+	var rows []int
+	err = CollectRowsByNamespace(ctx, bServ, &dah, namespace, 1, 3, func(row int, nr share.NamespacedRow) error {
+		require.NoError(t, nr.Verify(&dah, row, namespace))
+		require.Equal(t, expected[row].Shares, nr.Shares)
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, rows)
+	require.Equal(t, []int{0, 1, 2, 3}, FilterRowsByNamespace(&dah, namespace, 0, 0))
+
+	// an error returned by put aborts the collection
+	errStop := errors.New("stop")
+	err = CollectRowsByNamespace(ctx, bServ, &dah, namespace, 0, 0, func(int, share.NamespacedRow) error {
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+}
+
 func TestGetSharesWithProofsByNamespace(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	t.Cleanup(cancel)
@@ -82,3 +82,18 @@ func (mr *MockGetterMockRecorder) GetSharesByNamespace(arg0, arg1, arg2 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespace", reflect.TypeOf((*MockGetter)(nil).GetSharesByNamespace), arg0, arg1, arg2)
 }
+
+// GetSharesByNamespaces mocks base method.
+func (m *MockGetter) GetSharesByNamespaces(arg0 context.Context, arg1 *da.DataAvailabilityHeader, arg2 []share.Namespace) ([]share.NamespacedShares, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharesByNamespaces", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]share.NamespacedShares)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharesByNamespaces indicates an expected call of GetSharesByNamespaces.
+func (mr *MockGetterMockRecorder) GetSharesByNamespaces(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByNamespaces", reflect.TypeOf((*MockGetter)(nil).GetSharesByNamespaces), arg0, arg1, arg2)
+}
@@ -0,0 +1,234 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+// Compression is the algorithm compressing the data sent over shrex streams. Compression is
+// negotiated per stream by the protocol ID suffix.
+type Compression string
+
+const (
+	// CompressionZstd compresses streams with zstd.
+	CompressionZstd Compression = "zstd"
+	// CompressionSnappy compresses streams with snappy framing format.
+	CompressionSnappy Compression = "snappy"
+)
+
+// zstdMaxWindow limits the memory used by the zstd decoder of a single stream.
+const zstdMaxWindow = 8 << 20
+
+// Validate checks whether the Compression is supported.
+func (c Compression) Validate() error {
+	switch c {
+	case CompressionZstd, CompressionSnappy:
+		return nil
+	default:
+		return fmt.Errorf("unknown compression: %q", c)
+	}
+}
+
+// ProtocolIDs returns the protocol IDs for the enabled compressions in preference order, followed
+// by the uncompressed one.
+func (p *Parameters) ProtocolIDs(base protocol.ID) []protocol.ID {
+	ids := make([]protocol.ID, 0, len(p.Compression)+1)
+	for _, c := range p.Compression {
+		ids = append(ids, compressedProtocolID(base, c))
+	}
+	return append(ids, base)
+}
+
+func compressedProtocolID(base protocol.ID, c Compression) protocol.ID {
+	return protocol.ID(fmt.Sprintf("%s/%s", base, c))
+}
+
+// CompressStream wraps the stream opened over one of the ProtocolIDs of the base protocol, so that
+// the data is compressed and decompressed according to the negotiated protocol. Uncompressed
+// streams are returned as is.
+func CompressStream(stream network.Stream, base protocol.ID, metrics *Metrics) network.Stream {
+	c, ok := strings.CutPrefix(string(stream.Protocol()), string(base)+"/")
+	if !ok || Compression(c).Validate() != nil {
+		return stream
+	}
+	s := &compressedStream{
+		Stream:      stream,
+		compression: Compression(c),
+		metrics:     metrics,
+	}
+	s.compressedW = &countingWriter{w: stream}
+	s.compressedR = &countingReader{r: stream}
+	return s
+}
+
+// compressedStream compresses the data written to and decompresses the data read from the stream.
+// Every write is flushed, so that messages are never delayed by the compressor.
+type compressedStream struct {
+	network.Stream
+	compression Compression
+	metrics     *Metrics
+
+	compressedW *countingWriter
+	compressedR *countingReader
+	rawWritten  int64
+	rawRead     int64
+
+	enc     compressor
+	dec     io.Reader
+	decDone func()
+
+	observeOnce sync.Once
+}
+
+// compressor is a flushable compressing writer.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+}
+
+func (s *compressedStream) Read(p []byte) (int, error) {
+	if s.dec == nil {
+		if err := s.initDecoder(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := s.dec.Read(p)
+	s.rawRead += int64(n)
+	return n, err
+}
+
+func (s *compressedStream) Write(p []byte) (int, error) {
+	if s.enc == nil {
+		if err := s.initEncoder(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := s.enc.Write(p)
+	s.rawWritten += int64(n)
+	if err != nil {
+		return n, err
+	}
+	return n, s.enc.Flush()
+}
+
+// CloseWrite finalizes the compressed data before closing the write side of the stream.
+func (s *compressedStream) CloseWrite() error {
+	s.closeEncoder()
+	return s.Stream.CloseWrite()
+}
+
+func (s *compressedStream) Close() error {
+	s.closeEncoder()
+	s.closeDecoder()
+	s.observe()
+	return s.Stream.Close()
+}
+
+func (s *compressedStream) Reset() error {
+	s.closeDecoder()
+	s.observe()
+	return s.Stream.Reset()
+}
+
+func (s *compressedStream) initEncoder() error {
+	switch s.compression {
+	case CompressionZstd:
+		enc, err := zstd.NewWriter(s.compressedW,
+			zstd.WithEncoderLevel(zstd.SpeedFastest),
+			zstd.WithEncoderConcurrency(1),
+			zstd.WithLowerEncoderMem(true),
+		)
+		if err != nil {
+			return fmt.Errorf("creating zstd encoder: %w", err)
+		}
+		s.enc = enc
+	case CompressionSnappy:
+		s.enc = snappy.NewBufferedWriter(s.compressedW)
+	}
+	return nil
+}
+
+func (s *compressedStream) initDecoder() error {
+	switch s.compression {
+	case CompressionZstd:
+		dec, err := zstd.NewReader(s.compressedR,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderLowmem(true),
+			zstd.WithDecoderMaxWindow(zstdMaxWindow),
+		)
+		if err != nil {
+			return fmt.Errorf("creating zstd decoder: %w", err)
+		}
+		s.dec, s.decDone = dec, dec.Close
+	case CompressionSnappy:
+		s.dec, s.decDone = snappy.NewReader(s.compressedR), func() {}
+	}
+	return nil
+}
+
+// closeEncoder writes the end of the compressed data. As every write is flushed, the peer has
+// already received all the messages, so failing to write the end, e.g. because the peer has closed
+// its read side after reading the message it waited for, must not break the stream.
+func (s *compressedStream) closeEncoder() {
+	if s.enc == nil {
+		return
+	}
+	enc := s.enc
+	s.enc = closedCompressor{}
+	if err := enc.Close(); err != nil {
+		log.Debugw("closing compressor", "compression", s.compression, "err", err)
+	}
+}
+
+func (s *compressedStream) closeDecoder() {
+	if s.decDone != nil {
+		s.decDone()
+		s.decDone = nil
+	}
+}
+
+// observe records the compression ratio of the data sent and received over the stream.
+func (s *compressedStream) observe() {
+	s.observeOnce.Do(func() {
+		ctx := context.Background()
+		s.metrics.ObserveCompression(ctx, s.compression, "sent", s.rawWritten, s.compressedW.n)
+		s.metrics.ObserveCompression(ctx, s.compression, "received", s.rawRead, s.compressedR.n)
+	})
+}
+
+// closedCompressor fails writes to the stream with the finalized compressed data.
+type closedCompressor struct{}
+
+func (closedCompressor) Write([]byte) (int, error) { return 0, io.ErrClosedPipe }
+func (closedCompressor) Flush() error              { return nil }
+func (closedCompressor) Close() error              { return nil }
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,175 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressStream(t *testing.T) {
+	const base = protocol.ID("/test/v0.0.1")
+
+	tests := []struct {
+		name             string
+		client, server   []Compression
+		expectedProtocol protocol.ID
+	}{
+		{
+			name:             "zstd",
+			client:           []Compression{CompressionZstd},
+			server:           []Compression{CompressionZstd},
+			expectedProtocol: base + "/zstd",
+		},
+		{
+			name:             "snappy",
+			client:           []Compression{CompressionSnappy},
+			server:           []Compression{CompressionZstd, CompressionSnappy},
+			expectedProtocol: base + "/snappy",
+		},
+		{
+			name:             "client preference",
+			client:           []Compression{CompressionSnappy, CompressionZstd},
+			server:           []Compression{CompressionZstd, CompressionSnappy},
+			expectedProtocol: base + "/snappy",
+		},
+		{
+			name:             "fallback to uncompressed",
+			client:           []Compression{CompressionZstd},
+			expectedProtocol: base,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			t.Cleanup(cancel)
+
+			net, err := mocknet.FullMeshConnected(2)
+			require.NoError(t, err)
+			client, server := net.Hosts()[0], net.Hosts()[1]
+
+			// server echoes the request back three times
+			serverParams := &Parameters{Compression: tt.server}
+			for _, id := range serverParams.ProtocolIDs(base) {
+				server.SetStreamHandler(id, func(stream network.Stream) {
+					stream = CompressStream(stream, base, nil)
+					req, err := io.ReadAll(stream)
+					if err != nil {
+						stream.Reset() //nolint:errcheck
+						return
+					}
+					for i := 0; i < 3; i++ {
+						if _, err = stream.Write(req); err != nil {
+							stream.Reset() //nolint:errcheck
+							return
+						}
+					}
+					stream.Close() //nolint:errcheck
+				})
+			}
+
+			clientParams := &Parameters{Compression: tt.client}
+			stream, err := client.NewStream(ctx, server.ID(), clientParams.ProtocolIDs(base)...)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedProtocol, stream.Protocol())
+			stream = CompressStream(stream, base, nil)
+
+			req := bytes.Repeat([]byte("compressible"), 1024)
+			_, err = stream.Write(req)
+			require.NoError(t, err)
+			require.NoError(t, stream.CloseWrite())
+
+			resp, err := io.ReadAll(stream)
+			require.NoError(t, err)
+			require.Equal(t, bytes.Repeat(req, 3), resp)
+			require.NoError(t, stream.Close())
+
+			if cs, ok := stream.(*compressedStream); ok {
+				require.Less(t, cs.compressedW.n, cs.rawWritten)
+				require.Less(t, cs.compressedR.n, cs.rawRead)
+			} else {
+				require.Empty(t, tt.server)
+			}
+		})
+	}
+}
+
+func TestCompressStream_PeerClosedRead(t *testing.T) {
+	const base = protocol.ID("/test/v0.0.1")
+
+	for _, compression := range []Compression{CompressionZstd, CompressionSnappy} {
+		t.Run(string(compression), func(t *testing.T) {
+			id := compressedProtocolID(base, compression)
+
+			// compress the response the peer sends after it has read the request
+			resp := []byte("response")
+			compressedResp := &bytes.Buffer{}
+			peer := CompressStream(&stubStream{protocol: id, w: compressedResp}, base, nil)
+			_, err := peer.Write(resp)
+			require.NoError(t, err)
+			require.NoError(t, peer.Close())
+
+			stub := &stubStream{protocol: id, w: io.Discard, r: compressedResp}
+			stream := CompressStream(stub, base, nil)
+			_, err = stream.Write([]byte("request"))
+			require.NoError(t, err)
+
+			// the peer has closed its read side, so the end of the compressed request can't be
+			// written anymore, which must not break the stream
+			stub.w = errWriter{io.ErrClosedPipe}
+			require.NoError(t, stream.CloseWrite())
+			require.False(t, stub.reset)
+
+			got, err := io.ReadAll(stream)
+			require.NoError(t, err)
+			require.Equal(t, resp, got)
+			require.NoError(t, stream.Close())
+			require.False(t, stub.reset)
+		})
+	}
+}
+
+// stubStream is the network.Stream writing to and reading from the given writer and reader.
+type stubStream struct {
+	network.Stream
+	protocol protocol.ID
+	w        io.Writer
+	r        io.Reader
+	reset    bool
+}
+
+func (s *stubStream) Protocol() protocol.ID       { return s.protocol }
+func (s *stubStream) Write(p []byte) (int, error) { return s.w.Write(p) }
+func (s *stubStream) Read(p []byte) (int, error)  { return s.r.Read(p) }
+func (s *stubStream) CloseWrite() error           { return nil }
+func (s *stubStream) Close() error                { return nil }
+func (s *stubStream) Reset() error {
+	s.reset = true
+	return nil
+}
+
+type errWriter struct{ err error }
+
+func (w errWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestParameters_Compression(t *testing.T) {
+	params := DefaultParameters()
+	require.NoError(t, params.Validate())
+
+	params.Compression = []Compression{CompressionZstd, CompressionSnappy}
+	require.NoError(t, params.Validate())
+	require.Equal(t, []protocol.ID{"/base/zstd", "/base/snappy", "/base"}, params.ProtocolIDs("/base"))
+
+	params.Compression = []Compression{CompressionZstd, CompressionZstd}
+	require.Error(t, params.Validate())
+
+	params.Compression = []Compression{"gzip"}
+	require.Error(t, params.Validate())
+}
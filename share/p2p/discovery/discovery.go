@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/libp2p/go-libp2p/core/discovery"
 	"github.com/libp2p/go-libp2p/core/event"
@@ -54,9 +55,17 @@ type Discovery struct {
 
 	triggerDisc chan struct{}
 
+	// store persists the known good peers, if set
+	store *peerStore
+	// known are the known peers loaded at start, which are dialled first
+	known []knownPeer
+	// scorer gives the scores of the peers persisted in the store
+	scorer func(peer.ID) float64
+
 	metrics *metrics
 
 	cancel context.CancelFunc
+	done   chan struct{}
 
 	params Parameters
 }
@@ -86,29 +95,70 @@ func NewDiscovery(
 	}
 }
 
-func (d *Discovery) Start(context.Context) error {
+// WithPeerStore makes Discovery persist the known good peers in the given datastore, so that they
+// are dialled first after a restart. It must be called before Start.
+func (d *Discovery) WithPeerStore(ds datastore.Datastore) {
+	if d.params.KnownPeersTTL == 0 {
+		return
+	}
+	d.store = newPeerStore(ds, d.params.KnownPeersTTL)
+}
+
+// WithPeerScorer sets the function giving the scores of the persisted peers. Peers with higher
+// scores are dialled first after a restart.
+func (d *Discovery) WithPeerScorer(scorer func(peer.ID) float64) {
+	d.scorer = scorer
+}
+
+func (d *Discovery) Start(startCtx context.Context) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	d.cancel = cancel
+	d.done = make(chan struct{})
 
 	if d.params.PeersLimit == 0 {
+		close(d.done)
 		log.Warn("peers limit is set to 0. Skipping discovery...")
 		return nil
 	}
 
 	sub, err := d.host.EventBus().Subscribe(&event.EvtPeerConnectednessChanged{}, eventbus.BufSize(eventbusBufSize))
 	if err != nil {
+		close(d.done)
 		return fmt.Errorf("subscribing for connection events: %w", err)
 	}
 
+	if d.store != nil {
+		d.known, err = d.store.load(startCtx)
+		if err != nil {
+			log.Warnw("unable to load known peers", "err", err)
+		}
+		log.Infow("loaded known peers", "amount", len(d.known))
+	}
+
 	go d.discoveryLoop(ctx)
 	go d.disconnectsLoop(ctx, sub)
 	go d.connector.GC(ctx)
+	go d.persistLoop(ctx)
 	return nil
 }
 
-func (d *Discovery) Stop(context.Context) error {
+func (d *Discovery) Stop(ctx context.Context) error {
+	// Start did not run, e.g. another component failed to start, so there is nothing to stop
+	if d.cancel == nil {
+		return nil
+	}
 	d.cancel()
-	return nil
+
+	select {
+	case <-d.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if d.store == nil || d.params.PeersLimit == 0 {
+		return nil
+	}
+	return d.persist(ctx)
 }
 
 // WithOnPeersUpdate chains OnPeersUpdate callbacks on every update of discovered peers list.
@@ -136,6 +186,9 @@ func (d *Discovery) Discard(id peer.ID) bool {
 	d.host.ConnManager().Unprotect(id, rendezvousPoint)
 	d.connector.Backoff(id)
 	d.set.Remove(id)
+	if d.store != nil {
+		d.store.seen(peer.AddrInfo{ID: id}, time.Now())
+	}
 	d.onUpdatedPeers(id, false)
 	log.Debugw("removed peer from the peer set", "peer", id.String())
 
@@ -202,6 +255,8 @@ func (d *Discovery) Advertise(ctx context.Context) {
 // It initiates peer discovery upon request and restarts the process until the soft limit is
 // reached.
 func (d *Discovery) discoveryLoop(ctx context.Context) {
+	d.connectKnownPeers(ctx)
+
 	t := time.NewTicker(discoveryRetryTimeout)
 	defer t.Stop()
 
@@ -370,6 +425,12 @@ func (d *Discovery) handleDiscoveredPeer(ctx context.Context, peer peer.AddrInfo
 	d.onUpdatedPeers(peer.ID, true)
 	d.metrics.observeHandlePeer(ctx, handlePeerConnected)
 	logger.Debug("added peer to set")
+	if d.store != nil {
+		if len(peer.Addrs) == 0 {
+			peer.Addrs = d.host.Peerstore().Addrs(peer.ID)
+		}
+		d.store.seen(peer, time.Now())
+	}
 
 	// tag to protect peer from being killed by ConnManager
 	// NOTE: This is does not protect from remote killing the connection.
@@ -379,6 +440,65 @@ func (d *Discovery) handleDiscoveredPeer(ctx context.Context, peer peer.AddrInfo
 	return true
 }
 
+// connectKnownPeers dials the peers persisted before the restart, in order of their scores, until
+// the soft peer limit is reached.
+func (d *Discovery) connectKnownPeers(ctx context.Context) {
+	if len(d.known) == 0 {
+		return
+	}
+
+	var wg errgroup.Group
+	wg.SetLimit(int(d.set.Limit()))
+	for _, kp := range d.known {
+		if ctx.Err() != nil || d.set.Size() >= d.set.Limit() {
+			break
+		}
+
+		info := kp.Info
+		wg.Go(func() error {
+			connCtx, cancel := context.WithTimeout(ctx, knownPeerConnectTimeout)
+			defer cancel()
+			if d.handleDiscoveredPeer(connCtx, info) {
+				log.Debugw("reconnected to known peer", "peer", info.ID.String())
+			}
+			return nil
+		})
+	}
+	wg.Wait() //nolint:errcheck
+	d.known = nil
+	log.Infow("connected to known peers", "amount", d.set.Size())
+}
+
+// persistLoop periodically persists the known peers.
+func (d *Discovery) persistLoop(ctx context.Context) {
+	defer close(d.done)
+	if d.store == nil {
+		return
+	}
+
+	t := time.NewTicker(persistInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := d.persist(ctx); err != nil {
+				log.Warnw("unable to persist known peers", "err", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// persist marks the peers in the set as seen and saves the known peers.
+func (d *Discovery) persist(ctx context.Context) error {
+	now := time.Now()
+	for _, id := range d.set.List() {
+		d.store.seen(peer.AddrInfo{ID: id, Addrs: d.host.Peerstore().Addrs(id)}, now)
+	}
+	return d.store.save(ctx, d.scorer)
+}
+
 func drainChannel(c <-chan time.Time) {
 	for {
 		select {
@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	"github.com/libp2p/go-libp2p/core/discovery"
 	"github.com/libp2p/go-libp2p/core/host"
@@ -73,6 +75,67 @@ func TestDiscovery(t *testing.T) {
 	assert.EqualValues(t, 0, peerA.set.Size())
 }
 
+func TestDiscovery_StopWithoutStart(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	d := NewDiscovery(nil, nil)
+	d.WithPeerStore(ds_sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, d.Stop(ctx))
+}
+
+func TestDiscovery_KnownPeers(t *testing.T) {
+	const nodes = 3
+
+	discoveryRetryTimeout = time.Millisecond * 100 // defined in discovery.go
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+
+	tn := newTestnet(ctx, t)
+	for i := 0; i < nodes; i++ {
+		tn.discovery(WithPeersLimit(0), WithAdvertiseInterval(time.Millisecond*100))
+	}
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	hst, routingDisc := tn.peer()
+	peerA := NewDiscovery(hst, routingDisc, WithPeersLimit(nodes), WithAdvertiseInterval(-1))
+	peerA.WithPeerStore(ds)
+	require.NoError(t, peerA.Start(ctx))
+	require.Eventually(t, func() bool {
+		return peerA.set.Size() == nodes
+	}, time.Second*30, time.Millisecond*100)
+	require.NoError(t, peerA.Stop(ctx))
+
+	for _, id := range peerA.set.List() {
+		require.NoError(t, hst.Network().ClosePeer(id))
+	}
+
+	// restarted discovery, which is unable to find peers on its own, reconnects to the known peers
+	restarted := NewDiscovery(hst, noDiscovery{}, WithPeersLimit(nodes), WithAdvertiseInterval(-1))
+	restarted.WithPeerStore(ds)
+	require.NoError(t, restarted.Start(ctx))
+	t.Cleanup(func() {
+		require.NoError(t, restarted.Stop(ctx))
+	})
+	require.Eventually(t, func() bool {
+		return restarted.set.Size() == nodes
+	}, time.Second*30, time.Millisecond*100)
+}
+
+// noDiscovery is a discovery.Discovery that never finds any peers.
+type noDiscovery struct{}
+
+func (noDiscovery) Advertise(context.Context, string, ...discovery.Option) (time.Duration, error) {
+	return time.Hour, nil
+}
+
+func (noDiscovery) FindPeers(context.Context, string, ...discovery.Option) (<-chan peer.AddrInfo, error) {
+	ch := make(chan peer.AddrInfo)
+	close(ch)
+	return ch, nil
+}
+
 type testnet struct {
 	ctx context.Context
 	T   *testing.T
@@ -14,6 +14,10 @@ type Parameters struct {
 	// Set -1 to disable.
 	// NOTE: only full and bridge can advertise themselves.
 	AdvertiseInterval time.Duration
+	// KnownPeersTTL is the time a previously discovered peer is persisted for since it was last
+	// seen. Known peers are dialled first at start.
+	// Set 0 to disable.
+	KnownPeersTTL time.Duration
 }
 
 // Option is a function that configures Discovery Parameters
@@ -26,6 +30,7 @@ func DefaultParameters() Parameters {
 		PeersLimit: 5,
 		// based on https://github.com/libp2p/go-libp2p-kad-dht/pull/793
 		AdvertiseInterval: time.Hour * 22,
+		KnownPeersTTL:     time.Hour * 72,
 	}
 }
 
@@ -39,6 +44,11 @@ func (p *Parameters) Validate() error {
 		)
 	}
 
+	if p.KnownPeersTTL < 0 {
+		return fmt.Errorf("discovery: invalid option: value KnownPeersTTL %s, value must not be negative",
+			p.KnownPeersTTL)
+	}
+
 	return nil
 }
 
@@ -57,3 +67,11 @@ func WithAdvertiseInterval(advInterval time.Duration) Option {
 		p.AdvertiseInterval = advInterval
 	}
 }
+
+// WithKnownPeersTTL is a functional option that Discovery
+// uses to set the KnownPeersTTL configuration param
+func WithKnownPeersTTL(ttl time.Duration) Option {
+	return func(p *Parameters) {
+		p.KnownPeersTTL = ttl
+	}
+}
@@ -70,18 +70,22 @@ func (ps *limitedSet) Remove(id peer.ID) {
 	ps.lk.Unlock()
 }
 
+// List returns all discovered peers from the set without blocking.
+func (ps *limitedSet) List() []peer.ID {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+	out := make([]peer.ID, 0, len(ps.ps))
+	for p := range ps.ps {
+		out = append(out, p)
+	}
+	return out
+}
+
 // Peers returns all discovered peers from the set.
 func (ps *limitedSet) Peers(ctx context.Context) ([]peer.ID, error) {
-	ps.lk.RLock()
-	if len(ps.ps) > 0 {
-		out := make([]peer.ID, 0, len(ps.ps))
-		for p := range ps.ps {
-			out = append(out, p)
-		}
-		ps.lk.RUnlock()
+	if out := ps.List(); len(out) > 0 {
 		return out, nil
 	}
-	ps.lk.RUnlock()
 
 	// block until a new peer will be discovered
 	select {
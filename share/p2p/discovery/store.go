@@ -0,0 +1,144 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// maxKnownPeers limits the amount of persisted peers.
+	maxKnownPeers = 100
+	// persistInterval defines the time interval between persisting the known peers.
+	persistInterval = 5 * time.Minute
+	// knownPeerConnectTimeout limits the time of connecting to a single known peer at start.
+	knownPeerConnectTimeout = 10 * time.Second
+)
+
+var (
+	storePrefix   = datastore.NewKey("discovery")
+	knownPeersKey = datastore.NewKey("known_peers")
+)
+
+// knownPeer is a previously discovered peer persisted across restarts.
+type knownPeer struct {
+	Info peer.AddrInfo
+	// Score is the score of the peer given by the scorer, if any.
+	Score float64
+	// LastSeen is the last time the peer was in the discovered peer set.
+	LastSeen time.Time
+}
+
+// peerStore keeps the known good peers and persists them in the datastore.
+type peerStore struct {
+	ds  datastore.Datastore
+	ttl time.Duration
+
+	lk    sync.Mutex
+	peers map[peer.ID]*knownPeer
+}
+
+func newPeerStore(ds datastore.Datastore, ttl time.Duration) *peerStore {
+	return &peerStore{
+		ds:    namespace.Wrap(ds, storePrefix),
+		ttl:   ttl,
+		peers: make(map[peer.ID]*knownPeer),
+	}
+}
+
+// load reads the persisted peers out of the datastore, ages out the stale ones and returns the
+// rest, best scored and most recently seen first.
+func (s *peerStore) load(ctx context.Context) ([]knownPeer, error) {
+	bin, err := s.ds.Get(ctx, knownPeersKey)
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading known peers from datastore: %w", err)
+	}
+
+	var peers []knownPeer
+	if err = json.Unmarshal(bin, &peers); err != nil {
+		return nil, fmt.Errorf("unmarshalling known peers: %w", err)
+	}
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	for i := range peers {
+		s.peers[peers[i].Info.ID] = &peers[i]
+	}
+	return s.list(time.Now()), nil
+}
+
+// seen records the peer as seen in the discovered peer set at the given time.
+func (s *peerStore) seen(info peer.AddrInfo, now time.Time) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	kp, ok := s.peers[info.ID]
+	if !ok {
+		kp = &knownPeer{Info: peer.AddrInfo{ID: info.ID}}
+		s.peers[info.ID] = kp
+	}
+	if len(info.Addrs) > 0 {
+		kp.Info.Addrs = info.Addrs
+	}
+	kp.LastSeen = now
+}
+
+// save persists the known peers, updating their scores with the given scorer, if any.
+func (s *peerStore) save(ctx context.Context, scorer func(peer.ID) float64) error {
+	s.lk.Lock()
+	if scorer != nil {
+		for id, kp := range s.peers {
+			kp.Score = scorer(id)
+		}
+	}
+	peers := s.list(time.Now())
+	s.lk.Unlock()
+
+	bin, err := json.Marshal(peers)
+	if err != nil {
+		return fmt.Errorf("marshalling known peers: %w", err)
+	}
+	if err = s.ds.Put(ctx, knownPeersKey, bin); err != nil {
+		return fmt.Errorf("writing known peers to datastore: %w", err)
+	}
+	log.Debugw("persisted known peers", "amount", len(peers))
+	return nil
+}
+
+// list forgets the stale and excessive peers and returns the rest ordered by score and last seen
+// time. It must be called with the lock held.
+func (s *peerStore) list(now time.Time) []knownPeer {
+	peers := make([]knownPeer, 0, len(s.peers))
+	for id, kp := range s.peers {
+		if now.Sub(kp.LastSeen) > s.ttl {
+			delete(s.peers, id)
+			continue
+		}
+		peers = append(peers, *kp)
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].Score != peers[j].Score {
+			return peers[i].Score > peers[j].Score
+		}
+		return peers[i].LastSeen.After(peers[j].LastSeen)
+	})
+	if len(peers) > maxKnownPeers {
+		for _, kp := range peers[maxKnownPeers:] {
+			delete(s.peers, kp.Info.ID)
+		}
+		peers = peers[:maxKnownPeers]
+	}
+	return peers
+}
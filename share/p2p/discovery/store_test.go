@@ -0,0 +1,74 @@
+package discovery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerStore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	store := newPeerStore(ds, time.Hour)
+
+	now := time.Now()
+	stale, recent, old, best := test.RandPeerIDFatal(t), test.RandPeerIDFatal(t),
+		test.RandPeerIDFatal(t), test.RandPeerIDFatal(t)
+	store.seen(peer.AddrInfo{ID: stale}, now.Add(-time.Hour*2))
+	store.seen(peer.AddrInfo{ID: old}, now.Add(-time.Minute*30))
+	store.seen(peer.AddrInfo{ID: recent}, now)
+	store.seen(peer.AddrInfo{ID: best}, now.Add(-time.Minute*10))
+
+	err := store.save(ctx, func(id peer.ID) float64 {
+		if id == best {
+			return 1
+		}
+		return 0.5
+	})
+	require.NoError(t, err)
+
+	// known peers survive the restart, ordered by score and last seen time, without stale ones
+	known, err := newPeerStore(ds, time.Hour).load(ctx)
+	require.NoError(t, err)
+	require.Len(t, known, 3)
+	assert.Equal(t, best, known[0].Info.ID)
+	assert.Equal(t, recent, known[1].Info.ID)
+	assert.Equal(t, old, known[2].Info.ID)
+	assert.EqualValues(t, 1, known[0].Score)
+}
+
+func TestPeerStore_Limit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	store := newPeerStore(ds, time.Hour)
+
+	now := time.Now()
+	for i := 0; i < maxKnownPeers+10; i++ {
+		store.seen(peer.AddrInfo{ID: test.RandPeerIDFatal(t)}, now.Add(-time.Duration(i)*time.Second))
+	}
+	require.NoError(t, store.save(ctx, nil))
+
+	known, err := newPeerStore(ds, time.Hour).load(ctx)
+	require.NoError(t, err)
+	assert.Len(t, known, maxKnownPeers)
+}
+
+func TestPeerStore_Empty(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	known, err := newPeerStore(datastore.NewMapDatastore(), time.Hour).load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, known)
+}
@@ -0,0 +1,138 @@
+package p2p
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// limiterGCInterval is the interval at which idle peer buckets are forgotten.
+const limiterGCInterval = time.Minute
+
+// tokenBucket is a token bucket that can be charged into debt, so that the cost of a request can
+// be accounted once it is known.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+// take takes n tokens if they are available.
+func (b *tokenBucket) take(n float64, now time.Time) bool {
+	b.refill(now)
+	if b.tokens < n {
+		return false
+	}
+	b.tokens -= n
+	return true
+}
+
+// charge takes n tokens, going into debt if they are not available.
+func (b *tokenBucket) charge(n float64, now time.Time) {
+	b.refill(now)
+	b.tokens -= n
+}
+
+func (b *tokenBucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+type peerBuckets struct {
+	requests *tokenBucket
+	bytes    *tokenBucket
+}
+
+// peerLimiter limits the rate of requests and the amount of bytes served per peer.
+type peerLimiter struct {
+	requestsPerSecond float64
+	requestsBurst     float64
+	bytesPerSecond    float64
+
+	lk      sync.Mutex
+	buckets map[peer.ID]*peerBuckets
+	lastGC  time.Time
+}
+
+func newPeerLimiter(requestsPerSecond float64, requestsBurst, bytesPerSecond int) *peerLimiter {
+	return &peerLimiter{
+		requestsPerSecond: requestsPerSecond,
+		requestsBurst:     float64(requestsBurst),
+		bytesPerSecond:    float64(bytesPerSecond),
+		buckets:           make(map[peer.ID]*peerBuckets),
+		lastGC:            time.Now(),
+	}
+}
+
+// allow reports whether a new request of the peer is allowed and takes a request token for it.
+// Requests are not allowed while the peer is in debt for the bytes served to it.
+func (l *peerLimiter) allow(id peer.ID) bool {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	now := time.Now()
+	l.gc(now)
+	b := l.peerBuckets(id, now)
+	if b.bytes != nil && !b.bytes.take(0, now) {
+		return false
+	}
+	return b.requests == nil || b.requests.take(1, now)
+}
+
+// charge accounts the bytes served to the peer.
+func (l *peerLimiter) charge(id peer.ID, bytes int) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	now := time.Now()
+	if b := l.peerBuckets(id, now); b.bytes != nil {
+		b.bytes.charge(float64(bytes), now)
+	}
+}
+
+func (l *peerLimiter) bytesLimited() bool {
+	return l.bytesPerSecond > 0
+}
+
+func (l *peerLimiter) peerBuckets(id peer.ID, now time.Time) *peerBuckets {
+	b, ok := l.buckets[id]
+	if ok {
+		return b
+	}
+
+	b = &peerBuckets{}
+	if l.requestsPerSecond > 0 {
+		b.requests = newTokenBucket(l.requestsPerSecond, math.Max(1, l.requestsBurst), now)
+	}
+	if l.bytesPerSecond > 0 {
+		// allow a second worth of bytes to be served at once
+		b.bytes = newTokenBucket(l.bytesPerSecond, l.bytesPerSecond, now)
+	}
+	l.buckets[id] = b
+	return b
+}
+
+// gc forgets the buckets of the peers that have been idle long enough to refill them.
+func (l *peerLimiter) gc(now time.Time) {
+	if now.Sub(l.lastGC) < limiterGCInterval {
+		return
+	}
+	l.lastGC = now
+	for id, b := range l.buckets {
+		if (b.requests == nil || b.requests.full(now)) && (b.bytes == nil || b.bytes.full(now)) {
+			delete(l.buckets, id)
+		}
+	}
+}
@@ -27,6 +27,7 @@ const (
 
 type Metrics struct {
 	totalRequestCounter metric.Int64Counter
+	compressionRatio    metric.Float64Histogram
 }
 
 // ObserveRequests increments the total number of requests sent with the given status as an
@@ -44,6 +45,24 @@ func (m *Metrics) ObserveRequests(ctx context.Context, count int64, status statu
 		))
 }
 
+// ObserveCompression records the ratio of the raw data size to the compressed one, sent or
+// received over a single stream.
+func (m *Metrics) ObserveCompression(
+	ctx context.Context,
+	compression Compression,
+	direction string,
+	raw, compressed int64,
+) {
+	if m == nil || raw == 0 || compressed == 0 {
+		return
+	}
+	m.compressionRatio.Record(ctx, float64(raw)/float64(compressed),
+		metric.WithAttributes(
+			attribute.String("compression", string(compression)),
+			attribute.String("direction", direction),
+		))
+}
+
 func InitClientMetrics(protocol string) (*Metrics, error) {
 	totalRequestCounter, err := meter.Int64Counter(
 		fmt.Sprintf("shrex_%s_client_total_requests", protocol),
@@ -53,8 +72,17 @@ func InitClientMetrics(protocol string) (*Metrics, error) {
 		return nil, err
 	}
 
+	compressionRatio, err := meter.Float64Histogram(
+		fmt.Sprintf("shrex_%s_client_compression_ratio", protocol),
+		metric.WithDescription(fmt.Sprintf("Compression ratio of shrex/%s client streams", protocol)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Metrics{
 		totalRequestCounter: totalRequestCounter,
+		compressionRatio:    compressionRatio,
 	}, nil
 }
 
@@ -67,7 +95,16 @@ func InitServerMetrics(protocol string) (*Metrics, error) {
 		return nil, err
 	}
 
+	compressionRatio, err := meter.Float64Histogram(
+		fmt.Sprintf("shrex_%s_server_compression_ratio", protocol),
+		metric.WithDescription(fmt.Sprintf("Compression ratio of shrex/%s server streams", protocol)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Metrics{
 		totalRequestCounter: totalRequestCounter,
+		compressionRatio:    compressionRatio,
 	}, nil
 }
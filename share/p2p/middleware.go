@@ -1,10 +1,14 @@
 package p2p
 
 import (
+	"sync"
 	"sync/atomic"
+	"time"
 
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 var log = logging.Logger("shrex/middleware")
@@ -12,16 +16,87 @@ var log = logging.Logger("shrex/middleware")
 type Middleware struct {
 	// concurrencyLimit is the maximum number of requests that can be processed at once.
 	concurrencyLimit int64
-	// parallelRequests is the number of requests currently being processed.
-	parallelRequests atomic.Int64
 	// numRateLimited is the number of requests that were rate limited.
 	numRateLimited atomic.Int64
+
+	limiter *peerLimiter
+	// exempt reports whether the peer is exempt from per-peer rate limits.
+	exempt func(peer.ID) bool
+	// throttled responds to rate limited requests. If not set, their streams are closed.
+	throttled network.StreamHandler
+
+	// queueTimeout is the maximum time a request waits for a free slot.
+	queueTimeout time.Duration
+	// peerQueueSize is the maximum number of waiting requests per peer.
+	peerQueueSize int
+
+	lk sync.Mutex
+	// parallelRequests is the number of requests currently being processed.
+	parallelRequests int64
+	// queues holds the requests waiting for a free slot per peer.
+	queues map[peer.ID][]*waiter
+	// order is the round-robin order of the peers with waiting requests.
+	order []peer.ID
+}
+
+// MiddlewareOption configures Middleware.
+type MiddlewareOption func(*Middleware)
+
+// WithPeerLimits sets per-peer limits on the rate of requests and on the amount of bytes
+// written per second. Zero values disable the corresponding limit.
+func WithPeerLimits(requestsPerSecond float64, requestsBurst, bytesPerSecond int) MiddlewareOption {
+	return func(m *Middleware) {
+		if requestsPerSecond == 0 && bytesPerSecond == 0 {
+			m.limiter = nil
+			return
+		}
+		m.limiter = newPeerLimiter(requestsPerSecond, requestsBurst, bytesPerSecond)
+	}
+}
+
+// WithFairQueue makes requests that exceed the concurrency limit wait for a free slot up to the
+// given timeout. Slots are handed over to the waiting peers in round-robin order, and each peer can
+// have up to peerQueueSize waiting requests.
+func WithFairQueue(timeout time.Duration, peerQueueSize int) MiddlewareOption {
+	return func(m *Middleware) {
+		m.queueTimeout = timeout
+		m.peerQueueSize = peerQueueSize
+	}
 }
 
-func NewMiddleware(concurrencyLimit int) *Middleware {
-	return &Middleware{
+// WithExemptPeers exempts the peers for which the given function returns true from the per-peer
+// limits.
+func WithExemptPeers(exempt func(peer.ID) bool) MiddlewareOption {
+	return func(m *Middleware) {
+		m.exempt = exempt
+	}
+}
+
+// IsProtected returns a function reporting whether the peer is protected in the connection
+// manager of the host, e.g. as a trusted or bootstrap peer, with any tag.
+func IsProtected(h host.Host) func(peer.ID) bool {
+	return func(id peer.ID) bool {
+		return h.ConnManager().IsProtected(id, "")
+	}
+}
+
+// WithThrottledHandler sets the handler responding to rate limited requests, so that peers
+// are explicitly informed instead of having their streams silently closed.
+func WithThrottledHandler(handler network.StreamHandler) MiddlewareOption {
+	return func(m *Middleware) {
+		m.throttled = handler
+	}
+}
+
+func NewMiddleware(concurrencyLimit int, opts ...MiddlewareOption) *Middleware {
+	m := &Middleware{
 		concurrencyLimit: int64(concurrencyLimit),
+		queues:           make(map[peer.ID][]*waiter),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
 // DrainCounter returns the current value of the rate limit counter and resets it to 0.
@@ -31,18 +106,141 @@ func (m *Middleware) DrainCounter() int64 {
 
 func (m *Middleware) RateLimitHandler(handler network.StreamHandler) network.StreamHandler {
 	return func(stream network.Stream) {
-		current := m.parallelRequests.Add(1)
-		defer m.parallelRequests.Add(-1)
+		id := stream.Conn().RemotePeer()
+		limited := m.limiter != nil && (m.exempt == nil || !m.exempt(id))
+		if limited && !m.limiter.allow(id) {
+			log.Debugw("peer rate limit reached", "peer", id.String())
+			m.reject(stream)
+			return
+		}
 
-		if current > m.concurrencyLimit {
-			m.numRateLimited.Add(1)
+		if !m.acquire(id) {
 			log.Debug("concurrency limit reached")
-			err := stream.Close()
-			if err != nil {
-				log.Debugw("server: closing stream", "err", err)
-			}
+			m.reject(stream)
 			return
 		}
+		defer m.release()
+
+		if limited && m.limiter.bytesLimited() {
+			stream = &countingStream{Stream: stream, onWrite: func(n int) {
+				m.limiter.charge(id, n)
+			}}
+		}
 		handler(stream)
 	}
 }
+
+func (m *Middleware) reject(stream network.Stream) {
+	m.numRateLimited.Add(1)
+	if m.throttled != nil {
+		m.throttled(stream)
+		return
+	}
+	err := stream.Close()
+	if err != nil {
+		log.Debugw("server: closing stream", "err", err)
+	}
+}
+
+type waiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// acquire takes a free processing slot, waiting in the peer's queue for up to the queue timeout if
+// none is available.
+func (m *Middleware) acquire(id peer.ID) bool {
+	m.lk.Lock()
+	if m.parallelRequests < m.concurrencyLimit && len(m.order) == 0 {
+		m.parallelRequests++
+		m.lk.Unlock()
+		return true
+	}
+	if m.queueTimeout <= 0 || len(m.queues[id]) >= m.peerQueueSize {
+		m.lk.Unlock()
+		return false
+	}
+
+	w := &waiter{ready: make(chan struct{})}
+	if len(m.queues[id]) == 0 {
+		m.order = append(m.order, id)
+	}
+	m.queues[id] = append(m.queues[id], w)
+	m.lk.Unlock()
+
+	timer := time.NewTimer(m.queueTimeout)
+	defer timer.Stop()
+	select {
+	case <-w.ready:
+		return true
+	case <-timer.C:
+	}
+
+	m.lk.Lock()
+	defer m.lk.Unlock()
+	if w.granted {
+		// the slot was handed over in the meantime
+		return true
+	}
+	m.dequeue(id, w)
+	return false
+}
+
+// release frees the processing slot or hands it over to the next waiting peer.
+func (m *Middleware) release() {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	if len(m.order) == 0 {
+		m.parallelRequests--
+		return
+	}
+
+	id := m.order[0]
+	m.order = m.order[1:]
+	w, queue := m.queues[id][0], m.queues[id][1:]
+	if len(queue) > 0 {
+		// requeue the peer at the end, so that other peers are served first
+		m.queues[id] = queue
+		m.order = append(m.order, id)
+	} else {
+		delete(m.queues, id)
+	}
+	w.granted = true
+	close(w.ready)
+}
+
+// dequeue removes the waiter from the peer's queue and the peer from the round-robin order.
+func (m *Middleware) dequeue(id peer.ID, w *waiter) {
+	queue := m.queues[id]
+	for i := range queue {
+		if queue[i] == w {
+			queue = append(queue[:i], queue[i+1:]...)
+			break
+		}
+	}
+	if len(queue) > 0 {
+		m.queues[id] = queue
+		return
+	}
+
+	delete(m.queues, id)
+	for i := range m.order {
+		if m.order[i] == id {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// countingStream reports the amount of bytes written to the stream.
+type countingStream struct {
+	network.Stream
+	onWrite func(int)
+}
+
+func (s *countingStream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	s.onWrite(n)
+	return n, err
+}
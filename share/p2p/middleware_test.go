@@ -0,0 +1,77 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware_FairQueue(t *testing.T) {
+	m := NewMiddleware(1, WithFairQueue(time.Second, 2))
+	peerA, peerB := peer.ID("A"), peer.ID("B")
+
+	// take the only slot
+	require.True(t, m.acquire(peerA))
+
+	served := make(chan string, 3)
+	wait := func(id peer.ID, name string, position int) {
+		go func() {
+			if m.acquire(id) {
+				served <- name
+			}
+		}()
+		// ensure the request is enqueued before the next one
+		require.Eventually(t, func() bool {
+			return queued(m, id) == position
+		}, time.Second, time.Millisecond)
+	}
+	wait(peerA, "A1", 1)
+	wait(peerA, "A2", 2)
+	wait(peerB, "B1", 1)
+
+	// the queue of the peer is full
+	require.False(t, m.acquire(peerA))
+
+	// slots are handed over to peers in round-robin order
+	for _, expected := range []string{"A1", "B1", "A2"} {
+		m.release()
+		require.Equal(t, expected, <-served)
+	}
+	m.release()
+	require.Zero(t, m.parallelRequests)
+}
+
+func TestMiddleware_QueueTimeout(t *testing.T) {
+	m := NewMiddleware(1, WithFairQueue(10*time.Millisecond, 1))
+
+	require.True(t, m.acquire("A"))
+	require.False(t, m.acquire("B"))
+	require.Empty(t, m.order)
+	require.Empty(t, m.queues)
+
+	m.release()
+	require.True(t, m.acquire("B"))
+}
+
+func TestPeerLimiter(t *testing.T) {
+	l := newPeerLimiter(1, 2, 100)
+
+	// burst of requests
+	require.True(t, l.allow("A"))
+	require.True(t, l.allow("A"))
+	require.False(t, l.allow("A"))
+	// limits are per peer
+	require.True(t, l.allow("B"))
+
+	// peers in debt for bytes are limited until it is repaid
+	l.charge("B", 200)
+	require.False(t, l.allow("B"))
+}
+
+func queued(m *Middleware, id peer.ID) int {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+	return len(m.queues[id])
+}
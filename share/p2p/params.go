@@ -21,6 +21,25 @@ type Parameters struct {
 	// ConcurrencyLimit is the maximum number of concurrently handled streams
 	ConcurrencyLimit int
 
+	// PeerRequestsPerSecond limits the rate of requests handled per peer. Zero disables the limit.
+	PeerRequestsPerSecond float64
+	// PeerRequestsBurst is the number of requests a peer can make at once above its rate.
+	PeerRequestsBurst int
+	// PeerBytesPerSecond limits the amount of bytes served per peer. Zero disables the limit.
+	PeerBytesPerSecond int
+
+	// QueueTimeout is the maximum time a request waits for a free slot once the concurrency limit
+	// is reached. Zero rejects such requests right away. It must be less than ServerReadTimeout, so
+	// that waiting requests are served before the client times out on reading the status.
+	QueueTimeout time.Duration
+	// PeerQueueSize is the maximum number of requests of a single peer waiting for a free slot.
+	PeerQueueSize int
+
+	// Compression lists the compressions enabled for the protocol streams in preference order.
+	// Compression is negotiated per stream, falling back to uncompressed streams. Empty list
+	// disables compression.
+	Compression []Compression
+
 	// networkID is prepended to the protocolID and represents the network the protocol is
 	// running on.
 	networkID string
@@ -32,6 +51,8 @@ func DefaultParameters() *Parameters {
 		ServerWriteTimeout:   time.Minute, // based on max observed sample time for 256 blocks (~50s)
 		HandleRequestTimeout: time.Minute,
 		ConcurrencyLimit:     10,
+		// per-peer limits and queueing are disabled by default, so that the serving behaviour of
+		// the existing nodes does not change unless opted in
 	}
 }
 
@@ -50,6 +71,26 @@ func (p *Parameters) Validate() error {
 	if p.ConcurrencyLimit <= 0 {
 		return fmt.Errorf("invalid concurrency limit: %s", errSuffix)
 	}
+	if p.PeerRequestsPerSecond < 0 || p.PeerRequestsBurst < 0 || p.PeerBytesPerSecond < 0 {
+		return fmt.Errorf("invalid peer rate limits: values should not be negative")
+	}
+	if p.QueueTimeout < 0 || p.PeerQueueSize < 0 {
+		return fmt.Errorf("invalid queue parameters: values should not be negative")
+	}
+	for i, c := range p.Compression {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		for _, prev := range p.Compression[:i] {
+			if prev == c {
+				return fmt.Errorf("duplicate compression: %q", c)
+			}
+		}
+	}
+	if p.QueueTimeout >= p.ServerReadTimeout {
+		return fmt.Errorf("invalid queue timeout: %v, value should be less than the read timeout %v",
+			p.QueueTimeout, p.ServerReadTimeout)
+	}
 	return nil
 }
 
@@ -67,3 +108,11 @@ func (p *Parameters) NetworkID() string {
 func ProtocolID(networkID, protocolString string) protocol.ID {
 	return protocol.ID(fmt.Sprintf("/%s%s", networkID, protocolString))
 }
+
+// MiddlewareOptions returns the options configuring Middleware according to the parameters.
+func (p *Parameters) MiddlewareOptions() []MiddlewareOption {
+	return []MiddlewareOption{
+		WithPeerLimits(p.PeerRequestsPerSecond, p.PeerRequestsBurst, p.PeerBytesPerSecond),
+		WithFairQueue(p.QueueTimeout, p.PeerQueueSize),
+	}
+}
@@ -0,0 +1,183 @@
+package peers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+var blacklistKey = datastore.NewKey("peer_blacklist")
+
+// ErrNotBlacklisted is returned when removing a peer that is not blacklisted by the Manager.
+var ErrNotBlacklisted = errors.New("peer is not blacklisted")
+
+// BlacklistEntry describes a peer blacklisted by the Manager.
+type BlacklistEntry struct {
+	Peer peer.ID
+	// Reason is the reason the peer was blacklisted for.
+	Reason string
+	// BlacklistedAt is the time the peer was blacklisted at.
+	BlacklistedAt time.Time
+	// ExpiresAt is the time the peer gets removed from the blacklist at. Zero value means the peer is
+	// blacklisted until removed manually.
+	ExpiresAt time.Time
+	// BlockedByOperator reports whether the peer is blocked by the operator as well, in which case it
+	// stays blocked when removed from the blacklist.
+	BlockedByOperator bool
+}
+
+// expired reports whether the entry has expired by the given time.
+func (e *BlacklistEntry) expired(now time.Time) bool {
+	return !e.ExpiresAt.IsZero() && !now.Before(e.ExpiresAt)
+}
+
+// blacklist keeps the entries of blacklisted peers and persists them in the datastore.
+type blacklist struct {
+	ttl time.Duration
+	ds  datastore.Datastore
+
+	lk      sync.Mutex
+	entries map[peer.ID]*BlacklistEntry
+}
+
+func newBlacklist(ds datastore.Datastore, ttl time.Duration) *blacklist {
+	return &blacklist{
+		ttl:     ttl,
+		ds:      namespace.Wrap(ds, blacklistKey),
+		entries: make(map[peer.ID]*BlacklistEntry),
+	}
+}
+
+// add records the peer as blacklisted for the given reason at the given time. blockedByOperator
+// reports whether the peer was already blocked by the operator. Re-blacklisting a peer keeps the
+// origin of its block.
+func (b *blacklist) add(
+	ctx context.Context,
+	id peer.ID,
+	reason blacklistPeerReason,
+	now time.Time,
+	blockedByOperator bool,
+) error {
+	entry := &BlacklistEntry{
+		Peer:              id,
+		Reason:            string(reason),
+		BlacklistedAt:     now,
+		BlockedByOperator: blockedByOperator,
+	}
+	if b.ttl > 0 {
+		entry.ExpiresAt = now.Add(b.ttl)
+	}
+
+	b.lk.Lock()
+	if prev, ok := b.entries[id]; ok {
+		entry.BlockedByOperator = prev.BlockedByOperator
+	}
+	b.entries[id] = entry
+	b.lk.Unlock()
+	return b.put(ctx, entry)
+}
+
+// markBlockedByOperator records that the operator blocked the blacklisted peer as well. It is a
+// no-op for peers that are not blacklisted.
+func (b *blacklist) markBlockedByOperator(ctx context.Context, id peer.ID) error {
+	b.lk.Lock()
+	entry, ok := b.entries[id]
+	if !ok || entry.BlockedByOperator {
+		b.lk.Unlock()
+		return nil
+	}
+	updated := *entry
+	updated.BlockedByOperator = true
+	b.entries[id] = &updated
+	b.lk.Unlock()
+	return b.put(ctx, &updated)
+}
+
+func (b *blacklist) put(ctx context.Context, entry *BlacklistEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err = b.ds.Put(ctx, datastore.NewKey(entry.Peer.String()), data); err != nil {
+		return fmt.Errorf("writing blacklist entry: %w", err)
+	}
+	return nil
+}
+
+// remove forgets the blacklisted peer, returning its entry.
+func (b *blacklist) remove(ctx context.Context, id peer.ID) (BlacklistEntry, error) {
+	b.lk.Lock()
+	entry, ok := b.entries[id]
+	delete(b.entries, id)
+	b.lk.Unlock()
+	if !ok {
+		return BlacklistEntry{}, ErrNotBlacklisted
+	}
+
+	if err := b.ds.Delete(ctx, datastore.NewKey(id.String())); err != nil {
+		return BlacklistEntry{}, fmt.Errorf("deleting blacklist entry: %w", err)
+	}
+	return *entry, nil
+}
+
+// list returns all the blacklist entries ordered by blacklisting time.
+func (b *blacklist) list() []BlacklistEntry {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	list := make([]BlacklistEntry, 0, len(b.entries))
+	for _, entry := range b.entries {
+		list = append(list, *entry)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].BlacklistedAt.Before(list[j].BlacklistedAt)
+	})
+	return list
+}
+
+// expired returns the peers with the entries expired by the given time.
+func (b *blacklist) expired(now time.Time) []peer.ID {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	var expired []peer.ID
+	for id, entry := range b.entries {
+		if entry.expired(now) {
+			expired = append(expired, id)
+		}
+	}
+	return expired
+}
+
+// load reads the persisted blacklist entries out of the datastore.
+func (b *blacklist) load(ctx context.Context) error {
+	results, err := b.ds.Query(ctx, query.Query{})
+	if err != nil {
+		return fmt.Errorf("querying blacklist: %w", err)
+	}
+	defer results.Close()
+
+	b.lk.Lock()
+	defer b.lk.Unlock()
+	for res := range results.Next() {
+		if res.Error != nil {
+			return fmt.Errorf("iterating blacklist: %w", res.Error)
+		}
+		entry := &BlacklistEntry{}
+		if err = json.Unmarshal(res.Value, entry); err != nil {
+			log.Warnw("skipping invalid blacklist entry", "key", res.Key, "err", err)
+			continue
+		}
+		b.entries[entry.Peer] = entry
+	}
+	return nil
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
+	"github.com/ipfs/go-datastore"
 	logging "github.com/ipfs/go-log/v2"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/event"
@@ -71,6 +74,11 @@ type Manager struct {
 	// hashes that are not in the chain
 	blacklistedHashes map[string]bool
 
+	// scores is only tracked if peer scoring is enabled
+	scores *scores
+	// blacklist records the peers blacklisted for misbehaving
+	blacklist *blacklist
+
 	metrics *metrics
 
 	headerSubDone         chan struct{}
@@ -79,8 +87,23 @@ type Manager struct {
 }
 
 // DoneFunc updates internal state depending on call results. Should be called once per returned
-// peer from Peer method
-type DoneFunc func(result)
+// peer from Peer method. DoneOptions provide additional details for scoring of the peer.
+type DoneFunc func(result, ...DoneOption)
+
+// DoneOption provides additional details on the request made to the peer returned by Peer method.
+type DoneOption func(*requestStats)
+
+type requestStats struct {
+	received int
+}
+
+// WithReceived reports the amount of bytes received from the peer, so that its throughput is
+// scored.
+func WithReceived(bytes int) DoneOption {
+	return func(stats *requestStats) {
+		stats.received = bytes
+	}
+}
 
 type syncPool struct {
 	*pool
@@ -95,6 +118,12 @@ type syncPool struct {
 	isSynced atomic.Bool
 	// createdAt is the syncPool creation time
 	createdAt time.Time
+
+	claimsLk sync.Mutex
+	// expected is the notification built from the DAH of the header corresponding to syncpool
+	expected *shrexsub.Notification
+	// claims are the notifications received before the header, to be checked once it arrives
+	claims map[peer.ID]shrexsub.Notification
 }
 
 func NewManager(
@@ -104,6 +133,7 @@ func NewManager(
 	discovery *discovery.Discovery,
 	host host.Host,
 	connGater *conngater.BasicConnectionGater,
+	ds datastore.Batching,
 ) (*Manager, error) {
 	if err := params.Validate(); err != nil {
 		return nil, err
@@ -123,6 +153,12 @@ func NewManager(
 	}
 
 	s.fullNodes = newPool(s.params.PeerCooldown)
+	if s.params.EnablePeerScoring {
+		s.scores = newScores(ds, s.params.ScoreHalfLife)
+		// known peers with better scores are dialled first after a restart
+		discovery.WithPeerScorer(s.peerScore)
+	}
+	s.blacklist = newBlacklist(ds, s.params.BlacklistTTL)
 
 	discovery.WithOnPeersUpdate(
 		func(peerID peer.ID, isAdded bool) {
@@ -147,6 +183,23 @@ func (m *Manager) Start(startCtx context.Context) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	m.cancel = cancel
 
+	if m.scores != nil {
+		if err := m.scores.load(startCtx); err != nil {
+			return fmt.Errorf("loading peer scores: %w", err)
+		}
+	}
+
+	if err := m.blacklist.load(startCtx); err != nil {
+		return fmt.Errorf("loading blacklist: %w", err)
+	}
+	m.expireBlacklist(startCtx)
+	for _, entry := range m.blacklist.list() {
+		// ensure the peers are blocked, even if the connection gater does not persist them
+		if err := m.connGater.BlockPeer(entry.Peer); err != nil {
+			log.Warnw("failed to block peer", "peer", entry.Peer, "err", err)
+		}
+	}
+
 	validatorFn := m.metrics.validationObserver(m.Validate)
 	err := m.shrexSub.AddValidator(validatorFn)
 	if err != nil {
@@ -190,33 +243,41 @@ func (m *Manager) Stop(ctx context.Context) error {
 		return ctx.Err()
 	}
 
+	if m.scores != nil {
+		if err := m.scores.save(ctx); err != nil {
+			return fmt.Errorf("saving peer scores: %w", err)
+		}
+	}
 	return nil
 }
 
 // Peer returns peer collected from shrex.Sub for given datahash if any available.
 // If there is none, it will look for full nodes collected from discovery. If there is no discovered
 // full nodes, it will wait until any peer appear in either source or timeout happen.
+// If peer scoring is enabled, peers with the best scores for the given protocol are returned first.
+// Excluded peers, e.g. the ones already serving other parts of the data, are only returned if no
+// other peer is available right away.
 // After fetching data using given peer, caller is required to call returned DoneFunc using
 // appropriate result value
 func (m *Manager) Peer(
-	ctx context.Context, datahash share.DataHash,
+	ctx context.Context, datahash share.DataHash, proto Protocol, exclude ...peer.ID,
 ) (peer.ID, DoneFunc, error) {
 	p := m.validatedPool(datahash.String())
 
 	// first, check if a peer is available for the given datahash
-	peerID, ok := p.tryGet()
+	peerID, ok := m.tryGet(p.pool, proto, exclude)
 	if ok {
 		if m.removeIfUnreachable(p, peerID) {
-			return m.Peer(ctx, datahash)
+			return m.Peer(ctx, datahash, proto, exclude...)
 		}
-		return m.newPeer(ctx, datahash, peerID, sourceShrexSub, p.len(), 0)
+		return m.newPeer(ctx, datahash, proto, peerID, sourceShrexSub, p.len(), 0)
 	}
 
 	// if no peer for datahash is currently available, try to use full node
 	// obtained from discovery
-	peerID, ok = m.fullNodes.tryGet()
+	peerID, ok = m.tryGet(m.fullNodes, proto, exclude)
 	if ok {
-		return m.newPeer(ctx, datahash, peerID, sourceFullNodes, m.fullNodes.len(), 0)
+		return m.newPeer(ctx, datahash, proto, peerID, sourceFullNodes, m.fullNodes.len(), 0)
 	}
 
 	// no peers are available right now, wait for the first one
@@ -224,19 +285,55 @@ func (m *Manager) Peer(
 	select {
 	case peerID = <-p.next(ctx):
 		if m.removeIfUnreachable(p, peerID) {
-			return m.Peer(ctx, datahash)
+			return m.Peer(ctx, datahash, proto, exclude...)
 		}
-		return m.newPeer(ctx, datahash, peerID, sourceShrexSub, p.len(), time.Since(start))
+		return m.newPeer(ctx, datahash, proto, peerID, sourceShrexSub, p.len(), time.Since(start))
 	case peerID = <-m.fullNodes.next(ctx):
-		return m.newPeer(ctx, datahash, peerID, sourceFullNodes, m.fullNodes.len(), time.Since(start))
+		return m.newPeer(ctx, datahash, proto, peerID, sourceFullNodes, m.fullNodes.len(), time.Since(start))
 	case <-ctx.Done():
 		return "", nil, ctx.Err()
 	}
 }
 
+// tryGet returns the best scored peer from the given pool, or the next one in round-robin order
+// if peer scoring is disabled or the peers are being explored. Excluded peers are only returned if
+// no other peer is available.
+func (m *Manager) tryGet(p *pool, proto Protocol, exclude []peer.ID) (peer.ID, bool) {
+	explore := m.scores == nil || rand.Float64() < m.params.ExplorationRate //nolint:gosec
+	if explore && len(exclude) == 0 {
+		return p.tryGet()
+	}
+	return p.tryGetBest(func(peerID peer.ID) float64 {
+		switch {
+		case slices.Contains(exclude, peerID):
+			return -1
+		case explore:
+			// equal scores keep the round-robin order
+			return 0
+		default:
+			return m.scores.value(peerID, proto)
+		}
+	})
+}
+
+// Scores returns the scores of all the peers requested for any of the protocols. It returns nil
+// if peer scoring is disabled.
+func (m *Manager) Scores() []PeerScore {
+	if m.scores == nil {
+		return nil
+	}
+	return m.scores.list()
+}
+
+// peerScore returns the average score of the peer over the shrex protocols.
+func (m *Manager) peerScore(peerID peer.ID) float64 {
+	return (m.scores.value(peerID, ProtocolEDS) + m.scores.value(peerID, ProtocolND)) / 2
+}
+
 func (m *Manager) newPeer(
 	ctx context.Context,
 	datahash share.DataHash,
+	proto Protocol,
 	peerID peer.ID,
 	source peerSource,
 	poolSize int,
@@ -245,21 +342,24 @@ func (m *Manager) newPeer(
 	log.Debugw("got peer",
 		"hash", datahash.String(),
 		"peer", peerID.String(),
+		"protocol", proto,
 		"source", source,
 		"pool_size", poolSize,
 		"wait (s)", waitTime)
 	m.metrics.observeGetPeer(ctx, source, poolSize, waitTime)
-	return peerID, m.doneFunc(datahash, peerID, source), nil
+	return peerID, m.doneFunc(datahash, proto, peerID, source), nil
 }
 
-func (m *Manager) doneFunc(datahash share.DataHash, peerID peer.ID, source peerSource) DoneFunc {
-	return func(result result) {
+func (m *Manager) doneFunc(datahash share.DataHash, proto Protocol, peerID peer.ID, source peerSource) DoneFunc {
+	start := time.Now()
+	return func(result result, opts ...DoneOption) {
 		log.Debugw("set peer result",
 			"hash", datahash.String(),
 			"peer", peerID.String(),
 			"source", source,
 			"result", result)
 		m.metrics.observeDoneResult(source, result)
+		m.observeRequest(proto, peerID, result, time.Since(start), opts...)
 		switch result {
 		case ResultNoop:
 		case ResultSynced:
@@ -271,7 +371,7 @@ func (m *Manager) doneFunc(datahash share.DataHash, peerID peer.ID, source peerS
 			}
 			m.getOrCreatePool(datahash.String()).putOnCooldown(peerID)
 		case ResultBlacklistPeer:
-			m.blacklistPeers(reasonMisbehave, peerID)
+			m.blacklistPeers(context.Background(), reasonMisbehave, peerID)
 		}
 	}
 }
@@ -290,7 +390,12 @@ func (m *Manager) subscribeHeader(ctx context.Context, headerSub libhead.Subscri
 			log.Errorw("get next header from sub", "err", err)
 			continue
 		}
-		m.validatedPool(h.DataHash.String())
+		p := m.validatedPool(h.DataHash.String())
+		// check the notifications received before the header against its DAH
+		if invalid := p.setExpected(h.DAH); len(invalid) > 0 {
+			p.remove(invalid...)
+			m.blacklistPeers(ctx, reasonInvalidNotification, invalid...)
+		}
 
 		// store first header for validation purposes
 		if m.initialHeight.CompareAndSwap(0, h.Height()) {
@@ -327,7 +432,7 @@ func (m *Manager) subscribeDisconnectedPeers(ctx context.Context, sub event.Subs
 }
 
 // Validate will collect peer.ID into corresponding peer pool
-func (m *Manager) Validate(_ context.Context, peerID peer.ID, msg shrexsub.Notification) pubsub.ValidationResult {
+func (m *Manager) Validate(ctx context.Context, peerID peer.ID, msg shrexsub.Notification) pubsub.ValidationResult {
 	logger := log.With("peer", peerID.String(), "hash", msg.DataHash.String())
 
 	// messages broadcast from self should bypass the validation with Accept
@@ -362,6 +467,11 @@ func (m *Manager) Validate(_ context.Context, peerID peer.ID, msg shrexsub.Notif
 	}
 
 	p := m.getOrCreatePool(msg.DataHash.String())
+	if !p.checkClaim(peerID, msg) {
+		logger.Debug("received notification not matching the DAH, reject validation")
+		m.blacklistPeers(ctx, reasonInvalidNotification, peerID)
+		return pubsub.ValidationReject
+	}
 	p.headerHeight.Store(msg.Height)
 	logger.Debugw("got hash from shrex-sub")
 
@@ -389,7 +499,7 @@ func (m *Manager) getOrCreatePool(datahash string) *syncPool {
 	return p
 }
 
-func (m *Manager) blacklistPeers(reason blacklistPeerReason, peerIDs ...peer.ID) {
+func (m *Manager) blacklistPeers(ctx context.Context, reason blacklistPeerReason, peerIDs ...peer.ID) {
 	m.metrics.observeBlacklistPeers(reason, len(peerIDs))
 
 	for _, peerID := range peerIDs {
@@ -401,11 +511,19 @@ func (m *Manager) blacklistPeers(reason blacklistPeerReason, peerIDs ...peer.ID)
 		}
 
 		m.fullNodes.remove(peerID)
+		// peers blocked before they are blacklisted are blocked by the operator, unless the Manager
+		// blacklisted them before
+		blockedByOperator := m.isBlacklistedPeer(peerID)
 		// add peer to the blacklist, so we can't connect to it in the future.
 		err := m.connGater.BlockPeer(peerID)
 		if err != nil {
 			log.Warnw("failed to block peer", "peer", peerID, "err", err)
 		}
+		// record why and when the peer was blacklisted, so that it can be expired or removed.
+		err = m.blacklist.add(ctx, peerID, reason, time.Now(), blockedByOperator)
+		if err != nil {
+			log.Warnw("failed to persist blacklisted peer", "peer", peerID, "err", err)
+		}
 		// close connections to peer.
 		err = m.host.Network().ClosePeer(peerID)
 		if err != nil {
@@ -414,6 +532,54 @@ func (m *Manager) blacklistPeers(reason blacklistPeerReason, peerIDs ...peer.ID)
 	}
 }
 
+// Blacklist returns the peers blacklisted by the Manager with the reasons.
+func (m *Manager) Blacklist() []BlacklistEntry {
+	return m.blacklist.list()
+}
+
+// RemoveFromBlacklist unblocks the peer blacklisted by the Manager, unless the peer is blocked by
+// the operator as well. It returns ErrNotBlacklisted if the peer is not in the blacklist.
+func (m *Manager) RemoveFromBlacklist(ctx context.Context, peerID peer.ID) error {
+	entry, err := m.blacklist.remove(ctx, peerID)
+	if err != nil {
+		return err
+	}
+	if !entry.BlockedByOperator {
+		if err = m.connGater.UnblockPeer(peerID); err != nil {
+			return fmt.Errorf("unblocking peer: %w", err)
+		}
+	}
+	log.Infow("removed peer from blacklist", "peer", peerID.String(), "still_blocked", entry.BlockedByOperator)
+	return nil
+}
+
+// BlockedByOperator records that the operator blocked the peer, so that the block is kept once
+// the peer is removed from the blacklist of the Manager.
+func (m *Manager) BlockedByOperator(ctx context.Context, peerID peer.ID) error {
+	return m.blacklist.markBlockedByOperator(ctx, peerID)
+}
+
+// UnblockedByOperator forgets the peer unblocked by the operator, so that the Manager neither
+// blocks it again on restart nor keeps it blocked once its blacklisting expires. It is a no-op for
+// peers that are not blacklisted.
+func (m *Manager) UnblockedByOperator(ctx context.Context, peerID peer.ID) error {
+	_, err := m.blacklist.remove(ctx, peerID)
+	if errors.Is(err, ErrNotBlacklisted) {
+		return nil
+	}
+	return err
+}
+
+// expireBlacklist unblocks the peers with expired blacklist entries.
+func (m *Manager) expireBlacklist(ctx context.Context) {
+	for _, peerID := range m.blacklist.expired(time.Now()) {
+		log.Debugw("blacklisting of peer expired", "peer", peerID.String())
+		if err := m.RemoveFromBlacklist(ctx, peerID); err != nil {
+			log.Warnw("failed to remove expired blacklisted peer", "peer", peerID, "err", err)
+		}
+	}
+}
+
 func (m *Manager) isBlacklistedPeer(peerID peer.ID) bool {
 	return !m.connGater.InterceptPeerDial(peerID)
 }
@@ -458,9 +624,37 @@ func (m *Manager) GC(ctx context.Context) {
 
 		blacklist = m.cleanUp()
 		if len(blacklist) > 0 {
-			m.blacklistPeers(reasonInvalidHash, blacklist...)
+			m.blacklistPeers(ctx, reasonInvalidHash, blacklist...)
 		}
+		m.expireBlacklist(ctx)
+
+		if m.scores != nil {
+			if err := m.scores.save(ctx); err != nil {
+				log.Warnw("saving peer scores", "err", err)
+			}
+		}
+	}
+}
+
+// observeRequest accounts the request made to the peer in its score.
+func (m *Manager) observeRequest(
+	proto Protocol,
+	peerID peer.ID,
+	result result,
+	latency time.Duration,
+	opts ...DoneOption,
+) {
+	if m.scores == nil {
+		return
+	}
+	stats := &requestStats{}
+	for _, opt := range opts {
+		opt(stats)
 	}
+
+	success := result == ResultNoop || result == ResultSynced
+	m.scores.observe(peerID, proto, latency, stats.received, success)
+	m.metrics.observeRequest(proto, latency, success)
 }
 
 func (m *Manager) cleanUp() []peer.ID {
@@ -510,6 +704,51 @@ func (m *Manager) markPoolAsSynced(datahash string) {
 	}
 }
 
+// checkClaim checks the square size and the namespace filter of the notification against the DAH
+// of the header, if it has already arrived. Otherwise, the notification is kept to be checked
+// later. Notifications from the v0.1.0 topic lack both and are not checked.
+func (p *syncPool) checkClaim(peerID peer.ID, msg shrexsub.Notification) bool {
+	if msg.SquareSize == 0 && msg.Namespaces == nil {
+		return true
+	}
+
+	p.claimsLk.Lock()
+	defer p.claimsLk.Unlock()
+	if p.expected != nil {
+		return matchesExpected(*p.expected, msg)
+	}
+	if p.claims == nil {
+		p.claims = make(map[peer.ID]shrexsub.Notification)
+	}
+	p.claims[peerID] = msg
+	return true
+}
+
+// setExpected sets the notification expected for the DAH and returns the peers whose earlier
+// notifications do not match it.
+func (p *syncPool) setExpected(dah *share.Root) []peer.ID {
+	if dah == nil {
+		return nil
+	}
+	expected := shrexsub.NewNotification(0, dah)
+
+	p.claimsLk.Lock()
+	defer p.claimsLk.Unlock()
+	p.expected = &expected
+	var invalid []peer.ID
+	for peerID, msg := range p.claims {
+		if !matchesExpected(expected, msg) {
+			invalid = append(invalid, peerID)
+		}
+	}
+	p.claims = nil
+	return invalid
+}
+
+func matchesExpected(expected, msg shrexsub.Notification) bool {
+	return expected.SquareSize == msg.SquareSize && expected.Namespaces.Equals(msg.Namespaces)
+}
+
 func (p *syncPool) add(peers ...peer.ID) {
 	if !p.isSynced.Load() {
 		p.pool.add(peers...)
@@ -13,6 +13,7 @@ import (
 	routinghelpers "github.com/libp2p/go-libp2p-routing-helpers"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
 	routingdisc "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	"github.com/libp2p/go-libp2p/p2p/net/conngater"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
@@ -22,8 +23,10 @@ import (
 
 	"github.com/celestiaorg/celestia-node/header"
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 	"github.com/celestiaorg/celestia-node/share/p2p/discovery"
 	"github.com/celestiaorg/celestia-node/share/p2p/shrexsub"
+	"github.com/celestiaorg/celestia-node/share/sharetest"
 )
 
 // TODO: add broadcast to tests
@@ -64,7 +67,7 @@ func TestManager(t *testing.T) {
 		result := manager.Validate(ctx, peerID, msg)
 		require.Equal(t, pubsub.ValidationIgnore, result)
 
-		pID, done, err := manager.Peer(ctx, h.DataHash.Bytes())
+		pID, done, err := manager.Peer(ctx, h.DataHash.Bytes(), ProtocolEDS)
 		require.NoError(t, err)
 		require.Equal(t, peerID, pID)
 
@@ -100,7 +103,7 @@ func TestManager(t *testing.T) {
 		require.Equal(t, pubsub.ValidationIgnore, result)
 
 		// mark peer as misbehaved to blacklist it
-		pID, done, err := manager.Peer(ctx, h.DataHash.Bytes())
+		pID, done, err := manager.Peer(ctx, h.DataHash.Bytes(), ProtocolEDS)
 		require.NoError(t, err)
 		require.Equal(t, peerID, pID)
 		manager.params.EnableBlackListing = true
@@ -139,8 +142,8 @@ func TestManager(t *testing.T) {
 
 		// create validated pool
 		validDataHash := share.DataHash("datahash2")
-		manager.fullNodes.add("full")    // add FN to unblock Peer call
-		manager.Peer(ctx, validDataHash) //nolint:errcheck
+		manager.fullNodes.add("full")                 // add FN to unblock Peer call
+		manager.Peer(ctx, validDataHash, ProtocolEDS) //nolint:errcheck
 
 		// trigger cleanup
 		blacklisted := manager.cleanUp()
@@ -172,7 +175,7 @@ func TestManager(t *testing.T) {
 		peers := []peer.ID{"peer1", "peer2", "peer3"}
 		manager.fullNodes.add(peers...)
 
-		peerID, done, err := manager.Peer(ctx, h.DataHash.Bytes())
+		peerID, done, err := manager.Peer(ctx, h.DataHash.Bytes(), ProtocolEDS)
 		done(ResultSynced)
 		require.NoError(t, err)
 		require.Contains(t, peers, peerID)
@@ -195,7 +198,7 @@ func TestManager(t *testing.T) {
 		// make sure peers are not returned before timeout
 		timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 		t.Cleanup(cancel)
-		_, _, err = manager.Peer(timeoutCtx, h.DataHash.Bytes())
+		_, _, err = manager.Peer(timeoutCtx, h.DataHash.Bytes(), ProtocolEDS)
 		require.ErrorIs(t, err, context.DeadlineExceeded)
 
 		peers := []peer.ID{"peer1", "peer2", "peer3"}
@@ -204,7 +207,7 @@ func TestManager(t *testing.T) {
 		doneCh := make(chan struct{})
 		go func() {
 			defer close(doneCh)
-			peerID, done, err := manager.Peer(ctx, h.DataHash.Bytes())
+			peerID, done, err := manager.Peer(ctx, h.DataHash.Bytes(), ProtocolEDS)
 			done(ResultSynced)
 			require.NoError(t, err)
 			require.Contains(t, peers, peerID)
@@ -238,7 +241,7 @@ func TestManager(t *testing.T) {
 		result := manager.Validate(ctx, peerID, msg)
 		require.Equal(t, pubsub.ValidationIgnore, result)
 
-		pID, done, err := manager.Peer(ctx, h.DataHash.Bytes())
+		pID, done, err := manager.Peer(ctx, h.DataHash.Bytes(), ProtocolEDS)
 		require.NoError(t, err)
 		require.Equal(t, peerID, pID)
 		done(ResultSynced)
@@ -355,7 +358,7 @@ func TestIntegration(t *testing.T) {
 		}))
 
 		// FN should get message
-		peerID, _, err := fnPeerManager.Peer(ctx, peerHash)
+		peerID, _, err := fnPeerManager.Peer(ctx, peerHash, ProtocolEDS)
 		require.NoError(t, err)
 
 		// check that peerID matched bridge node
@@ -424,6 +427,7 @@ func TestIntegration(t *testing.T) {
 			fnDisc,
 			nil,
 			connGater,
+			sync.MutexWrap(datastore.NewMapDatastore()),
 		)
 		require.NoError(t, err)
 
@@ -474,6 +478,7 @@ func testManager(ctx context.Context, headerSub libhead.Subscriber[*header.Exten
 		disc,
 		host,
 		connGater,
+		sync.MutexWrap(datastore.NewMapDatastore()),
 	)
 	if err != nil {
 		return nil, err
@@ -564,3 +569,139 @@ func newShrexSubMsg(h *header.ExtendedHeader) shrexsub.Notification {
 		Height:   h.Height(),
 	}
 }
+
+func TestManager_Blacklist(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	h := testHeader()
+	ds := sync.MutexWrap(datastore.NewMapDatastore())
+	connGater, err := conngater.NewBasicConnectionGater(sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+
+	params := DefaultParameters()
+	params.EnableBlackListing = true
+	params.BlacklistTTL = time.Hour
+	newManager := func() *Manager {
+		host, err := mocknet.New().GenPeer()
+		require.NoError(t, err)
+		shrexSub, err := shrexsub.NewPubSub(ctx, host, "test")
+		require.NoError(t, err)
+		disc := discovery.NewDiscovery(nil,
+			routingdisc.NewRoutingDiscovery(routinghelpers.Null{}),
+			discovery.WithPeersLimit(0),
+			discovery.WithAdvertiseInterval(time.Second),
+		)
+		manager, err := NewManager(params, newSubLock(h, nil), shrexSub, disc, host, connGater, ds)
+		require.NoError(t, err)
+		require.NoError(t, manager.Start(ctx))
+		return manager
+	}
+
+	manager := newManager()
+	peerID := test.RandPeerIDFatal(t)
+	manager.Validate(ctx, peerID, newShrexSubMsg(h))
+	pID, done, err := manager.Peer(ctx, h.DataHash.Bytes(), ProtocolEDS)
+	require.NoError(t, err)
+	require.Equal(t, peerID, pID)
+	done(ResultBlacklistPeer)
+
+	entries := manager.Blacklist()
+	require.Len(t, entries, 1)
+	require.Equal(t, peerID, entries[0].Peer)
+	require.Equal(t, string(reasonMisbehave), entries[0].Reason)
+	require.Equal(t, entries[0].BlacklistedAt.Add(time.Hour), entries[0].ExpiresAt)
+	require.True(t, manager.isBlacklistedPeer(peerID))
+	stopManager(t, manager)
+
+	// blacklist survives the restart
+	manager = newManager()
+	require.Len(t, manager.Blacklist(), 1)
+	require.True(t, manager.isBlacklistedPeer(peerID))
+
+	// removed peers are unblocked
+	require.NoError(t, manager.RemoveFromBlacklist(ctx, peerID))
+	require.ErrorIs(t, manager.RemoveFromBlacklist(ctx, peerID), ErrNotBlacklisted)
+	require.Empty(t, manager.Blacklist())
+	require.False(t, manager.isBlacklistedPeer(peerID))
+
+	// expired peers are unblocked
+	manager.blacklistPeers(ctx, reasonInvalidHash, peerID)
+	require.True(t, manager.isBlacklistedPeer(peerID))
+	manager.blacklist.entries[peerID].ExpiresAt = time.Now()
+	manager.expireBlacklist(ctx)
+	require.Empty(t, manager.Blacklist())
+	require.False(t, manager.isBlacklistedPeer(peerID))
+
+	// peers blocked by the operator stay blocked, whether blocked before or after the blacklisting
+	require.NoError(t, connGater.BlockPeer(peerID))
+	manager.blacklistPeers(ctx, reasonMisbehave, peerID)
+	require.NoError(t, manager.RemoveFromBlacklist(ctx, peerID))
+	require.True(t, manager.isBlacklistedPeer(peerID))
+	require.NoError(t, connGater.UnblockPeer(peerID))
+
+	manager.blacklistPeers(ctx, reasonMisbehave, peerID)
+	require.NoError(t, manager.BlockedByOperator(ctx, peerID))
+	require.NoError(t, manager.RemoveFromBlacklist(ctx, peerID))
+	require.True(t, manager.isBlacklistedPeer(peerID))
+	require.NoError(t, connGater.UnblockPeer(peerID))
+	require.NoError(t, manager.UnblockedByOperator(ctx, peerID))
+
+	// peers blocked and then unblocked by the operator are forgotten and stay unblocked on restart
+	manager.blacklistPeers(ctx, reasonMisbehave, peerID)
+	require.NoError(t, connGater.BlockPeer(peerID))
+	require.NoError(t, manager.BlockedByOperator(ctx, peerID))
+	require.NoError(t, connGater.UnblockPeer(peerID))
+	require.NoError(t, manager.UnblockedByOperator(ctx, peerID))
+	require.Empty(t, manager.Blacklist())
+	require.False(t, manager.isBlacklistedPeer(peerID))
+	stopManager(t, manager)
+
+	manager = newManager()
+	require.Empty(t, manager.Blacklist())
+	require.False(t, manager.isBlacklistedPeer(peerID))
+	stopManager(t, manager)
+}
+
+func TestManager_ValidateNotificationAgainstDAH(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	t.Cleanup(cancel)
+
+	_, dah := edstest.RandEDSWithNamespace(t, sharetest.RandV0Namespace(), 4)
+	_, otherDAH := edstest.RandEDSWithNamespace(t, sharetest.RandV0Namespace(), 4)
+	h := &header.ExtendedHeader{
+		RawHeader: header.RawHeader{
+			Height:   1,
+			DataHash: dah.Hash(),
+		},
+		DAH: &dah,
+	}
+	headerSub := newSubLock(h, nil)
+
+	manager, err := testManager(ctx, headerSub)
+	require.NoError(t, err)
+	manager.params.EnableBlackListing = true
+
+	valid := shrexsub.NewNotification(h.Height(), &dah)
+	invalid := valid
+	invalid.Namespaces = shrexsub.NewNamespaceFilter(&otherDAH)
+
+	// notifications received before the header are checked once it arrives
+	honest, liar := test.RandPeerIDFatal(t), test.RandPeerIDFatal(t)
+	require.Equal(t, pubsub.ValidationIgnore, manager.Validate(ctx, honest, valid))
+	require.Equal(t, pubsub.ValidationIgnore, manager.Validate(ctx, liar, invalid))
+	require.NoError(t, headerSub.wait(ctx, 1))
+	require.False(t, manager.isBlacklistedPeer(honest))
+	require.True(t, manager.isBlacklistedPeer(liar))
+
+	// notifications received after the header are checked right away
+	honest, liar = test.RandPeerIDFatal(t), test.RandPeerIDFatal(t)
+	require.Equal(t, pubsub.ValidationIgnore, manager.Validate(ctx, honest, valid))
+	require.Equal(t, pubsub.ValidationReject, manager.Validate(ctx, liar, invalid))
+	require.False(t, manager.isBlacklistedPeer(honest))
+	require.True(t, manager.isBlacklistedPeer(liar))
+
+	// notifications from the v0.1.0 topic lack the filter and are not checked
+	require.Equal(t, pubsub.ValidationIgnore, manager.Validate(ctx, test.RandPeerIDFatal(t), newShrexSubMsg(h)))
+	stopManager(t, manager)
+}
@@ -18,14 +18,17 @@ import (
 const (
 	isInstantKey  = "is_instant"
 	doneResultKey = "done_result"
+	protocolKey   = "protocol"
+	successKey    = "success"
 
 	sourceKey                  = "source"
 	sourceShrexSub  peerSource = "shrexsub"
 	sourceFullNodes peerSource = "full_nodes"
 
-	blacklistPeerReasonKey                     = "blacklist_reason"
-	reasonInvalidHash      blacklistPeerReason = "invalid_hash"
-	reasonMisbehave        blacklistPeerReason = "misbehave"
+	blacklistPeerReasonKey                        = "blacklist_reason"
+	reasonInvalidHash         blacklistPeerReason = "invalid_hash"
+	reasonMisbehave           blacklistPeerReason = "misbehave"
+	reasonInvalidNotification blacklistPeerReason = "invalid_notification"
 
 	validationResultKey = "validation_result"
 	validationAccept    = "accept"
@@ -67,11 +70,14 @@ type metrics struct {
 	getPeerPoolSizeHistogram metric.Int64Histogram // attributes: source
 	doneResult               metric.Int64Counter   // attributes: source, done_result
 	validationResult         metric.Int64Counter   // attributes: validation_result
+	requestTimeHistogram     metric.Int64Histogram // attributes: protocol, success
 
 	shrexPools               metric.Int64ObservableGauge // attributes: pool_status
 	fullNodesPool            metric.Int64ObservableGauge // attributes: pool_status
 	blacklistedPeersByReason sync.Map
-	blacklistedPeers         metric.Int64ObservableGauge // attributes: blacklist_reason
+	blacklistedPeers         metric.Int64ObservableGauge   // attributes: blacklist_reason
+	scoredPeers              metric.Int64ObservableGauge   // attributes: protocol
+	averageScore             metric.Float64ObservableGauge // attributes: protocol
 }
 
 func initMetrics(manager *Manager) (*metrics, error) {
@@ -105,6 +111,24 @@ func initMetrics(manager *Manager) (*metrics, error) {
 		return nil, err
 	}
 
+	requestTimeHistogram, err := meter.Int64Histogram("peer_manager_request_ms_time_hist",
+		metric.WithDescription("time histogram(ms) of requests to peers, observed only if peer scoring is enabled"))
+	if err != nil {
+		return nil, err
+	}
+
+	scoredPeers, err := meter.Int64ObservableGauge("peer_manager_scored_peers_gauge",
+		metric.WithDescription("amount of peers with a score"))
+	if err != nil {
+		return nil, err
+	}
+
+	averageScore, err := meter.Float64ObservableGauge("peer_manager_average_score_gauge",
+		metric.WithDescription("average score of scored peers"))
+	if err != nil {
+		return nil, err
+	}
+
 	shrexPools, err := meter.Int64ObservableGauge("peer_manager_pools_gauge",
 		metric.WithDescription("pools amount"))
 	if err != nil {
@@ -132,6 +156,9 @@ func initMetrics(manager *Manager) (*metrics, error) {
 		fullNodesPool:            fullNodesPool,
 		getPeerPoolSizeHistogram: getPeerPoolSizeHistogram,
 		blacklistedPeers:         blacklisted,
+		requestTimeHistogram:     requestTimeHistogram,
+		scoredPeers:              scoredPeers,
+		averageScore:             averageScore,
 	}
 
 	callback := func(ctx context.Context, observer metric.Observer) error {
@@ -156,9 +183,20 @@ func initMetrics(manager *Manager) (*metrics, error) {
 					attribute.String(blacklistPeerReasonKey, string(reason))))
 			return true
 		})
+
+		amounts, sums := make(map[Protocol]int64), make(map[Protocol]float64)
+		for _, score := range manager.Scores() {
+			amounts[score.Protocol]++
+			sums[score.Protocol] += score.Score
+		}
+		for proto, amount := range amounts {
+			attrs := metric.WithAttributes(attribute.String(protocolKey, string(proto)))
+			observer.ObserveInt64(scoredPeers, amount, attrs)
+			observer.ObserveFloat64(averageScore, sums[proto]/float64(amount), attrs)
+		}
 		return nil
 	}
-	_, err = meter.RegisterCallback(callback, shrexPools, fullNodesPool, blacklisted)
+	_, err = meter.RegisterCallback(callback, shrexPools, fullNodesPool, blacklisted, scoredPeers, averageScore)
 	if err != nil {
 		return nil, fmt.Errorf("registering metrics callback: %w", err)
 	}
@@ -278,3 +316,13 @@ func (m *Manager) shrexPools() map[poolStatus]int64 {
 	shrexPools[poolStatusBlacklisted] = int64(len(m.blacklistedHashes))
 	return shrexPools
 }
+
+func (m *metrics) observeRequest(proto Protocol, latency time.Duration, success bool) {
+	if m == nil {
+		return
+	}
+	m.requestTimeHistogram.Record(context.Background(), latency.Milliseconds(),
+		metric.WithAttributes(
+			attribute.String(protocolKey, string(proto)),
+			attribute.Bool(successKey, success)))
+}
@@ -19,6 +19,19 @@ type Parameters struct {
 
 	// EnableBlackListing turns on blacklisting for misbehaved peers
 	EnableBlackListing bool
+	// BlacklistTTL is the time misbehaved peers stay blacklisted for. Set 0 to keep them
+	// blacklisted until removed manually.
+	BlacklistTTL time.Duration
+
+	// EnablePeerScoring turns on scoring of peers by the latency, throughput and success rate of
+	// requests, so that the best scored peers are returned first.
+	EnablePeerScoring bool
+	// ScoreHalfLife is the time in which peer scores lose half of their weight and decay towards
+	// the neutral score.
+	ScoreHalfLife time.Duration
+	// ExplorationRate is the share of requests for which peers are returned in round-robin order
+	// instead of by score, so that scores of other peers get updated.
+	ExplorationRate float64
 }
 
 // Validate validates the values in Parameters
@@ -35,6 +48,19 @@ func (p *Parameters) Validate() error {
 		return fmt.Errorf("peer-manager: garbage collection interval must be positive")
 	}
 
+	if p.BlacklistTTL < 0 {
+		return fmt.Errorf("peer-manager: blacklist TTL must not be negative")
+	}
+
+	if p.EnablePeerScoring {
+		if p.ScoreHalfLife <= 0 {
+			return fmt.Errorf("peer-manager: score half-life must be positive")
+		}
+		if p.ExplorationRate < 0 || p.ExplorationRate > 1 {
+			return fmt.Errorf("peer-manager: exploration rate must be within [0, 1]")
+		}
+	}
+
 	return nil
 }
 
@@ -53,6 +79,10 @@ func DefaultParameters() Parameters {
 		// blacklisting is off by default //TODO(@walldiss): enable blacklisting once all related issues
 		// are resolved
 		EnableBlackListing: false,
+		BlacklistTTL:       24 * time.Hour,
+		EnablePeerScoring:  true,
+		ScoreHalfLife:      time.Hour,
+		ExplorationRate:    0.1,
 	}
 }
 
@@ -79,6 +79,43 @@ func (p *pool) tryGet() (peer.ID, bool) {
 	}
 }
 
+// tryGetBest returns the active peer with the highest score along with bool flag indicating
+// success of operation. Peers with equal scores are returned in round-robin order.
+func (p *pool) tryGetBest(score func(peer.ID) float64) (peer.ID, bool) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.activeCount == 0 {
+		return "", false
+	}
+
+	// if pointer is out of range, point to first element
+	if p.nextIdx > len(p.peersList)-1 {
+		p.nextIdx = 0
+	}
+
+	bestIdx, bestScore := -1, 0.0
+	for i := 0; i < len(p.peersList); i++ {
+		idx := (p.nextIdx + i) % len(p.peersList)
+		peerID := p.peersList[idx]
+		if p.statuses[peerID] != active {
+			continue
+		}
+		if s := score(peerID); bestIdx == -1 || s > bestScore {
+			bestIdx, bestScore = idx, s
+		}
+	}
+	if bestIdx == -1 {
+		return "", false
+	}
+
+	p.nextIdx = bestIdx + 1
+	if p.nextIdx == len(p.peersList) {
+		p.nextIdx = 0
+	}
+	return p.peersList[bestIdx], true
+}
+
 // next sends a peer to the returned channel when it becomes available.
 func (p *pool) next(ctx context.Context) <-chan peer.ID {
 	peerCh := make(chan peer.ID, 1)
@@ -0,0 +1,273 @@
+package peers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/namespace"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Protocol is the shrex protocol a peer is requested for.
+type Protocol string
+
+const (
+	ProtocolEDS Protocol = "eds"
+	ProtocolND  Protocol = "nd"
+)
+
+const (
+	// neutralScore is the score of peers without any observed requests.
+	neutralScore = 0.5
+	// scoreSmoothing is the weight of a new observation in the moving averages of a fresh score.
+	scoreSmoothing = 0.2
+	// minScoreWeight is the weight below which decayed scores are forgotten.
+	minScoreWeight = 0.01
+	// refLatency is the request latency that halves the latency part of the score.
+	refLatency = time.Second
+	// refThroughput is the throughput in bytes per second that halves the throughput part of the
+	// score.
+	refThroughput = 1 << 20
+)
+
+var scoresKey = datastore.NewKey("peer_scores")
+
+// PeerScore describes the performance of a peer serving a single protocol.
+type PeerScore struct {
+	Peer     peer.ID
+	Protocol Protocol
+	// Score is the overall score of the peer in [0, 1]. Peers without observed requests have the
+	// neutral score of 0.5, and scores decay to it over time.
+	Score float64
+	// Latency is the moving average of the request latencies.
+	Latency time.Duration
+	// Throughput is the moving average of the throughput of successful requests in bytes per second.
+	Throughput float64
+	// SuccessRate is the moving average of the request outcomes in [0, 1].
+	SuccessRate float64
+	// Requests is the total amount of observed requests.
+	Requests uint64
+	// UpdatedAt is the time of the last observed request.
+	UpdatedAt time.Time
+}
+
+// score is the persisted state of a peer score.
+type score struct {
+	Latency     time.Duration
+	Throughput  float64
+	SuccessRate float64
+	Requests    uint64
+	UpdatedAt   time.Time
+}
+
+type scoreKey struct {
+	peer  peer.ID
+	proto Protocol
+}
+
+// scores tracks the performance of peers per protocol and persists it in the datastore.
+type scores struct {
+	halfLife time.Duration
+	ds       datastore.Datastore
+
+	lk     sync.Mutex
+	scores map[scoreKey]*score
+	dirty  map[scoreKey]bool
+}
+
+func newScores(ds datastore.Datastore, halfLife time.Duration) *scores {
+	return &scores{
+		halfLife: halfLife,
+		ds:       namespace.Wrap(ds, scoresKey),
+		scores:   make(map[scoreKey]*score),
+		dirty:    make(map[scoreKey]bool),
+	}
+}
+
+// observe accounts the result of a request to the given peer. Received bytes are used to compute
+// the throughput of successful requests.
+func (s *scores) observe(id peer.ID, proto Protocol, latency time.Duration, received int, success bool) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	now := time.Now()
+	key := scoreKey{peer: id, proto: proto}
+	sc, ok := s.scores[key]
+	if !ok {
+		sc = &score{Latency: latency, SuccessRate: neutralScore}
+		s.scores[key] = sc
+	}
+
+	// the older the score, the more the new observation replaces it
+	alpha := math.Max(scoreSmoothing, 1-s.weight(sc, now))
+	outcome := 0.0
+	if success {
+		outcome = 1
+	}
+	sc.SuccessRate += alpha * (outcome - sc.SuccessRate)
+	sc.Latency += time.Duration(alpha * float64(latency-sc.Latency))
+	if success && received > 0 && latency > 0 {
+		throughput := float64(received) / latency.Seconds()
+		if sc.Throughput == 0 {
+			sc.Throughput = throughput
+		} else {
+			sc.Throughput += alpha * (throughput - sc.Throughput)
+		}
+	}
+	sc.Requests++
+	sc.UpdatedAt = now
+	s.dirty[key] = true
+}
+
+// value returns the current score of the peer for the given protocol.
+func (s *scores) value(id peer.ID, proto Protocol) float64 {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	sc, ok := s.scores[scoreKey{peer: id, proto: proto}]
+	if !ok {
+		return neutralScore
+	}
+	return s.decayed(sc, time.Now())
+}
+
+// decayed returns the score value decayed towards the neutral score according to its age.
+func (s *scores) decayed(sc *score, now time.Time) float64 {
+	speed := refLatency.Seconds() / (refLatency.Seconds() + sc.Latency.Seconds())
+	if sc.Throughput > 0 {
+		speed = (speed + sc.Throughput/(sc.Throughput+refThroughput)) / 2
+	}
+	raw := sc.SuccessRate * speed
+	return neutralScore + (raw-neutralScore)*s.weight(sc, now)
+}
+
+// weight returns the weight of the score in [0, 1], halving every half-life since its last update.
+func (s *scores) weight(sc *score, now time.Time) float64 {
+	age := now.Sub(sc.UpdatedAt)
+	if age <= 0 {
+		return 1
+	}
+	return math.Pow(2, -float64(age)/float64(s.halfLife))
+}
+
+// list returns all the tracked scores, ordered by peer and protocol.
+func (s *scores) list() []PeerScore {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	now := time.Now()
+	list := make([]PeerScore, 0, len(s.scores))
+	for key, sc := range s.scores {
+		list = append(list, PeerScore{
+			Peer:        key.peer,
+			Protocol:    key.proto,
+			Score:       s.decayed(sc, now),
+			Latency:     sc.Latency,
+			Throughput:  sc.Throughput,
+			SuccessRate: sc.SuccessRate,
+			Requests:    sc.Requests,
+			UpdatedAt:   sc.UpdatedAt,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Peer != list[j].Peer {
+			return list[i].Peer < list[j].Peer
+		}
+		return list[i].Protocol < list[j].Protocol
+	})
+	return list
+}
+
+// load reads the persisted scores out of the datastore.
+func (s *scores) load(ctx context.Context) error {
+	results, err := s.ds.Query(ctx, query.Query{})
+	if err != nil {
+		return fmt.Errorf("querying scores: %w", err)
+	}
+	defer results.Close()
+
+	s.lk.Lock()
+	defer s.lk.Unlock()
+	for res := range results.Next() {
+		if res.Error != nil {
+			return fmt.Errorf("iterating scores: %w", res.Error)
+		}
+		key, err := parseScoreKey(datastore.RawKey(res.Key))
+		if err != nil {
+			log.Warnw("skipping invalid peer score", "key", res.Key, "err", err)
+			continue
+		}
+		sc := &score{}
+		if err = json.Unmarshal(res.Value, sc); err != nil {
+			log.Warnw("skipping invalid peer score", "key", res.Key, "err", err)
+			continue
+		}
+		s.scores[key] = sc
+	}
+	return nil
+}
+
+// save persists the scores updated since the last save and forgets the fully decayed ones.
+func (s *scores) save(ctx context.Context) error {
+	s.lk.Lock()
+	now := time.Now()
+	updated := make(map[datastore.Key][]byte, len(s.dirty))
+	var removed []datastore.Key
+	for key, sc := range s.scores {
+		if s.weight(sc, now) < minScoreWeight {
+			delete(s.scores, key)
+			delete(s.dirty, key)
+			removed = append(removed, key.dsKey())
+			continue
+		}
+		if !s.dirty[key] {
+			continue
+		}
+		data, err := json.Marshal(sc)
+		if err != nil {
+			s.lk.Unlock()
+			return err
+		}
+		updated[key.dsKey()] = data
+	}
+	s.dirty = make(map[scoreKey]bool)
+	s.lk.Unlock()
+
+	if len(updated) == 0 && len(removed) == 0 {
+		return nil
+	}
+	for key, data := range updated {
+		if err := s.ds.Put(ctx, key, data); err != nil {
+			return fmt.Errorf("saving score: %w", err)
+		}
+	}
+	for _, key := range removed {
+		if err := s.ds.Delete(ctx, key); err != nil {
+			return fmt.Errorf("removing score: %w", err)
+		}
+	}
+	return s.ds.Sync(ctx, datastore.NewKey("/"))
+}
+
+func (k scoreKey) dsKey() datastore.Key {
+	return datastore.KeyWithNamespaces([]string{k.peer.String(), string(k.proto)})
+}
+
+func parseScoreKey(key datastore.Key) (scoreKey, error) {
+	parts := key.Namespaces()
+	if len(parts) != 2 {
+		return scoreKey{}, fmt.Errorf("unexpected key format")
+	}
+	id, err := peer.Decode(parts[0])
+	if err != nil {
+		return scoreKey{}, err
+	}
+	return scoreKey{peer: id, proto: Protocol(parts[1])}, nil
+}
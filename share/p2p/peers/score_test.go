@@ -0,0 +1,113 @@
+package peers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-node/share/p2p/discovery"
+)
+
+func TestScores(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	fast, slow, failing := peer.ID("fast"), peer.ID("slow"), peer.ID("failing")
+
+	t.Run("fast and reliable peers score higher", func(t *testing.T) {
+		s := newScores(sync.MutexWrap(datastore.NewMapDatastore()), time.Hour)
+		for i := 0; i < 10; i++ {
+			s.observe(fast, ProtocolEDS, 10*time.Millisecond, 1<<20, true)
+			s.observe(slow, ProtocolEDS, 5*time.Second, 1<<20, true)
+			s.observe(failing, ProtocolEDS, 10*time.Millisecond, 0, false)
+		}
+
+		require.Greater(t, s.value(fast, ProtocolEDS), s.value(slow, ProtocolEDS))
+		require.Greater(t, s.value(slow, ProtocolEDS), s.value(failing, ProtocolEDS))
+		// scores are tracked per protocol
+		require.Equal(t, neutralScore, s.value(fast, ProtocolND))
+	})
+
+	t.Run("scores decay to neutral", func(t *testing.T) {
+		s := newScores(sync.MutexWrap(datastore.NewMapDatastore()), time.Hour)
+		s.observe(failing, ProtocolEDS, time.Second, 0, false)
+
+		sc := s.scores[scoreKey{peer: failing, proto: ProtocolEDS}]
+		fresh := s.decayed(sc, sc.UpdatedAt)
+		decayed := s.decayed(sc, sc.UpdatedAt.Add(time.Hour))
+		require.Less(t, fresh, decayed)
+		require.InDelta(t, neutralScore-(neutralScore-fresh)/2, decayed, 1e-9)
+	})
+
+	t.Run("persistence", func(t *testing.T) {
+		// persisted scores are keyed by valid peer IDs
+		fast, slow := test.RandPeerIDFatal(t), test.RandPeerIDFatal(t)
+		ds := sync.MutexWrap(datastore.NewMapDatastore())
+		s := newScores(ds, time.Hour)
+		s.observe(fast, ProtocolEDS, 10*time.Millisecond, 1<<20, true)
+		s.observe(slow, ProtocolND, 5*time.Second, 1<<10, true)
+		require.NoError(t, s.save(ctx))
+
+		loaded := newScores(ds, time.Hour)
+		require.NoError(t, loaded.load(ctx))
+		expected, got := s.list(), loaded.list()
+		require.Len(t, got, len(expected))
+		for i := range expected {
+			// scores keep decaying in between the listings
+			require.True(t, expected[i].UpdatedAt.Equal(got[i].UpdatedAt))
+			require.InDelta(t, expected[i].Score, got[i].Score, 1e-6)
+			got[i].UpdatedAt, got[i].Score = expected[i].UpdatedAt, expected[i].Score
+		}
+		require.Equal(t, expected, got)
+
+		// fully decayed scores are forgotten
+		loaded.scores[scoreKey{peer: slow, proto: ProtocolND}].UpdatedAt = time.Now().Add(-24 * time.Hour)
+		loaded.dirty[scoreKey{peer: slow, proto: ProtocolND}] = true
+		require.NoError(t, loaded.save(ctx))
+
+		reloaded := newScores(ds, time.Hour)
+		require.NoError(t, reloaded.load(ctx))
+		list := reloaded.list()
+		require.Len(t, list, 1)
+		require.Equal(t, fast, list[0].Peer)
+	})
+}
+
+func TestManager_ScoredPeer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	params := DefaultParameters()
+	params.ExplorationRate = 0
+	manager, err := NewManager(params, nil, nil, discovery.NewDiscovery(nil, nil), nil, nil,
+		sync.MutexWrap(datastore.NewMapDatastore()))
+	require.NoError(t, err)
+
+	fast, slow := peer.ID("fast"), peer.ID("slow")
+	manager.fullNodes.add(slow, fast)
+	manager.scores.observe(fast, ProtocolEDS, 10*time.Millisecond, 1<<20, true)
+	manager.scores.observe(slow, ProtocolEDS, 5*time.Second, 1<<20, true)
+
+	// the best scored peer is always chosen without exploration
+	for i := 0; i < 3; i++ {
+		peerID, done, err := manager.Peer(ctx, []byte("datahash"), ProtocolEDS)
+		require.NoError(t, err)
+		require.Equal(t, fast, peerID)
+		done(ResultNoop, WithReceived(1<<20))
+	}
+	require.Equal(t, uint64(4), manager.scores.scores[scoreKey{peer: fast, proto: ProtocolEDS}].Requests)
+
+	// without scoring, peers are returned in round-robin order
+	manager.scores = nil
+	first, _, err := manager.Peer(ctx, []byte("datahash"), ProtocolEDS)
+	require.NoError(t, err)
+	second, _, err := manager.Peer(ctx, []byte("datahash"), ProtocolEDS)
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
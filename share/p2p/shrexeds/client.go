@@ -12,6 +12,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
+	msmux "github.com/multiformats/go-multistream"
 
 	"github.com/celestiaorg/go-libp2p-messenger/serde"
 	"github.com/celestiaorg/rsmt2d"
@@ -22,11 +23,16 @@ import (
 	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexeds/pb"
 )
 
+// ErrRowsNotSupported is returned by Client.RequestRows when the peer does not support requests of
+// row ranges yet. The whole EDS can still be requested from it with Client.RequestEDS.
+var ErrRowsNotSupported = errors.New("peer does not support requests of rows")
+
 // Client is responsible for requesting EDSs for blocksync over the ShrEx/EDS protocol.
 type Client struct {
-	params     *Parameters
-	protocolID protocol.ID
-	host       host.Host
+	params         *Parameters
+	protocolID     protocol.ID
+	rowsProtocolID protocol.ID
+	host           host.Host
 
 	metrics *p2p.Metrics
 }
@@ -38,9 +44,10 @@ func NewClient(params *Parameters, host host.Host) (*Client, error) {
 	}
 
 	return &Client{
-		params:     params,
-		host:       host,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
+		params:         params,
+		host:           host,
+		protocolID:     p2p.ProtocolID(params.NetworkID(), protocolString),
+		rowsProtocolID: p2p.ProtocolID(params.NetworkID(), rowsProtocolString),
 	}, nil
 }
 
@@ -51,13 +58,37 @@ func (c *Client) RequestEDS(
 	peer peer.ID,
 ) (*rsmt2d.ExtendedDataSquare, error) {
 	eds, err := c.doRequest(ctx, dataHash, peer)
-	if err == nil {
-		return eds, nil
+	if err != nil {
+		return nil, c.handleError(ctx, err, dataHash, peer)
 	}
+	return eds, nil
+}
+
+// RequestRows requests the left halves of the EDS rows [from, to) from the given peer. It returns
+// the full rows upon success, each of them recomputed and verified against the row root.
+// ErrRowsNotSupported is returned if the peer does not support requests of row ranges.
+func (c *Client) RequestRows(
+	ctx context.Context,
+	root *share.Root,
+	from, to int,
+	peer peer.ID,
+) ([][]share.Share, error) {
+	if from < 0 || from >= to || to > len(root.RowRoots) {
+		return nil, fmt.Errorf("invalid rows range [%d, %d) for width %d", from, to, len(root.RowRoots))
+	}
+
+	rows, err := c.doRowsRequest(ctx, root, from, to, peer)
+	if err != nil {
+		return nil, c.handleError(ctx, err, root.Hash(), peer)
+	}
+	return rows, nil
+}
+
+func (c *Client) handleError(ctx context.Context, err error, dataHash share.DataHash, peer peer.ID) error {
 	log.Debugw("client: eds request to peer failed", "peer", peer.String(), "hash", dataHash.String(), "error", err)
 	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 		c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
-		return nil, err
+		return err
 	}
 	// some net.Errors also mean the context deadline was exceeded, but yamux/mocknet do not
 	// unwrap to a ctx err
@@ -65,17 +96,16 @@ func (c *Client) RequestEDS(
 	if errors.As(err, &ne) && ne.Timeout() {
 		if deadline, _ := ctx.Deadline(); deadline.Before(time.Now()) {
 			c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
-			return nil, context.DeadlineExceeded
+			return context.DeadlineExceeded
 		}
 	}
-	if err != p2p.ErrNotFound {
+	if err != p2p.ErrNotFound && err != p2p.ErrRateLimited && err != ErrRowsNotSupported {
 		log.Warnw("client: eds request to peer failed",
 			"peer", peer.String(),
 			"hash", dataHash.String(),
 			"err", err)
 	}
-
-	return nil, err
+	return err
 }
 
 func (c *Client) doRequest(
@@ -83,19 +113,84 @@ func (c *Client) doRequest(
 	dataHash share.DataHash,
 	to peer.ID,
 ) (*rsmt2d.ExtendedDataSquare, error) {
+	log.Debugw("client: requesting ods", "hash", dataHash.String(), "peer", to.String())
+	stream, err := c.openStream(ctx, c.protocolID, &pb.EDSRequest{Hash: dataHash}, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// use header and ODS bytes to construct EDS and verify it against dataHash
+	eds, err := eds.ReadEDS(ctx, stream, dataHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read eds from ods bytes: %w", err)
+	}
+	c.metrics.ObserveRequests(ctx, 1, p2p.StatusSuccess)
+	return eds, nil
+}
+
+func (c *Client) doRowsRequest(
+	ctx context.Context,
+	root *share.Root,
+	from, to int,
+	peerID peer.ID,
+) ([][]share.Share, error) {
+	log.Debugw("client: requesting rows",
+		"hash", root.String(),
+		"from", from,
+		"to", to,
+		"peer", peerID.String())
+	req := &pb.EDSRequest{Hash: root.Hash(), RowStart: uint32(from), RowEnd: uint32(to)}
+	stream, err := c.openStream(ctx, c.rowsProtocolID, req, peerID)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close() //nolint:errcheck
+
+	odsWidth := len(root.RowRoots) / 2
+	rows := make([][]share.Share, 0, to-from)
+	for rowIdx := from; rowIdx < to; rowIdx++ {
+		half := make([]share.Share, odsWidth)
+		for i := range half {
+			half[i] = make([]byte, share.Size)
+			if _, err = io.ReadFull(stream, half[i]); err != nil {
+				stream.Reset() //nolint:errcheck
+				return nil, fmt.Errorf("failed to read row %d: %w", rowIdx, err)
+			}
+		}
+		// verify each row as it arrives, so that invalid responses are detected early
+		row, err := eds.ExtendRow(root, rowIdx, half)
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			log.Debugw("client: invalid row", "row", rowIdx, "err", err)
+			return nil, p2p.ErrInvalidResponse
+		}
+		rows = append(rows, row)
+	}
+	c.metrics.ObserveRequests(ctx, 1, p2p.StatusSuccess)
+	return rows, nil
+}
+
+// openStream sends the request to the peer over the given protocol and returns the stream to read
+// the requested data from once the peer reports it is available.
+func (c *Client) openStream(
+	ctx context.Context,
+	protocolID protocol.ID,
+	req *pb.EDSRequest,
+	to peer.ID,
+) (network.Stream, error) {
 	streamOpenCtx, cancel := context.WithTimeout(ctx, c.params.ServerReadTimeout)
 	defer cancel()
-	stream, err := c.host.NewStream(streamOpenCtx, to, c.protocolID)
+	stream, err := c.host.NewStream(streamOpenCtx, to, c.params.ProtocolIDs(protocolID)...)
 	if err != nil {
+		if protocolID == c.rowsProtocolID && errors.Is(err, msmux.ErrNotSupported[protocol.ID]{}) {
+			return nil, ErrRowsNotSupported
+		}
 		return nil, fmt.Errorf("failed to open stream: %w", err)
 	}
+	stream = p2p.CompressStream(stream, protocolID, c.metrics)
 
 	c.setStreamDeadlines(ctx, stream)
 
-	req := &pb.EDSRequest{Hash: dataHash}
-
-	// request ODS
-	log.Debugw("client: requesting ods", "hash", dataHash.String(), "peer", to.String())
 	_, err = serde.Write(stream, req)
 	if err != nil {
 		stream.Reset() //nolint:errcheck
@@ -127,16 +222,13 @@ func (c *Client) doRequest(
 	case pb.Status_OK:
 		// reset stream deadlines to original values, since read deadline was changed during status read
 		c.setStreamDeadlines(ctx, stream)
-		// use header and ODS bytes to construct EDS and verify it against dataHash
-		eds, err := eds.ReadEDS(ctx, stream, dataHash)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read eds from ods bytes: %w", err)
-		}
-		c.metrics.ObserveRequests(ctx, 1, p2p.StatusSuccess)
-		return eds, nil
+		return stream, nil
 	case pb.Status_NOT_FOUND:
 		c.metrics.ObserveRequests(ctx, 1, p2p.StatusNotFound)
 		return nil, p2p.ErrNotFound
+	case pb.Status_THROTTLED:
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusRateLimited)
+		return nil, p2p.ErrRateLimited
 	case pb.Status_INVALID:
 		log.Debug("client: invalid request")
 		fallthrough
@@ -19,6 +19,7 @@ import (
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 	"github.com/celestiaorg/celestia-node/share/p2p"
+	p2p_pb "github.com/celestiaorg/celestia-node/share/p2p/shrexeds/pb"
 )
 
 func TestExchange_RequestEDS(t *testing.T) {
@@ -91,6 +92,56 @@ func TestExchange_RequestEDS(t *testing.T) {
 		require.ErrorIs(t, err, p2p.ErrNotFound)
 	})
 
+	t.Run("Rows_Available", func(t *testing.T) {
+		eds := edstest.RandEDS(t, 4)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+		err = store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		// ODS rows and parity rows
+		for _, rng := range [][2]int{{0, 4}, {1, 3}, {5, 8}} {
+			rows, err := client.RequestRows(ctx, &dah, rng[0], rng[1], server.host.ID())
+			require.NoError(t, err)
+			require.Len(t, rows, rng[1]-rng[0])
+			for i, row := range rows {
+				assert.Equal(t, eds.Row(uint(rng[0]+i)), row)
+			}
+		}
+
+		_, err = client.RequestRows(ctx, &dah, 4, 9, server.host.ID())
+		assert.Error(t, err)
+	})
+
+	t.Run("Rows_err_not_found", func(t *testing.T) {
+		eds := edstest.RandEDS(t, 4)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+		_, err = client.RequestRows(ctx, &dah, 0, 4, server.host.ID())
+		require.ErrorIs(t, err, p2p.ErrNotFound)
+	})
+
+	// Testcase: Peer rate limit reached
+	t.Run("EDS_throttled", func(t *testing.T) {
+		eds := edstest.RandEDS(t, 4)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+		err = store.Put(ctx, dah.Hash(), eds)
+		require.NoError(t, err)
+
+		middleware := p2p.NewMiddleware(10,
+			p2p.WithPeerLimits(0.01, 1, 0),
+			p2p.WithThrottledHandler(server.handleThrottled),
+		)
+		server.host.SetStreamHandler(server.protocolID, middleware.RateLimitHandler(server.handleStream))
+
+		_, err = client.RequestEDS(ctx, dah.Hash(), server.host.ID())
+		require.NoError(t, err)
+		_, err = client.RequestEDS(ctx, dah.Hash(), server.host.ID())
+		require.ErrorIs(t, err, p2p.ErrRateLimited)
+		require.EqualValues(t, 1, middleware.DrainCounter())
+	})
+
 	// Testcase: Concurrency limit reached
 	t.Run("EDS_concurrency_limit", func(t *testing.T) {
 		store, client, server := makeExchange(t)
@@ -134,6 +185,83 @@ func TestExchange_RequestEDS(t *testing.T) {
 	})
 }
 
+func TestExchange_RequestEDS_Compressed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	store := newStore(t)
+	hosts := createMocknet(t, 2)
+	params := DefaultParameters()
+	params.Compression = []p2p.Compression{p2p.CompressionZstd}
+	client, err := NewClient(params, hosts[0])
+	require.NoError(t, err)
+	server, err := NewServer(params, hosts[1], store)
+	require.NoError(t, err)
+	require.NoError(t, store.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	eds := edstest.RandEDS(t, 4)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, dah.Hash(), eds))
+
+	requestedEDS, err := client.RequestEDS(ctx, dah.Hash(), server.host.ID())
+	require.NoError(t, err)
+	require.Equal(t, eds.Flattened(), requestedEDS.Flattened())
+
+	rows, err := client.RequestRows(ctx, &dah, 1, 3, server.host.ID())
+	require.NoError(t, err)
+	require.Equal(t, eds.Row(1), rows[0])
+	require.Equal(t, eds.Row(2), rows[1])
+
+	_, err = client.RequestEDS(ctx, edstest.RandEDS(t, 4).Flattened()[0][:32], server.host.ID())
+	require.ErrorIs(t, err, p2p.ErrNotFound)
+}
+
+func TestExchange_RequestRows_Legacy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	store, client, server := makeExchange(t)
+	require.NoError(t, store.Start(ctx))
+
+	// a peer not supporting requests of rows ignores the range and streams the whole ODS
+	server.host.SetStreamHandler(server.protocolID, func(stream network.Stream) {
+		logger := log.With("peer", stream.Conn().RemotePeer().String())
+		req, err := server.readRequest(logger, stream)
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			return
+		}
+		edsReader, err := store.GetCAR(ctx, req.Hash)
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			return
+		}
+		err = server.writeStatus(logger, p2p_pb.Status_OK, stream)
+		if err == nil {
+			err = server.writeODS(logger, edsReader, stream)
+		}
+		if err != nil {
+			stream.Reset() //nolint:errcheck
+			return
+		}
+		stream.Close() //nolint:errcheck
+	})
+
+	eds := edstest.RandEDS(t, 4)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, dah.Hash(), eds))
+
+	_, err = client.RequestRows(ctx, &dah, 0, 4, server.host.ID())
+	require.ErrorIs(t, err, ErrRowsNotSupported)
+
+	requestedEDS, err := client.RequestEDS(ctx, dah.Hash(), server.host.ID())
+	require.NoError(t, err)
+	require.Equal(t, eds.Flattened(), requestedEDS.Flattened())
+}
+
 func newStore(t *testing.T) *eds.Store {
 	t.Helper()
 
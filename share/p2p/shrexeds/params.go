@@ -2,13 +2,22 @@ package shrexeds
 
 import (
 	"fmt"
+	"strings"
 
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/protocol"
 
 	"github.com/celestiaorg/celestia-node/share/p2p"
 )
 
-const protocolString = "/shrex/eds/v0.0.1"
+const (
+	// protocolString is the protocol of the requests of the whole ODS.
+	protocolString = "/shrex/eds/v0.0.1"
+	// rowsProtocolString is the protocol of the requests of the whole ODS or of a range of rows.
+	// Peers not supporting it yet would ignore the range and respond with the whole ODS, so ranges
+	// are only requested over it.
+	rowsProtocolString = "/shrex/eds/v0.0.2"
+)
 
 var log = logging.Logger("shrex/eds")
 
@@ -35,6 +44,12 @@ func (p *Parameters) Validate() error {
 	return p.Parameters.Validate()
 }
 
+// isProtocol reports whether the stream protocol is the base protocol or one of its compressed
+// variants.
+func isProtocol(id, base protocol.ID) bool {
+	return id == base || strings.HasPrefix(string(id), string(base)+"/")
+}
+
 func (c *Client) WithMetrics() error {
 	metrics, err := p2p.InitClientMetrics("eds")
 	if err != nil {
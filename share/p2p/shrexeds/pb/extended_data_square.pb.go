@@ -29,6 +29,7 @@ const (
 	Status_OK        Status = 1
 	Status_NOT_FOUND Status = 2
 	Status_INTERNAL  Status = 3
+	Status_THROTTLED Status = 4
 )
 
 var Status_name = map[int32]string{
@@ -36,6 +37,7 @@ var Status_name = map[int32]string{
 	1: "OK",
 	2: "NOT_FOUND",
 	3: "INTERNAL",
+	4: "THROTTLED",
 }
 
 var Status_value = map[string]int32{
@@ -43,6 +45,7 @@ var Status_value = map[string]int32{
 	"OK":        1,
 	"NOT_FOUND": 2,
 	"INTERNAL":  3,
+	"THROTTLED": 4,
 }
 
 func (x Status) String() string {
@@ -54,7 +57,11 @@ func (Status) EnumDescriptor() ([]byte, []int) {
 }
 
 type EDSRequest struct {
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Hash     []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// row_start and row_end request the left halves of the EDS rows [row_start, row_end) instead of
+	// the ODS. The ODS is requested if row_end is zero.
+	RowStart uint32 `protobuf:"varint,2,opt,name=row_start,json=rowStart,proto3" json:"row_start,omitempty"`
+	RowEnd   uint32 `protobuf:"varint,3,opt,name=row_end,json=rowEnd,proto3" json:"row_end,omitempty"`
 }
 
 func (m *EDSRequest) Reset()         { *m = EDSRequest{} }
@@ -97,6 +104,20 @@ func (m *EDSRequest) GetHash() []byte {
 	return nil
 }
 
+func (m *EDSRequest) GetRowStart() uint32 {
+	if m != nil {
+		return m.RowStart
+	}
+	return 0
+}
+
+func (m *EDSRequest) GetRowEnd() uint32 {
+	if m != nil {
+		return m.RowEnd
+	}
+	return 0
+}
+
 type EDSResponse struct {
 	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=Status" json:"status,omitempty"`
 }
@@ -152,22 +173,25 @@ func init() {
 }
 
 var fileDescriptor_49d42aa96098056e = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x28, 0xce, 0x48, 0x2c,
-	0x4a, 0xd5, 0x2f, 0x30, 0x2a, 0xd0, 0x2f, 0xce, 0x28, 0x4a, 0xad, 0x48, 0x4d, 0x29, 0xd6, 0x2f,
-	0x48, 0xd2, 0x4f, 0xad, 0x28, 0x49, 0xcd, 0x4b, 0x49, 0x4d, 0x89, 0x4f, 0x49, 0x2c, 0x49, 0x8c,
-	0x2f, 0x2e, 0x2c, 0x4d, 0x2c, 0x4a, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x57, 0x52, 0xe0, 0xe2,
-	0x72, 0x75, 0x09, 0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0x12, 0xe2, 0x62, 0xc9, 0x48,
-	0x2c, 0xce, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x02, 0xb3, 0x95, 0xf4, 0xb8, 0xb8, 0xc1,
-	0x2a, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0xe4, 0xb9, 0xd8, 0x8a, 0x4b, 0x12, 0x4b, 0x4a,
-	0x8b, 0xc1, 0x8a, 0xf8, 0x8c, 0xd8, 0xf5, 0x82, 0xc1, 0xdc, 0x20, 0xa8, 0xb0, 0x96, 0x15, 0x17,
-	0x1b, 0x44, 0x44, 0x88, 0x9b, 0x8b, 0xdd, 0xd3, 0x2f, 0xcc, 0xd1, 0xc7, 0xd3, 0x45, 0x80, 0x41,
-	0x88, 0x8d, 0x8b, 0xc9, 0xdf, 0x5b, 0x80, 0x51, 0x88, 0x97, 0x8b, 0xd3, 0xcf, 0x3f, 0x24, 0xde,
-	0xcd, 0x3f, 0xd4, 0xcf, 0x45, 0x80, 0x49, 0x88, 0x87, 0x8b, 0xc3, 0xd3, 0x2f, 0xc4, 0x35, 0xc8,
-	0xcf, 0xd1, 0x47, 0x80, 0xd9, 0x49, 0xe2, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0x92, 0xd8, 0xc0, 0xce, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x1d, 0xd4, 0xa7, 0xe2,
-	0x00, 0x00, 0x00,
+	// 276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x2c, 0x8f, 0xc1, 0x6a, 0xf2, 0x40,
+	0x14, 0x46, 0x33, 0x2a, 0x51, 0xaf, 0xfa, 0x13, 0x66, 0xf3, 0x07, 0x0a, 0x53, 0x71, 0x25, 0x5d,
+	0x98, 0x62, 0x9f, 0xc0, 0x92, 0x94, 0x86, 0x4a, 0x02, 0x93, 0xd4, 0x6d, 0x18, 0x99, 0x81, 0xac,
+	0x32, 0x71, 0x66, 0x42, 0x7c, 0x8c, 0x3e, 0x56, 0x97, 0x2e, 0xbb, 0x2c, 0xc9, 0x8b, 0x94, 0x4c,
+	0xbb, 0xbb, 0xdf, 0x39, 0x70, 0xe0, 0xc2, 0xa3, 0x2e, 0x99, 0x12, 0x41, 0xbd, 0xaf, 0x03, 0x5d,
+	0x2a, 0x71, 0x15, 0x5c, 0x07, 0xf5, 0x39, 0x10, 0x57, 0x23, 0x2a, 0x2e, 0x78, 0xc1, 0x99, 0x61,
+	0x85, 0xbe, 0x34, 0x4c, 0x89, 0x5d, 0xad, 0xa4, 0x91, 0x9b, 0x13, 0x40, 0x14, 0x66, 0x54, 0x5c,
+	0x1a, 0xa1, 0x0d, 0xc6, 0x30, 0x29, 0x99, 0x2e, 0x7d, 0xb4, 0x46, 0xdb, 0x25, 0xb5, 0x37, 0xbe,
+	0x83, 0xb9, 0x92, 0x6d, 0xa1, 0x0d, 0x53, 0xc6, 0x1f, 0xad, 0xd1, 0x76, 0x45, 0x67, 0x4a, 0xb6,
+	0xd9, 0xb0, 0xf1, 0x7f, 0x98, 0x0e, 0x52, 0x54, 0xdc, 0x1f, 0x5b, 0xe5, 0x2a, 0xd9, 0x46, 0x15,
+	0xdf, 0xec, 0x60, 0x61, 0xbb, 0xba, 0x96, 0x95, 0x16, 0xf8, 0x1e, 0x5c, 0x6d, 0x98, 0x69, 0xb4,
+	0x4d, 0xff, 0xdb, 0x4f, 0x77, 0x99, 0x9d, 0xf4, 0x0f, 0x3f, 0xc4, 0xe0, 0xfe, 0x12, 0xbc, 0x80,
+	0x69, 0x9c, 0x9c, 0x0e, 0xc7, 0x38, 0xf4, 0x1c, 0xec, 0xc2, 0x28, 0x7d, 0xf3, 0x10, 0x5e, 0xc1,
+	0x3c, 0x49, 0xf3, 0xe2, 0x25, 0x7d, 0x4f, 0x42, 0x6f, 0x84, 0x97, 0x30, 0x8b, 0x93, 0x3c, 0xa2,
+	0xc9, 0xe1, 0xe8, 0x8d, 0x07, 0x99, 0xbf, 0xd2, 0x34, 0xcf, 0x8f, 0x51, 0xe8, 0x4d, 0x9e, 0xfd,
+	0xcf, 0x8e, 0xa0, 0x5b, 0x47, 0xd0, 0x77, 0x47, 0xd0, 0x47, 0x4f, 0x9c, 0x5b, 0x4f, 0x9c, 0xaf,
+	0x9e, 0x38, 0x67, 0xd7, 0xfe, 0xfc, 0xf4, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xab, 0x7e, 0x94,
+	0x27, 0x01, 0x00, 0x00,
 }
 
 func (m *EDSRequest) Marshal() (dAtA []byte, err error) {
@@ -190,6 +214,16 @@ func (m *EDSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RowEnd != 0 {
+		i = encodeVarintExtendedDataSquare(dAtA, i, uint64(m.RowEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RowStart != 0 {
+		i = encodeVarintExtendedDataSquare(dAtA, i, uint64(m.RowStart))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -249,6 +283,12 @@ func (m *EDSRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovExtendedDataSquare(uint64(l))
 	}
+	if m.RowStart != 0 {
+		n += 1 + sovExtendedDataSquare(uint64(m.RowStart))
+	}
+	if m.RowEnd != 0 {
+		n += 1 + sovExtendedDataSquare(uint64(m.RowEnd))
+	}
 	return n
 }
 
@@ -333,6 +373,44 @@ func (m *EDSRequest) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowStart", wireType)
+			}
+			m.RowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedDataSquare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowEnd", wireType)
+			}
+			m.RowEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtendedDataSquare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExtendedDataSquare(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package shrexeds
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/celestiaorg/go-libp2p-messenger/serde"
+	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
@@ -25,10 +27,11 @@ type Server struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	host       host.Host
-	protocolID protocol.ID
+	host           host.Host
+	protocolID     protocol.ID
+	rowsProtocolID protocol.ID
 
-	store *eds.Store
+	store eds.EDSStore
 
 	params     *Parameters
 	middleware *p2p.Middleware
@@ -36,32 +39,57 @@ type Server struct {
 }
 
 // NewServer creates a new ShrEx/EDS server.
-func NewServer(params *Parameters, host host.Host, store *eds.Store) (*Server, error) {
+func NewServer(params *Parameters, host host.Host, store eds.EDSStore) (*Server, error) {
 	if err := params.Validate(); err != nil {
 		return nil, fmt.Errorf("shrex-eds: server creation failed: %w", err)
 	}
 
-	return &Server{
-		host:       host,
-		store:      store,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
-		params:     params,
-		middleware: p2p.NewMiddleware(params.ConcurrencyLimit),
-	}, nil
+	srv := &Server{
+		host:           host,
+		store:          store,
+		protocolID:     p2p.ProtocolID(params.NetworkID(), protocolString),
+		rowsProtocolID: p2p.ProtocolID(params.NetworkID(), rowsProtocolString),
+		params:         params,
+	}
+	srv.middleware = p2p.NewMiddleware(params.ConcurrencyLimit, append(params.MiddlewareOptions(),
+		p2p.WithExemptPeers(p2p.IsProtected(host)),
+		p2p.WithThrottledHandler(srv.handleThrottled),
+	)...)
+	return srv, nil
 }
 
 func (s *Server) Start(context.Context) error {
 	s.ctx, s.cancel = context.WithCancel(context.Background())
-	s.host.SetStreamHandler(s.protocolID, s.middleware.RateLimitHandler(s.handleStream))
+	handler := s.middleware.RateLimitHandler(s.handleStream)
+	for _, id := range s.protocolIDs() {
+		s.host.SetStreamHandler(id, handler)
+	}
 	return nil
 }
 
 func (s *Server) Stop(context.Context) error {
 	defer s.cancel()
-	s.host.RemoveStreamHandler(s.protocolID)
+	for _, id := range s.protocolIDs() {
+		s.host.RemoveStreamHandler(id)
+	}
 	return nil
 }
 
+// protocolIDs returns the IDs of both the rows and the ODS only protocols, so that the peers not
+// supporting requests of rows yet are served as well.
+func (s *Server) protocolIDs() []protocol.ID {
+	return append(s.params.ProtocolIDs(s.rowsProtocolID), s.params.ProtocolIDs(s.protocolID)...)
+}
+
+// compressStream wraps the stream according to the negotiated protocol, reporting whether it
+// allows requests of rows.
+func (s *Server) compressStream(stream network.Stream) (network.Stream, bool) {
+	if isProtocol(stream.Protocol(), s.rowsProtocolID) {
+		return p2p.CompressStream(stream, s.rowsProtocolID, s.metrics), true
+	}
+	return p2p.CompressStream(stream, s.protocolID, s.metrics), false
+}
+
 func (s *Server) observeRateLimitedRequests() {
 	numRateLimited := s.middleware.DrainCounter()
 	if numRateLimited > 0 {
@@ -70,6 +98,7 @@ func (s *Server) observeRateLimitedRequests() {
 }
 
 func (s *Server) handleStream(stream network.Stream) {
+	stream, rowsSupported := s.compressStream(stream)
 	logger := log.With("peer", stream.Conn().RemotePeer().String())
 	logger.Debug("server: handling eds request")
 
@@ -96,6 +125,27 @@ func (s *Server) handleStream(stream network.Stream) {
 	ctx, cancel := context.WithTimeout(s.ctx, s.params.HandleRequestTimeout)
 	defer cancel()
 
+	if req.RowEnd != 0 {
+		if !rowsSupported {
+			// the client is expected to request rows only over the rows protocol
+			logger.Warnw("server: rows requested over the ODS only protocol")
+			s.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
+			err = s.writeStatus(logger, p2p_pb.Status_INVALID, stream)
+			if err != nil {
+				logger.Warnw("server: writing status to stream", "err", err)
+				stream.Reset() //nolint:errcheck
+				return
+			}
+			err = stream.Close()
+			if err != nil {
+				logger.Debugw("server: closing stream", "err", err)
+			}
+			return
+		}
+		s.handleRowsRequest(ctx, logger, stream, hash, int(req.RowStart), int(req.RowEnd))
+		return
+	}
+
 	// determine whether the EDS is available in our store
 	// we do not close the reader, so that other requests will not need to re-open the file.
 	// closing is handled by the LRU cache.
@@ -142,6 +192,76 @@ func (s *Server) handleStream(stream network.Stream) {
 	}
 }
 
+// handleRowsRequest serves the left halves of the requested EDS rows.
+func (s *Server) handleRowsRequest(
+	ctx context.Context,
+	logger *zap.SugaredLogger,
+	stream network.Stream,
+	hash share.DataHash,
+	from, to int,
+) {
+	logger = logger.With("from", from, "to", to)
+
+	square, err := s.store.Get(ctx, hash)
+	status := p2p_pb.Status_OK
+	switch {
+	case errors.Is(err, eds.ErrNotFound):
+		logger.Warnw("server: request hash not found")
+		s.metrics.ObserveRequests(ctx, 1, p2p.StatusNotFound)
+		status = p2p_pb.Status_NOT_FOUND
+	case err != nil:
+		logger.Errorw("server: get EDS", "err", err)
+		status = p2p_pb.Status_INTERNAL
+	case from >= to || to > int(square.Width()):
+		logger.Warnw("server: invalid rows range", "width", square.Width())
+		s.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
+		status = p2p_pb.Status_INVALID
+	}
+
+	err = s.writeStatus(logger, status, stream)
+	if err != nil {
+		logger.Warnw("server: writing status to stream", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+	if status != p2p_pb.Status_OK {
+		err = stream.Close()
+		if err != nil {
+			logger.Debugw("server: closing stream", "err", err)
+		}
+		return
+	}
+
+	err = s.writeRows(logger, square, from, to, stream)
+	if err != nil {
+		logger.Warnw("server: writing rows to stream", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+
+	s.metrics.ObserveRequests(ctx, 1, p2p.StatusSuccess)
+	err = stream.Close()
+	if err != nil {
+		logger.Debugw("server: closing stream", "err", err)
+	}
+}
+
+// handleThrottled informs the client that its request was rate limited.
+func (s *Server) handleThrottled(stream network.Stream) {
+	stream, _ = s.compressStream(stream)
+	logger := log.With("peer", stream.Conn().RemotePeer().String())
+	err := s.writeStatus(logger, p2p_pb.Status_THROTTLED, stream)
+	if err != nil {
+		logger.Debugw("server: writing throttled status to stream", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+	err = stream.Close()
+	if err != nil {
+		logger.Debugw("server: closing stream", "err", err)
+	}
+}
+
 func (s *Server) readRequest(logger *zap.SugaredLogger, stream network.Stream) (*p2p_pb.EDSRequest, error) {
 	err := stream.SetReadDeadline(time.Now().Add(s.params.ServerReadTimeout))
 	if err != nil {
@@ -190,3 +310,27 @@ func (s *Server) writeODS(logger *zap.SugaredLogger, edsReader io.Reader, stream
 
 	return nil
 }
+
+// writeRows writes the shares of the left halves of the EDS rows [from, to) to the stream.
+func (s *Server) writeRows(
+	logger *zap.SugaredLogger,
+	square *rsmt2d.ExtendedDataSquare,
+	from, to int,
+	stream network.Stream,
+) error {
+	err := stream.SetWriteDeadline(time.Now().Add(s.params.ServerWriteTimeout))
+	if err != nil {
+		logger.Debugw("server: set write deadline", "err", err)
+	}
+
+	odsWidth := square.Width() / 2
+	buf := bufio.NewWriterSize(stream, int(s.params.BufferSize))
+	for row := from; row < to; row++ {
+		for _, sh := range square.Row(uint(row))[:odsWidth] {
+			if _, err = buf.Write(sh); err != nil {
+				return fmt.Errorf("writing row %d: %w", row, err)
+			}
+		}
+	}
+	return buf.Flush()
+}
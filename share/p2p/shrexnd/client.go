@@ -17,6 +17,7 @@ import (
 	"github.com/celestiaorg/nmt"
 
 	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/ipld"
 	"github.com/celestiaorg/celestia-node/share/p2p"
 	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexnd/pb"
 )
@@ -24,8 +25,9 @@ import (
 // Client implements client side of shrex/nd protocol to obtain namespaced shares data from remote
 // peers.
 type Client struct {
-	params     *Parameters
-	protocolID protocol.ID
+	params           *Parameters
+	protocolID       protocol.ID
+	legacyProtocolID protocol.ID
 
 	host    host.Host
 	metrics *p2p.Metrics
@@ -38,9 +40,10 @@ func NewClient(params *Parameters, host host.Host) (*Client, error) {
 	}
 
 	return &Client{
-		host:       host,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
-		params:     params,
+		host:             host,
+		protocolID:       p2p.ProtocolID(params.NetworkID(), protocolString),
+		legacyProtocolID: p2p.ProtocolID(params.NetworkID(), legacyProtocolString),
+		params:           params,
 	}, nil
 }
 
@@ -56,13 +59,186 @@ func (c *Client) RequestND(
 		return nil, err
 	}
 
-	shares, err := c.doRequest(ctx, root, namespace, peer)
-	if err == nil {
-		return shares, nil
+	req := &pb.GetSharesByNamespaceRequest{
+		RootHash:  root.Hash(),
+		Namespace: namespace,
+	}
+	var shares share.NamespacedShares
+	put := func(_, _ int, nr share.NamespacedRow) error {
+		shares = append(shares, nr)
+		return nil
+	}
+	stream, legacy, err := c.openStream(ctx, peer)
+	if err != nil {
+		return nil, c.handleErr(ctx, err)
+	}
+	if err = c.doRequest(ctx, stream, legacy, req, 1, nil, put); err != nil {
+		return nil, c.handleErr(ctx, err)
+	}
+	return shares, nil
+}
+
+// RequestNDRows requests the rows within the given namespace and the row range [fromRow, toRow)
+// from the given peer. Zero toRow means the end of the square. Rows are passed to put with
+// unverified inclusion proofs against the share.Root as soon as each of them arrives. An error
+// returned by put aborts the request and is returned as is.
+func (c *Client) RequestNDRows(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+	fromRow, toRow int,
+	peer peer.ID,
+	put func(row int, nr share.NamespacedRow) error,
+) error {
+	if err := namespace.ValidateForData(); err != nil {
+		return err
+	}
+	if fromRow < 0 || toRow < 0 || (toRow != 0 && toRow <= fromRow) {
+		return fmt.Errorf("client-nd: invalid row range: [%d, %d)", fromRow, toRow)
+	}
+
+	// rows are expected in the order of the roots containing the namespace
+	rows := ipld.FilterRowsByNamespace(root, namespace, fromRow, toRow)
+	if len(rows) == 0 {
+		return nil
+	}
+	stream, legacy, err := c.openStream(ctx, peer)
+	if err != nil {
+		return c.handleErr(ctx, err)
+	}
+
+	req := &pb.GetSharesByNamespaceRequest{
+		RootHash:  root.Hash(),
+		Namespace: namespace,
+		FromRow:   uint32(fromRow),
+		ToRow:     uint32(toRow),
+	}
+	var putErr error
+	putRow := func(_, row int, nr share.NamespacedRow) error {
+		putErr = put(row, nr)
+		return putErr
+	}
+	if legacy {
+		// legacy servers respond with all the rows of the namespace, so the rows out of the range
+		// are dropped
+		req.FromRow, req.ToRow = 0, 0
+		rows = ipld.FilterRowsByNamespace(root, namespace, 0, 0)
+		putRow = func(_, row int, nr share.NamespacedRow) error {
+			if row < fromRow || (toRow != 0 && row >= toRow) {
+				return nil
+			}
+			putErr = put(row, nr)
+			return putErr
+		}
+	}
+	err = c.doRequest(ctx, stream, legacy, req, 1, rows, putRow)
+	if err != nil && putErr != nil {
+		return putErr
+	}
+	if err != nil {
+		return c.handleErr(ctx, err)
+	}
+	return nil
+}
+
+// RequestNDBatch requests namespaced data of several namespaces at once from the given peer.
+// Returns NamespacedShares with unverified inclusion proofs against the share.Root, ordered as the
+// given namespaces.
+func (c *Client) RequestNDBatch(
+	ctx context.Context,
+	root *share.Root,
+	namespaces []share.Namespace,
+	peer peer.ID,
+) ([]share.NamespacedShares, error) {
+	if len(namespaces) == 0 {
+		return nil, errors.New("client-nd: no namespaces requested")
+	}
+	if len(namespaces) > MaxNamespacesPerRequest {
+		return nil, fmt.Errorf("client-nd: too many namespaces: %d > %d",
+			len(namespaces), MaxNamespacesPerRequest)
+	}
+
+	req := &pb.GetSharesByNamespaceRequest{
+		RootHash:   root.Hash(),
+		Namespaces: make([][]byte, len(namespaces)),
+	}
+	for i, namespace := range namespaces {
+		if err := namespace.ValidateForData(); err != nil {
+			return nil, err
+		}
+		req.Namespaces[i] = namespace
+	}
+
+	stream, legacy, err := c.openStream(ctx, peer)
+	if err != nil {
+		return nil, c.handleErr(ctx, err)
+	}
+	if legacy {
+		return c.requestNDOneByOne(ctx, stream, root, namespaces, peer)
 	}
+
+	shares := make([]share.NamespacedShares, len(namespaces))
+	put := func(idx, _ int, nr share.NamespacedRow) error {
+		shares[idx] = append(shares[idx], nr)
+		return nil
+	}
+	if err = c.doRequest(ctx, stream, false, req, len(namespaces), nil, put); err != nil {
+		return nil, c.handleErr(ctx, err)
+	}
+	return shares, nil
+}
+
+// requestNDOneByOne requests the namespaces from the legacy server one by one, as it serves a
+// single namespace per request. The first one is requested over the already opened stream.
+func (c *Client) requestNDOneByOne(
+	ctx context.Context,
+	stream network.Stream,
+	root *share.Root,
+	namespaces []share.Namespace,
+	peer peer.ID,
+) ([]share.NamespacedShares, error) {
+	shares := make([]share.NamespacedShares, len(namespaces))
+	req := &pb.GetSharesByNamespaceRequest{
+		RootHash:  root.Hash(),
+		Namespace: namespaces[0],
+	}
+	put := func(_, _ int, nr share.NamespacedRow) error {
+		shares[0] = append(shares[0], nr)
+		return nil
+	}
+	if err := c.doRequest(ctx, stream, true, req, 1, nil, put); err != nil {
+		return nil, c.handleErr(ctx, err)
+	}
+
+	for i := 1; i < len(namespaces); i++ {
+		nsShares, err := c.RequestND(ctx, root, namespaces[i], peer)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = nsShares
+	}
+	return shares, nil
+}
+
+// openStream opens the stream to the peer, falling back to the legacy protocol if the peer does
+// not support the current one. It reports whether the legacy protocol was negotiated.
+func (c *Client) openStream(ctx context.Context, peerID peer.ID) (network.Stream, bool, error) {
+	ids := append(c.params.ProtocolIDs(c.protocolID), c.params.ProtocolIDs(c.legacyProtocolID)...)
+	stream, err := c.host.NewStream(ctx, peerID, ids...)
+	if err != nil {
+		return nil, false, err
+	}
+	base, legacy := c.protocolID, isProtocol(stream.Protocol(), c.legacyProtocolID)
+	if legacy {
+		base = c.legacyProtocolID
+	}
+	return p2p.CompressStream(stream, base, c.metrics), legacy, nil
+}
+
+func (c *Client) handleErr(ctx context.Context, err error) error {
 	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
 		c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
-		return nil, err
+		return err
 	}
 	// some net.Errors also mean the context deadline was exceeded, but yamux/mocknet do not
 	// unwrap to a ctx err
@@ -70,39 +246,35 @@ func (c *Client) RequestND(
 	if errors.As(err, &ne) && ne.Timeout() {
 		if deadline, _ := ctx.Deadline(); deadline.Before(time.Now()) {
 			c.metrics.ObserveRequests(ctx, 1, p2p.StatusTimeout)
-			return nil, context.DeadlineExceeded
+			return context.DeadlineExceeded
 		}
 	}
 	if err != p2p.ErrNotFound && err != p2p.ErrRateLimited {
 		log.Warnw("client-nd: peer returned err", "err", err)
 	}
-	return nil, err
+	return err
 }
 
+// doRequest sends the request over the stream and passes the received rows to put. Streams of the
+// legacy protocol carry no row indexes.
 func (c *Client) doRequest(
 	ctx context.Context,
-	root *share.Root,
-	namespace share.Namespace,
-	peerID peer.ID,
-) (share.NamespacedShares, error) {
-	stream, err := c.host.NewStream(ctx, peerID, c.protocolID)
-	if err != nil {
-		return nil, err
-	}
+	stream network.Stream,
+	legacy bool,
+	req *pb.GetSharesByNamespaceRequest,
+	amount int,
+	rows []int,
+	put func(nsIdx, row int, nr share.NamespacedRow) error,
+) error {
 	defer stream.Close()
 
 	c.setStreamDeadlines(ctx, stream)
 
-	req := &pb.GetSharesByNamespaceRequest{
-		RootHash:  root.Hash(),
-		Namespace: namespace,
-	}
-
-	_, err = serde.Write(stream, req)
+	_, err := serde.Write(stream, req)
 	if err != nil {
 		c.metrics.ObserveRequests(ctx, 1, p2p.StatusSendReqErr)
 		stream.Reset() //nolint:errcheck
-		return nil, fmt.Errorf("client-nd: writing request: %w", err)
+		return fmt.Errorf("client-nd: writing request: %w", err)
 	}
 
 	err = stream.CloseWrite()
@@ -111,9 +283,9 @@ func (c *Client) doRequest(
 	}
 
 	if err := c.readStatus(ctx, stream); err != nil {
-		return nil, err
+		return err
 	}
-	return c.readNamespacedShares(ctx, stream)
+	return c.readNamespacedRows(ctx, stream, legacy, amount, rows, put)
 }
 
 func (c *Client) readStatus(ctx context.Context, stream network.Stream) error {
@@ -133,22 +305,42 @@ func (c *Client) readStatus(ctx context.Context, stream network.Stream) error {
 	return c.convertStatusToErr(ctx, resp.Status)
 }
 
-// readNamespacedShares converts proto Rows to share.NamespacedShares
-func (c *Client) readNamespacedShares(
+// readNamespacedRows converts proto Rows to share.NamespacedRow and passes them to put together
+// with the index of the requested namespace and the row index, as soon as each of them arrives.
+// If the expected rows are given, rows are checked to arrive in their order, or assumed to for the
+// legacy protocol, which does not send the row indexes. Otherwise, the row index given by the
+// server is trusted.
+func (c *Client) readNamespacedRows(
 	ctx context.Context,
 	stream network.Stream,
-) (share.NamespacedShares, error) {
-	var shares share.NamespacedShares
-	for {
+	legacy bool,
+	amount int,
+	rows []int,
+	put func(nsIdx, row int, nr share.NamespacedRow) error,
+) error {
+	for received := 0; ; received++ {
 		var row pb.NamespaceRowResponse
 		_, err := serde.Read(stream, &row)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				// all data is received and steam is closed by server
-				return shares, nil
+				return nil
 			}
 			c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
-			return nil, err
+			return err
+		}
+		if int(row.NamespaceIndex) >= amount {
+			c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
+			return fmt.Errorf("%w: namespace index %d out of range %d",
+				p2p.ErrInvalidResponse, row.NamespaceIndex, amount)
+		}
+		rowIdx := int(row.RowIndex)
+		if rows != nil {
+			if received >= len(rows) || (!legacy && rowIdx != rows[received]) {
+				c.metrics.ObserveRequests(ctx, 1, p2p.StatusReadRespErr)
+				return fmt.Errorf("%w: unexpected row %d", p2p.ErrInvalidResponse, rowIdx)
+			}
+			rowIdx = rows[received]
 		}
 		var proof nmt.Proof
 		if row.Proof != nil {
@@ -169,10 +361,14 @@ func (c *Client) readNamespacedShares(
 				)
 			}
 		}
-		shares = append(shares, share.NamespacedRow{
+		nr := share.NamespacedRow{
 			Shares: row.Shares,
 			Proof:  &proof,
-		})
+		}
+		if err = put(int(row.NamespaceIndex), rowIdx, nr); err != nil {
+			stream.Reset() //nolint:errcheck
+			return err
+		}
 	}
 }
 
@@ -212,6 +408,9 @@ func (c *Client) convertStatusToErr(ctx context.Context, status pb.StatusCode) e
 	case pb.StatusCode_NOT_FOUND:
 		c.metrics.ObserveRequests(ctx, 1, p2p.StatusNotFound)
 		return p2p.ErrNotFound
+	case pb.StatusCode_THROTTLED:
+		c.metrics.ObserveRequests(ctx, 1, p2p.StatusRateLimited)
+		return p2p.ErrRateLimited
 	case pb.StatusCode_INVALID:
 		log.Warn("client-nd: invalid request")
 		fallthrough
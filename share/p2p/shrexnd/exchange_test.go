@@ -2,6 +2,8 @@ package shrexnd
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -14,12 +16,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
 	"github.com/celestiaorg/rsmt2d"
 
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
 	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 	"github.com/celestiaorg/celestia-node/share/p2p"
+	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexnd/pb"
 	"github.com/celestiaorg/celestia-node/share/sharetest"
 )
 
@@ -56,6 +60,197 @@ func TestExchange_RequestND_NotFound(t *testing.T) {
 	})
 }
 
+func TestExchange_RequestNDBatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	store := newStore(t)
+	edsStore, client, server := makeExchangeWithStore(t, store, storeGetter{store: store})
+	require.NoError(t, edsStore.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	eds := edstest.RandEDS(t, 4)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+
+	namespaces := []share.Namespace{
+		dah.RowRoots[0][:share.NamespaceSize],
+		dah.RowRoots[(len(dah.RowRoots)-1)/2][:share.NamespaceSize],
+		sharetest.RandV0Namespace(),
+	}
+	got, err := client.RequestNDBatch(ctx, &dah, namespaces, server.host.ID())
+	require.NoError(t, err)
+	require.Len(t, got, len(namespaces))
+	for i, namespace := range namespaces {
+		require.NoError(t, got[i].Verify(&dah, namespace))
+
+		single, err := client.RequestND(ctx, &dah, namespace, server.host.ID())
+		require.NoError(t, err)
+		require.Equal(t, single.Flatten(), got[i].Flatten())
+	}
+	require.NotEmpty(t, got[0].Flatten())
+
+	tooMany := make([]share.Namespace, MaxNamespacesPerRequest+1)
+	for i := range tooMany {
+		tooMany[i] = sharetest.RandV0Namespace()
+	}
+	_, err = client.RequestNDBatch(ctx, &dah, tooMany, server.host.ID())
+	require.Error(t, err)
+}
+
+func TestExchange_RequestNDRows(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	store := newStore(t)
+	require.NoError(t, store.Start(ctx))
+
+	// spread a single namespace over all the ODS rows
+	shares := sharetest.RandShares(t, 16)
+	namespace := share.GetNamespace(shares[0])
+	for i := range shares {
+		copy(shares[i][:share.NamespaceSize], namespace)
+	}
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares, share.DefaultRSMT2DCodec(), wrapper.NewConstructor(4))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, dah.Hash(), eds))
+
+	getters := map[string]share.Getter{
+		"streaming": rowsStoreGetter{storeGetter{store: store}},
+		"fallback":  storeGetter{store: store},
+	}
+	for name, getter := range getters {
+		t.Run(name, func(t *testing.T) {
+			_, client, server := makeExchangeWithStore(t, store, getter)
+			require.NoError(t, server.Start(ctx))
+
+			expected, err := client.RequestND(ctx, &dah, namespace, server.host.ID())
+			require.NoError(t, err)
+			require.NoError(t, expected.Verify(&dah, namespace))
+			require.Len(t, expected, 4)
+
+			var rows []int
+			err = client.RequestNDRows(ctx, &dah, namespace, 1, 3, server.host.ID(),
+				func(row int, nr share.NamespacedRow) error {
+					require.NoError(t, nr.Verify(&dah, row, namespace))
+					require.Equal(t, expected[row].Shares, nr.Shares)
+					rows = append(rows, row)
+					return nil
+				})
+			require.NoError(t, err)
+			require.Equal(t, []int{1, 2}, rows)
+
+			// an error returned by put aborts the request
+			errStop := errors.New("stop")
+			err = client.RequestNDRows(ctx, &dah, namespace, 0, 0, server.host.ID(),
+				func(int, share.NamespacedRow) error {
+					return errStop
+				})
+			require.ErrorIs(t, err, errStop)
+		})
+	}
+}
+
+func TestExchange_LegacyServer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	store := newStore(t)
+	edsStore, client, server := makeExchangeWithStore(t, store, rowsStoreGetter{storeGetter{store: store}})
+	require.NoError(t, edsStore.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+	// the server not supporting the current protocol yet serves the legacy one only
+	for _, id := range server.params.ProtocolIDs(server.protocolID) {
+		server.host.RemoveStreamHandler(id)
+	}
+
+	// spread a single namespace over all the ODS rows
+	shares := sharetest.RandShares(t, 16)
+	namespace := share.GetNamespace(shares[0])
+	for i := range shares {
+		copy(shares[i][:share.NamespaceSize], namespace)
+	}
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares, share.DefaultRSMT2DCodec(), wrapper.NewConstructor(4))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.NoError(t, edsStore.Put(ctx, dah.Hash(), eds))
+
+	namespaces := []share.Namespace{namespace, sharetest.RandV0Namespace()}
+	got, err := client.RequestNDBatch(ctx, &dah, namespaces, server.host.ID())
+	require.NoError(t, err)
+	require.Len(t, got, len(namespaces))
+	for i, namespace := range namespaces {
+		require.NoError(t, got[i].Verify(&dah, namespace))
+	}
+	require.Len(t, got[0], 4)
+
+	var rows []int
+	err = client.RequestNDRows(ctx, &dah, namespace, 1, 3, server.host.ID(),
+		func(row int, nr share.NamespacedRow) error {
+			require.NoError(t, nr.Verify(&dah, row, namespace))
+			require.Equal(t, got[0][row].Shares, nr.Shares)
+			rows = append(rows, row)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, rows)
+}
+
+func TestExchange_RequestND_Compressed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	store := newStore(t)
+	hosts := createMocknet(t, 2)
+	params := DefaultParameters()
+	params.Compression = []p2p.Compression{p2p.CompressionSnappy}
+	client, err := NewClient(params, hosts[0])
+	require.NoError(t, err)
+	server, err := NewServer(params, hosts[1], store, rowsStoreGetter{storeGetter{store: store}})
+	require.NoError(t, err)
+	require.NoError(t, store.Start(ctx))
+	require.NoError(t, server.Start(ctx))
+
+	eds := edstest.RandEDS(t, 4)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, dah.Hash(), eds))
+
+	namespace := dah.RowRoots[0][:share.NamespaceSize]
+	shares, err := client.RequestND(ctx, &dah, namespace, server.host.ID())
+	require.NoError(t, err)
+	require.NotEmpty(t, shares.Flatten())
+	require.NoError(t, shares.Verify(&dah, namespace))
+
+	root := share.Root{}
+	_, err = client.RequestND(ctx, &root, namespace, server.host.ID())
+	require.ErrorIs(t, err, p2p.ErrNotFound)
+}
+
+func TestValidateRequest(t *testing.T) {
+	namespace := sharetest.RandV0Namespace()
+	rootHash := make([]byte, sha256.Size)
+
+	require.NoError(t, validateRequest(pb.GetSharesByNamespaceRequest{
+		RootHash:  rootHash,
+		Namespace: namespace,
+	}))
+	require.NoError(t, validateRequest(pb.GetSharesByNamespaceRequest{
+		RootHash:   rootHash,
+		Namespaces: [][]byte{namespace, sharetest.RandV0Namespace()},
+	}))
+	require.Error(t, validateRequest(pb.GetSharesByNamespaceRequest{
+		RootHash:   rootHash,
+		Namespace:  namespace,
+		Namespaces: [][]byte{namespace},
+	}))
+	require.Error(t, validateRequest(pb.GetSharesByNamespaceRequest{
+		RootHash:   rootHash,
+		Namespaces: make([][]byte, MaxNamespacesPerRequest+1),
+	}))
+}
+
 func TestExchange_RequestND(t *testing.T) {
 	t.Run("ND_concurrency_limit", func(t *testing.T) {
 		net, err := mocknet.FullMeshConnected(2)
@@ -124,6 +319,53 @@ func (m notFoundGetter) GetSharesByNamespace(
 	return nil, nil
 }
 
+func (m notFoundGetter) GetSharesByNamespaces(
+	_ context.Context, _ *share.Root, namespaces []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	return make([]share.NamespacedShares, len(namespaces)), nil
+}
+
+// storeGetter serves shares by namespace straight from the eds.Store.
+type storeGetter struct {
+	notFoundGetter
+	store *eds.Store
+}
+
+func (g storeGetter) GetSharesByNamespace(
+	ctx context.Context, root *share.Root, namespace share.Namespace,
+) (share.NamespacedShares, error) {
+	return g.store.GetSharesByNamespace(ctx, root, namespace)
+}
+
+func (g storeGetter) GetSharesByNamespaces(
+	ctx context.Context, root *share.Root, namespaces []share.Namespace,
+) ([]share.NamespacedShares, error) {
+	shares := make([]share.NamespacedShares, len(namespaces))
+	for i, namespace := range namespaces {
+		nsShares, err := g.GetSharesByNamespace(ctx, root, namespace)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = nsShares
+	}
+	return shares, nil
+}
+
+// rowsStoreGetter additionally streams the rows by namespace straight from the eds.Store.
+type rowsStoreGetter struct {
+	storeGetter
+}
+
+func (g rowsStoreGetter) GetRowsByNamespace(
+	ctx context.Context,
+	root *share.Root,
+	namespace share.Namespace,
+	fromRow, toRow int,
+	put func(row int, nr share.NamespacedRow) error,
+) error {
+	return g.store.GetRowsByNamespace(ctx, root, namespace, fromRow, toRow, put)
+}
+
 func newStore(t *testing.T) *eds.Store {
 	t.Helper()
 
@@ -145,7 +387,11 @@ func createMocknet(t *testing.T, amount int) []libhost.Host {
 
 func makeExchange(t *testing.T, getter share.Getter) (*eds.Store, *Client, *Server) {
 	t.Helper()
-	store := newStore(t)
+	return makeExchangeWithStore(t, newStore(t), getter)
+}
+
+func makeExchangeWithStore(t *testing.T, store *eds.Store, getter share.Getter) (*eds.Store, *Client, *Server) {
+	t.Helper()
 	hosts := createMocknet(t, 2)
 
 	client, err := NewClient(DefaultParameters(), hosts[0])
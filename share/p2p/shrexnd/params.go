@@ -2,13 +2,25 @@ package shrexnd
 
 import (
 	"fmt"
+	"strings"
 
 	logging "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/protocol"
 
 	"github.com/celestiaorg/celestia-node/share/p2p"
 )
 
-const protocolString = "/shrex/nd/v0.0.3"
+const (
+	// protocolString is the protocol of the requests of several namespaces at once or of a range of
+	// rows, whose responses carry the row indexes.
+	protocolString = "/shrex/nd/v0.0.4"
+	// legacyProtocolString is the protocol of the requests of a single namespace over the whole
+	// square. It is served and requested until all the peers support protocolString.
+	legacyProtocolString = "/shrex/nd/v0.0.3"
+)
+
+// MaxNamespacesPerRequest limits the amount of namespaces requested at once.
+const MaxNamespacesPerRequest = 64
 
 var log = logging.Logger("shrex/nd")
 
@@ -19,6 +31,12 @@ func DefaultParameters() *Parameters {
 	return p2p.DefaultParameters()
 }
 
+// isProtocol reports whether the stream protocol is the base protocol or one of its compressed
+// variants.
+func isProtocol(id, base protocol.ID) bool {
+	return id == base || strings.HasPrefix(string(id), string(base)+"/")
+}
+
 func (c *Client) WithMetrics() error {
 	metrics, err := p2p.InitClientMetrics("nd")
 	if err != nil {
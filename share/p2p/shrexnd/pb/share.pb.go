@@ -30,6 +30,7 @@ const (
 	StatusCode_OK        StatusCode = 1
 	StatusCode_NOT_FOUND StatusCode = 2
 	StatusCode_INTERNAL  StatusCode = 3
+	StatusCode_THROTTLED StatusCode = 4
 )
 
 var StatusCode_name = map[int32]string{
@@ -37,6 +38,7 @@ var StatusCode_name = map[int32]string{
 	1: "OK",
 	2: "NOT_FOUND",
 	3: "INTERNAL",
+	4: "THROTTLED",
 }
 
 var StatusCode_value = map[string]int32{
@@ -44,6 +46,7 @@ var StatusCode_value = map[string]int32{
 	"OK":        1,
 	"NOT_FOUND": 2,
 	"INTERNAL":  3,
+	"THROTTLED": 4,
 }
 
 func (x StatusCode) String() string {
@@ -57,6 +60,12 @@ func (StatusCode) EnumDescriptor() ([]byte, []int) {
 type GetSharesByNamespaceRequest struct {
 	RootHash  []byte `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
 	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// namespaces requests several namespaces at once, instead of the single namespace.
+	Namespaces [][]byte `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// from_row is the index of the first EDS row to respond with.
+	FromRow uint32 `protobuf:"varint,4,opt,name=from_row,json=fromRow,proto3" json:"from_row,omitempty"`
+	// to_row is the index of the EDS row to stop before. Zero means the end of the square.
+	ToRow uint32 `protobuf:"varint,5,opt,name=to_row,json=toRow,proto3" json:"to_row,omitempty"`
 }
 
 func (m *GetSharesByNamespaceRequest) Reset()         { *m = GetSharesByNamespaceRequest{} }
@@ -106,6 +115,27 @@ func (m *GetSharesByNamespaceRequest) GetNamespace() []byte {
 	return nil
 }
 
+func (m *GetSharesByNamespaceRequest) GetNamespaces() [][]byte {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *GetSharesByNamespaceRequest) GetFromRow() uint32 {
+	if m != nil {
+		return m.FromRow
+	}
+	return 0
+}
+
+func (m *GetSharesByNamespaceRequest) GetToRow() uint32 {
+	if m != nil {
+		return m.ToRow
+	}
+	return 0
+}
+
 type GetSharesByNamespaceStatusResponse struct {
 	Status StatusCode `protobuf:"varint,1,opt,name=status,proto3,enum=share.p2p.shrex.nd.StatusCode" json:"status,omitempty"`
 }
@@ -153,6 +183,10 @@ func (m *GetSharesByNamespaceStatusResponse) GetStatus() StatusCode {
 type NamespaceRowResponse struct {
 	Shares [][]byte  `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
 	Proof  *pb.Proof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// namespace_index is the index of the requested namespace the row belongs to.
+	NamespaceIndex uint32 `protobuf:"varint,3,opt,name=namespace_index,json=namespaceIndex,proto3" json:"namespace_index,omitempty"`
+	// row_index is the index of the EDS row.
+	RowIndex uint32 `protobuf:"varint,4,opt,name=row_index,json=rowIndex,proto3" json:"row_index,omitempty"`
 }
 
 func (m *NamespaceRowResponse) Reset()         { *m = NamespaceRowResponse{} }
@@ -202,6 +236,20 @@ func (m *NamespaceRowResponse) GetProof() *pb.Proof {
 	return nil
 }
 
+func (m *NamespaceRowResponse) GetNamespaceIndex() uint32 {
+	if m != nil {
+		return m.NamespaceIndex
+	}
+	return 0
+}
+
+func (m *NamespaceRowResponse) GetRowIndex() uint32 {
+	if m != nil {
+		return m.RowIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("share.p2p.shrex.nd.StatusCode", StatusCode_name, StatusCode_value)
 	proto.RegisterType((*GetSharesByNamespaceRequest)(nil), "share.p2p.shrex.nd.GetSharesByNamespaceRequest")
@@ -212,28 +260,34 @@ func init() {
 func init() { proto.RegisterFile("share/p2p/shrexnd/pb/share.proto", fileDescriptor_ed9f13149b0de397) }
 
 var fileDescriptor_ed9f13149b0de397 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x4f, 0x4b, 0xf3, 0x40,
-	0x10, 0xc6, 0x93, 0x96, 0x37, 0x6f, 0x3b, 0xad, 0x35, 0x2c, 0x22, 0xc5, 0xca, 0x52, 0x02, 0x42,
-	0xf1, 0xb0, 0x81, 0x08, 0x1e, 0x85, 0xd6, 0xfa, 0xa7, 0x58, 0x52, 0xd9, 0xb6, 0xe2, 0x41, 0x28,
-	0x1b, 0xbb, 0x92, 0x8b, 0xd9, 0x35, 0xbb, 0x45, 0xfd, 0x16, 0x7e, 0x2c, 0x8f, 0x3d, 0x7a, 0x94,
-	0xf6, 0x8b, 0x48, 0xb6, 0xd1, 0x1c, 0xf4, 0xb6, 0xf3, 0xcc, 0x33, 0xbf, 0x7d, 0x66, 0xa0, 0xad,
-	0x62, 0x96, 0x72, 0x5f, 0x06, 0xd2, 0x57, 0x71, 0xca, 0x5f, 0x92, 0xb9, 0x2f, 0x23, 0xdf, 0x88,
-	0x44, 0xa6, 0x42, 0x0b, 0x84, 0xf2, 0x22, 0x90, 0xc4, 0x38, 0x48, 0x32, 0xdf, 0x6b, 0xc8, 0xc8,
-	0x97, 0xa9, 0x10, 0x0f, 0x1b, 0x8f, 0x77, 0x0b, 0xad, 0x0b, 0xae, 0xc7, 0x99, 0x51, 0xf5, 0x5e,
-	0x43, 0xf6, 0xc8, 0x95, 0x64, 0xf7, 0x9c, 0xf2, 0xa7, 0x05, 0x57, 0x1a, 0xb5, 0xa0, 0x9a, 0x0a,
-	0xa1, 0x67, 0x31, 0x53, 0x71, 0xd3, 0x6e, 0xdb, 0x9d, 0x3a, 0xad, 0x64, 0xc2, 0x25, 0x53, 0x31,
-	0xda, 0x87, 0x6a, 0xf2, 0x3d, 0xd0, 0x2c, 0x99, 0x66, 0x21, 0x78, 0x77, 0xe0, 0xfd, 0x45, 0x1e,
-	0x6b, 0xa6, 0x17, 0x8a, 0x72, 0x25, 0x45, 0xa2, 0x38, 0x3a, 0x06, 0x47, 0x19, 0xc5, 0xd0, 0x1b,
-	0x01, 0x26, 0xbf, 0x43, 0x93, 0xcd, 0xcc, 0xa9, 0x98, 0x73, 0x9a, 0xbb, 0xbd, 0x29, 0xec, 0x14,
-	0x61, 0xc5, 0xf3, 0x0f, 0x6f, 0x17, 0x1c, 0x03, 0xc8, 0x78, 0xe5, 0x4e, 0x9d, 0xe6, 0x15, 0x3a,
-	0x80, 0x7f, 0x66, 0x6d, 0x93, 0xb3, 0x16, 0x6c, 0x93, 0xfc, 0x08, 0x11, 0xb9, 0xce, 0x1e, 0x74,
-	0xd3, 0x3d, 0x3c, 0x01, 0x28, 0x3e, 0x43, 0x35, 0xf8, 0x3f, 0x08, 0x6f, 0xba, 0xc3, 0x41, 0xdf,
-	0xb5, 0x90, 0x03, 0xa5, 0xd1, 0x95, 0x6b, 0xa3, 0x2d, 0xa8, 0x86, 0xa3, 0xc9, 0xec, 0x7c, 0x34,
-	0x0d, 0xfb, 0x6e, 0x09, 0xd5, 0xa1, 0x32, 0x08, 0x27, 0x67, 0x34, 0xec, 0x0e, 0xdd, 0x72, 0xaf,
-	0xf9, 0xbe, 0xc2, 0xf6, 0x72, 0x85, 0xed, 0xcf, 0x15, 0xb6, 0xdf, 0xd6, 0xd8, 0x5a, 0xae, 0xb1,
-	0xf5, 0xb1, 0xc6, 0x56, 0xe4, 0x98, 0x7b, 0x1f, 0x7d, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x53,
-	0xb4, 0x86, 0xb7, 0x01, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xaf, 0xdb, 0x35, 0x6b, 0xdf, 0xba, 0x2e, 0xb2, 0x00, 0x05, 0x86, 0xac, 0xaa, 0x12, 0xa2,
+	0xe2, 0x90, 0x48, 0x45, 0xe2, 0xbe, 0xd1, 0xc1, 0x2a, 0x4a, 0x8a, 0xbc, 0xc0, 0x09, 0xa9, 0x4a,
+	0x89, 0xa7, 0x70, 0x58, 0x9e, 0xb1, 0x3d, 0x65, 0x7c, 0x0b, 0xee, 0x7c, 0x03, 0x3e, 0x09, 0xc7,
+	0x1d, 0x39, 0xa2, 0xf6, 0x8b, 0x20, 0x3b, 0x21, 0x45, 0x62, 0x37, 0xff, 0xfe, 0xbc, 0xa7, 0xf7,
+	0xfb, 0xc9, 0x30, 0xd2, 0x79, 0xaa, 0x44, 0x24, 0xa7, 0x32, 0xd2, 0xb9, 0x12, 0x37, 0x45, 0x16,
+	0xc9, 0x75, 0xe4, 0xc8, 0x50, 0x2a, 0x34, 0x48, 0x69, 0x0d, 0xa6, 0x32, 0x74, 0x8e, 0xb0, 0xc8,
+	0x1e, 0x0d, 0xe5, 0x3a, 0x92, 0x0a, 0xf1, 0xb2, 0xf2, 0x8c, 0x7f, 0x10, 0x38, 0x7e, 0x2d, 0xcc,
+	0x85, 0x75, 0xea, 0xd3, 0xaf, 0x71, 0x7a, 0x25, 0xb4, 0x4c, 0x3f, 0x09, 0x2e, 0xbe, 0x5c, 0x0b,
+	0x6d, 0xe8, 0x31, 0xf4, 0x15, 0xa2, 0x59, 0xe5, 0xa9, 0xce, 0x03, 0x32, 0x22, 0x93, 0x01, 0xef,
+	0x59, 0xe2, 0x3c, 0xd5, 0x39, 0x7d, 0x0c, 0xfd, 0xe2, 0xef, 0x40, 0xd0, 0x76, 0xe2, 0x8e, 0xa0,
+	0x0c, 0xa0, 0x01, 0x3a, 0xe8, 0x8c, 0x3a, 0x93, 0x01, 0xff, 0x87, 0xa1, 0x0f, 0xa1, 0x77, 0xa9,
+	0xf0, 0x6a, 0xa5, 0xb0, 0x0c, 0xf6, 0x46, 0x64, 0x72, 0xc8, 0xf7, 0x2d, 0xe6, 0x58, 0xd2, 0xfb,
+	0xe0, 0x19, 0x74, 0x42, 0xd7, 0x09, 0x5d, 0x83, 0x1c, 0xcb, 0xf1, 0x47, 0x18, 0xdf, 0x75, 0xeb,
+	0x85, 0x49, 0xcd, 0xb5, 0xe6, 0x42, 0x4b, 0x2c, 0xb4, 0xa0, 0x2f, 0xc0, 0xd3, 0x8e, 0x71, 0xf7,
+	0x0e, 0xa7, 0x2c, 0xfc, 0xbf, 0x87, 0xb0, 0x9a, 0x79, 0x89, 0x99, 0xe0, 0xb5, 0x7b, 0xfc, 0x9d,
+	0xc0, 0xbd, 0x5d, 0x7e, 0x2c, 0x9b, 0x85, 0x0f, 0xc0, 0x73, 0x1b, 0xec, 0x42, 0x1b, 0xa2, 0x46,
+	0xf4, 0x09, 0x74, 0x5d, 0x95, 0x2e, 0xfa, 0xc1, 0xf4, 0x28, 0xac, 0x8b, 0x5d, 0x87, 0xef, 0xec,
+	0x83, 0x57, 0x2a, 0x7d, 0x0a, 0x47, 0x4d, 0xea, 0xd5, 0xe7, 0x22, 0x13, 0x37, 0x41, 0xc7, 0xa5,
+	0x1a, 0x36, 0xf4, 0xdc, 0xb2, 0x55, 0xd7, 0x65, 0x6d, 0xa9, 0x1a, 0xe9, 0x29, 0x2c, 0x9d, 0xf8,
+	0xec, 0x2d, 0xc0, 0xee, 0x66, 0x7a, 0x00, 0xfb, 0xf3, 0xf8, 0xc3, 0xc9, 0x62, 0x3e, 0xf3, 0x5b,
+	0xd4, 0x83, 0xf6, 0xf2, 0x8d, 0x4f, 0xe8, 0x21, 0xf4, 0xe3, 0x65, 0xb2, 0x7a, 0xb5, 0x7c, 0x1f,
+	0xcf, 0xfc, 0x36, 0x1d, 0x40, 0x6f, 0x1e, 0x27, 0x67, 0x3c, 0x3e, 0x59, 0xf8, 0x1d, 0x2b, 0x26,
+	0xe7, 0x7c, 0x99, 0x24, 0x8b, 0xb3, 0x99, 0xbf, 0x77, 0x1a, 0xfc, 0xdc, 0x30, 0x72, 0xbb, 0x61,
+	0xe4, 0xf7, 0x86, 0x91, 0x6f, 0x5b, 0xd6, 0xba, 0xdd, 0xb2, 0xd6, 0xaf, 0x2d, 0x6b, 0xad, 0x3d,
+	0xf7, 0x31, 0x9e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x10, 0x1f, 0xca, 0xf4, 0x60, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GetSharesByNamespaceRequest) Marshal() (dAtA []byte, err error) {
@@ -256,6 +310,25 @@ func (m *GetSharesByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.ToRow != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.ToRow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromRow != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.FromRow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintShare(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -321,6 +394,16 @@ func (m *NamespaceRowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RowIndex != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.RowIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NamespaceIndex != 0 {
+		i = encodeVarintShare(dAtA, i, uint64(m.NamespaceIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Proof != nil {
 		{
 			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
@@ -370,6 +453,18 @@ func (m *GetSharesByNamespaceRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovShare(uint64(l))
 	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovShare(uint64(l))
+		}
+	}
+	if m.FromRow != 0 {
+		n += 1 + sovShare(uint64(m.FromRow))
+	}
+	if m.ToRow != 0 {
+		n += 1 + sovShare(uint64(m.ToRow))
+	}
 	return n
 }
 
@@ -401,6 +496,12 @@ func (m *NamespaceRowResponse) Size() (n int) {
 		l = m.Proof.Size()
 		n += 1 + l + sovShare(uint64(l))
 	}
+	if m.NamespaceIndex != 0 {
+		n += 1 + sovShare(uint64(m.NamespaceIndex))
+	}
+	if m.RowIndex != 0 {
+		n += 1 + sovShare(uint64(m.RowIndex))
+	}
 	return n
 }
 
@@ -507,6 +608,76 @@ func (m *GetSharesByNamespaceRequest) Unmarshal(dAtA []byte) error {
 				m.Namespace = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthShare
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
+			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRow", wireType)
+			}
+			m.FromRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRow", wireType)
+			}
+			m.ToRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShare(dAtA[iNdEx:])
@@ -694,6 +865,44 @@ func (m *NamespaceRowResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceIndex", wireType)
+			}
+			m.NamespaceIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowIndex", wireType)
+			}
+			m.RowIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipShare(dAtA[iNdEx:])
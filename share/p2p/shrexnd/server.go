@@ -17,21 +17,34 @@ import (
 
 	"github.com/celestiaorg/celestia-node/share"
 	"github.com/celestiaorg/celestia-node/share/eds"
+	"github.com/celestiaorg/celestia-node/share/ipld"
 	"github.com/celestiaorg/celestia-node/share/p2p"
 	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexnd/pb"
 )
 
+// rowsGetter is implemented by the share.Getters able to get the rows within a namespace one by one.
+type rowsGetter interface {
+	GetRowsByNamespace(
+		ctx context.Context,
+		root *share.Root,
+		namespace share.Namespace,
+		fromRow, toRow int,
+		put func(row int, nr share.NamespacedRow) error,
+	) error
+}
+
 // Server implements server side of shrex/nd protocol to serve namespaced share to remote
 // peers.
 type Server struct {
 	cancel context.CancelFunc
 
-	host       host.Host
-	protocolID protocol.ID
+	host             host.Host
+	protocolID       protocol.ID
+	legacyProtocolID protocol.ID
 
 	handler network.StreamHandler
 	getter  share.Getter
-	store   *eds.Store
+	store   eds.EDSStore
 
 	params     *Parameters
 	middleware *p2p.Middleware
@@ -39,19 +52,23 @@ type Server struct {
 }
 
 // NewServer creates new Server
-func NewServer(params *Parameters, host host.Host, store *eds.Store, getter share.Getter) (*Server, error) {
+func NewServer(params *Parameters, host host.Host, store eds.EDSStore, getter share.Getter) (*Server, error) {
 	if err := params.Validate(); err != nil {
 		return nil, fmt.Errorf("shrex-nd: server creation failed: %w", err)
 	}
 
 	srv := &Server{
-		getter:     getter,
-		store:      store,
-		host:       host,
-		params:     params,
-		protocolID: p2p.ProtocolID(params.NetworkID(), protocolString),
-		middleware: p2p.NewMiddleware(params.ConcurrencyLimit),
+		getter:           getter,
+		store:            store,
+		host:             host,
+		params:           params,
+		protocolID:       p2p.ProtocolID(params.NetworkID(), protocolString),
+		legacyProtocolID: p2p.ProtocolID(params.NetworkID(), legacyProtocolString),
 	}
+	srv.middleware = p2p.NewMiddleware(params.ConcurrencyLimit, append(params.MiddlewareOptions(),
+		p2p.WithExemptPeers(p2p.IsProtected(host)),
+		p2p.WithThrottledHandler(srv.handleThrottled),
+	)...)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	srv.cancel = cancel
@@ -62,20 +79,40 @@ func NewServer(params *Parameters, host host.Host, store *eds.Store, getter shar
 
 // Start starts the server
 func (srv *Server) Start(context.Context) error {
-	srv.host.SetStreamHandler(srv.protocolID, srv.handler)
+	for _, id := range srv.protocolIDs() {
+		srv.host.SetStreamHandler(id, srv.handler)
+	}
 	return nil
 }
 
 // Stop stops the server
 func (srv *Server) Stop(context.Context) error {
 	srv.cancel()
-	srv.host.RemoveStreamHandler(srv.protocolID)
+	for _, id := range srv.protocolIDs() {
+		srv.host.RemoveStreamHandler(id)
+	}
 	return nil
 }
 
+// protocolIDs returns the IDs of both the current and the legacy protocols, so that the peers not
+// supporting the current one yet are served as well.
+func (srv *Server) protocolIDs() []protocol.ID {
+	return append(srv.params.ProtocolIDs(srv.protocolID), srv.params.ProtocolIDs(srv.legacyProtocolID)...)
+}
+
+// compressStream wraps the stream according to the negotiated protocol, reporting whether it is
+// the legacy one.
+func (srv *Server) compressStream(s network.Stream) (network.Stream, bool) {
+	if isProtocol(s.Protocol(), srv.legacyProtocolID) {
+		return p2p.CompressStream(s, srv.legacyProtocolID, srv.metrics), true
+	}
+	return p2p.CompressStream(s, srv.protocolID, srv.metrics), false
+}
+
 func (srv *Server) streamHandler(ctx context.Context) network.StreamHandler {
 	return func(s network.Stream) {
-		err := srv.handleNamespacedData(ctx, s)
+		s, legacy := srv.compressStream(s)
+		err := srv.handleNamespacedData(ctx, s, legacy)
 		if err != nil {
 			s.Reset() //nolint:errcheck
 			return
@@ -98,25 +135,36 @@ func (srv *Server) observeRateLimitedRequests() {
 	}
 }
 
-func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stream) error {
+func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stream, legacy bool) error {
 	logger := log.With("source", "server", "peer", stream.Conn().RemotePeer().String())
 	logger.Debug("handling nd request")
 
 	srv.observeRateLimitedRequests()
-	req, err := srv.readRequest(logger, stream)
+	req, err := srv.readRequest(logger, stream, legacy)
 	if err != nil {
 		logger.Warnw("read request", "err", err)
 		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusBadRequest)
 		return err
 	}
 
-	logger = logger.With("namespace", share.Namespace(req.Namespace).String(),
+	namespaces := requestedNamespaces(*req)
+	logger = logger.With("namespace", namespaces[0].String(), "namespaces", len(namespaces),
 		"hash", share.DataHash(req.RootHash).String())
 
 	ctx, cancel := context.WithTimeout(ctx, srv.params.HandleRequestTimeout)
 	defer cancel()
 
-	shares, status, err := srv.getNamespaceData(ctx, req.RootHash, req.Namespace)
+	dah, status, err := srv.getDAH(ctx, req.RootHash)
+	if err == nil && status == pb.StatusCode_OK {
+		if getter, ok := srv.getter.(rowsGetter); ok {
+			return srv.streamNamespacedRows(ctx, logger, stream, getter, dah, req, namespaces)
+		}
+	}
+
+	var shares []share.NamespacedShares
+	if err == nil && status == pb.StatusCode_OK {
+		shares, status, err = srv.getNamespaceData(ctx, dah, namespaces)
+	}
 	if err != nil {
 		// server should respond with status regardless if there was an error getting data
 		sendErr := srv.respondStatus(ctx, logger, stream, status)
@@ -135,7 +183,7 @@ func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stre
 		return err
 	}
 
-	err = srv.sendNamespacedShares(shares, stream)
+	err = srv.sendNamespacedShares(dah, req, namespaces, shares, stream)
 	if err != nil {
 		logger.Errorw("send nd data", "err", err)
 		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSendRespErr)
@@ -144,9 +192,60 @@ func (srv *Server) handleNamespacedData(ctx context.Context, stream network.Stre
 	return nil
 }
 
+// streamNamespacedRows responds with the rows as soon as each of them is read by the getter, so
+// the whole namespace data never has to be kept in memory.
+func (srv *Server) streamNamespacedRows(
+	ctx context.Context,
+	logger *zap.SugaredLogger,
+	stream network.Stream,
+	getter rowsGetter,
+	dah *share.Root,
+	req *pb.GetSharesByNamespaceRequest,
+	namespaces []share.Namespace,
+) error {
+	err := srv.respondStatus(ctx, logger, stream, pb.StatusCode_OK)
+	if err != nil {
+		logger.Errorw("sending response", "err", err)
+		srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSendRespErr)
+		return err
+	}
+
+	for idx, namespace := range namespaces {
+		put := func(row int, nr share.NamespacedRow) error {
+			return srv.sendRow(stream, idx, row, nr)
+		}
+		err = getter.GetRowsByNamespace(ctx, dah, namespace, int(req.FromRow), int(req.ToRow), put)
+		if err != nil {
+			// the status is already sent, so the client can only learn about the failure from the
+			// reset stream
+			logger.Errorw("streaming nd data", "err", err)
+			srv.metrics.ObserveRequests(ctx, 1, p2p.StatusSendRespErr)
+			stream.Reset() //nolint:errcheck
+			return err
+		}
+	}
+	return nil
+}
+
+// handleThrottled informs the client that its request was rate limited.
+func (srv *Server) handleThrottled(stream network.Stream) {
+	stream, _ = srv.compressStream(stream)
+	logger := log.With("source", "server", "peer", stream.Conn().RemotePeer().String())
+	err := srv.respondStatus(context.Background(), logger, stream, pb.StatusCode_THROTTLED)
+	if err != nil {
+		logger.Debugw("writing throttled status", "err", err)
+		stream.Reset() //nolint:errcheck
+		return
+	}
+	if err = stream.Close(); err != nil {
+		logger.Debugw("server: closing stream", "err", err)
+	}
+}
+
 func (srv *Server) readRequest(
 	logger *zap.SugaredLogger,
 	stream network.Stream,
+	legacy bool,
 ) (*pb.GetSharesByNamespaceRequest, error) {
 	err := stream.SetReadDeadline(time.Now().Add(srv.params.ServerReadTimeout))
 	if err != nil {
@@ -160,6 +259,11 @@ func (srv *Server) readRequest(
 
 	}
 
+	if legacy {
+		// the legacy protocol serves a single namespace over the whole square
+		req.Namespaces, req.FromRow, req.ToRow = nil, 0, 0
+	}
+
 	logger.Debugw("new request")
 	err = stream.CloseRead()
 	if err != nil {
@@ -173,8 +277,7 @@ func (srv *Server) readRequest(
 	return &req, nil
 }
 
-func (srv *Server) getNamespaceData(ctx context.Context,
-	hash share.DataHash, namespace share.Namespace) (share.NamespacedShares, pb.StatusCode, error) {
+func (srv *Server) getDAH(ctx context.Context, hash share.DataHash) (*share.Root, pb.StatusCode, error) {
 	dah, err := srv.store.GetDAH(ctx, hash)
 	if err != nil {
 		if errors.Is(err, eds.ErrNotFound) {
@@ -182,8 +285,12 @@ func (srv *Server) getNamespaceData(ctx context.Context,
 		}
 		return nil, pb.StatusCode_INTERNAL, fmt.Errorf("retrieving DAH: %w", err)
 	}
+	return dah, pb.StatusCode_OK, nil
+}
 
-	shares, err := srv.getter.GetSharesByNamespace(ctx, dah, namespace)
+func (srv *Server) getNamespaceData(ctx context.Context,
+	dah *share.Root, namespaces []share.Namespace) ([]share.NamespacedShares, pb.StatusCode, error) {
+	shares, err := srv.getter.GetSharesByNamespaces(ctx, dah, namespaces)
 	if err != nil {
 		return nil, pb.StatusCode_INTERNAL, fmt.Errorf("retrieving shares: %w", err)
 	}
@@ -212,27 +319,60 @@ func (srv *Server) respondStatus(
 	return nil
 }
 
-// sendNamespacedShares encodes shares into proto messages and sends it to client
-func (srv *Server) sendNamespacedShares(shares share.NamespacedShares, stream network.Stream) error {
-	for _, row := range shares {
-		row := &pb.NamespaceRowResponse{
-			Shares: row.Shares,
-			Proof: &nmt_pb.Proof{
-				Start:                 int64(row.Proof.Start()),
-				End:                   int64(row.Proof.End()),
-				Nodes:                 row.Proof.Nodes(),
-				LeafHash:              row.Proof.LeafHash(),
-				IsMaxNamespaceIgnored: row.Proof.IsMaxNamespaceIDIgnored(),
-			},
-		}
-		_, err := serde.Write(stream, row)
-		if err != nil {
-			return fmt.Errorf("writing nd data to stream: %w", err)
+// sendNamespacedShares encodes the shares within the requested row range into proto messages and
+// sends them to client. Rows are tagged with the index of the requested namespace they belong to.
+func (srv *Server) sendNamespacedShares(
+	dah *share.Root,
+	req *pb.GetSharesByNamespaceRequest,
+	namespaces []share.Namespace,
+	shares []share.NamespacedShares,
+	stream network.Stream,
+) error {
+	for idx, nsShares := range shares {
+		// rows of the namespace shares go in the order of the roots containing the namespace
+		rows := ipld.FilterRowsByNamespace(dah, namespaces[idx], 0, 0)
+		for i, nr := range nsShares {
+			if i >= len(rows) {
+				return fmt.Errorf("getter returned more rows than the root contains: %d", len(nsShares))
+			}
+			if rows[i] < int(req.FromRow) || (req.ToRow != 0 && rows[i] >= int(req.ToRow)) {
+				continue
+			}
+			if err := srv.sendRow(stream, idx, rows[i], nr); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// sendRow encodes the row into proto message and sends it to client. The write deadline is
+// renewed for every row, so that the big namespaces could be sent without hitting it.
+func (srv *Server) sendRow(stream network.Stream, nsIdx, rowIdx int, nr share.NamespacedRow) error {
+	err := stream.SetWriteDeadline(time.Now().Add(srv.params.ServerWriteTimeout))
+	if err != nil {
+		log.Debugw("server: setting write deadline", "err", err)
+	}
+
+	row := &pb.NamespaceRowResponse{
+		Shares: nr.Shares,
+		Proof: &nmt_pb.Proof{
+			Start:                 int64(nr.Proof.Start()),
+			End:                   int64(nr.Proof.End()),
+			Nodes:                 nr.Proof.Nodes(),
+			LeafHash:              nr.Proof.LeafHash(),
+			IsMaxNamespaceIgnored: nr.Proof.IsMaxNamespaceIDIgnored(),
+		},
+		NamespaceIndex: uint32(nsIdx),
+		RowIndex:       uint32(rowIdx),
+	}
+	_, err = serde.Write(stream, row)
+	if err != nil {
+		return fmt.Errorf("writing nd data to stream: %w", err)
+	}
+	return nil
+}
+
 func (srv *Server) observeStatus(ctx context.Context, status pb.StatusCode) {
 	switch {
 	case status == pb.StatusCode_OK:
@@ -246,11 +386,36 @@ func (srv *Server) observeStatus(ctx context.Context, status pb.StatusCode) {
 
 // validateRequest checks correctness of the request
 func validateRequest(req pb.GetSharesByNamespaceRequest) error {
-	if err := share.Namespace(req.Namespace).ValidateForData(); err != nil {
-		return err
+	if len(req.Namespaces) != 0 {
+		if len(req.Namespace) != 0 {
+			return errors.New("both namespace and namespaces are set")
+		}
+		if len(req.Namespaces) > MaxNamespacesPerRequest {
+			return fmt.Errorf("too many namespaces: %d > %d", len(req.Namespaces), MaxNamespacesPerRequest)
+		}
+	}
+	if req.ToRow != 0 && req.ToRow <= req.FromRow {
+		return fmt.Errorf("invalid row range: [%d, %d)", req.FromRow, req.ToRow)
+	}
+	for _, namespace := range requestedNamespaces(req) {
+		if err := namespace.ValidateForData(); err != nil {
+			return err
+		}
 	}
 	if len(req.RootHash) != sha256.Size {
 		return fmt.Errorf("incorrect root hash length: %v", len(req.RootHash))
 	}
 	return nil
 }
+
+// requestedNamespaces returns the namespaces requested either one by one or in a batch.
+func requestedNamespaces(req pb.GetSharesByNamespaceRequest) []share.Namespace {
+	if len(req.Namespaces) == 0 {
+		return []share.Namespace{req.Namespace}
+	}
+	namespaces := make([]share.Namespace, len(req.Namespaces))
+	for i, namespace := range req.Namespaces {
+		namespaces[i] = namespace
+	}
+	return namespaces
+}
@@ -0,0 +1,97 @@
+package shrexsub
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-node/share"
+)
+
+// NamespaceRange is an inclusive range of namespaces.
+type NamespaceRange struct {
+	Min, Max share.Namespace
+}
+
+// NamespaceFilter is a compact set of namespace ranges covering all the namespaces present in an
+// ODS. It may contain namespaces missing in the ODS, but never misses present ones.
+type NamespaceFilter []NamespaceRange
+
+// NewNamespaceFilter builds the NamespaceFilter out of the ODS row roots of the given DAH.
+// Ranges of subsequent rows sharing the bounding namespace are merged.
+func NewNamespaceFilter(dah *share.Root) NamespaceFilter {
+	odsWidth := len(dah.RowRoots) / 2
+	filter := make(NamespaceFilter, 0, odsWidth)
+	for _, root := range dah.RowRoots[:odsWidth] {
+		rng := NamespaceRange{
+			Min: share.Namespace(root[:share.NamespaceSize]),
+			Max: share.Namespace(root[share.NamespaceSize : share.NamespaceSize*2]),
+		}
+		if last := len(filter) - 1; last >= 0 && filter[last].Max.IsGreaterOrEqualThan(rng.Min) {
+			if rng.Max.IsGreater(filter[last].Max) {
+				filter[last].Max = rng.Max
+			}
+			continue
+		}
+		filter = append(filter, rng)
+	}
+	return filter
+}
+
+// Contains reports whether the namespace may be present in the ODS.
+func (f NamespaceFilter) Contains(namespace share.Namespace) bool {
+	for _, rng := range f {
+		if namespace.IsGreaterOrEqualThan(rng.Min) && namespace.IsLessOrEqual(rng.Max) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equals reports whether both filters contain the same ranges.
+func (f NamespaceFilter) Equals(target NamespaceFilter) bool {
+	if len(f) != len(target) {
+		return false
+	}
+	for i := range f {
+		if !f[i].Min.Equals(target[i].Min) || !f[i].Max.Equals(target[i].Max) {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalBinary encodes the filter as the concatenated bounds of its ranges.
+func (f NamespaceFilter) MarshalBinary() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, len(f)*share.NamespaceSize*2))
+	for _, rng := range f {
+		buf.Write(rng.Min)
+		buf.Write(rng.Max)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the filter encoded by MarshalBinary. Empty data decodes into nil filter.
+func (f *NamespaceFilter) UnmarshalBinary(data []byte) error {
+	const rangeSize = share.NamespaceSize * 2
+	if len(data) == 0 {
+		*f = nil
+		return nil
+	}
+	if len(data)%rangeSize != 0 {
+		return fmt.Errorf("invalid namespace filter length: %d", len(data))
+	}
+
+	filter := make(NamespaceFilter, 0, len(data)/rangeSize)
+	for i := 0; i < len(data); i += rangeSize {
+		rng := NamespaceRange{
+			Min: share.Namespace(data[i : i+share.NamespaceSize]),
+			Max: share.Namespace(data[i+share.NamespaceSize : i+rangeSize]),
+		}
+		if rng.Max.IsLess(rng.Min) {
+			return fmt.Errorf("invalid namespace range: %s > %s", rng.Min, rng.Max)
+		}
+		filter = append(filter, rng)
+	}
+	*f = filter
+	return nil
+}
@@ -0,0 +1,55 @@
+package shrexsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/pkg/da"
+
+	"github.com/celestiaorg/celestia-node/share"
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
+	"github.com/celestiaorg/celestia-node/share/sharetest"
+)
+
+func TestNamespaceFilter(t *testing.T) {
+	const odsWidth = 8
+	eds := edstest.RandEDS(t, odsWidth)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	filter := NewNamespaceFilter(&dah)
+	require.NotEmpty(t, filter)
+	require.LessOrEqual(t, len(filter), odsWidth)
+	for _, sh := range eds.FlattenedODS() {
+		assert.True(t, filter.Contains(share.GetNamespace(sh)))
+	}
+	// parity namespace is not a part of ODS
+	assert.False(t, filter.Contains(share.ParitySharesNamespace))
+
+	data, err := filter.MarshalBinary()
+	require.NoError(t, err)
+	var decoded NamespaceFilter
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	assert.True(t, filter.Equals(decoded))
+
+	assert.Error(t, decoded.UnmarshalBinary(data[1:]))
+	require.NoError(t, decoded.UnmarshalBinary(nil))
+	assert.Nil(t, decoded)
+}
+
+func TestNamespaceFilter_SingleNamespace(t *testing.T) {
+	namespace := sharetest.RandV0Namespace()
+	_, dah := edstest.RandEDSWithNamespace(t, namespace, 8)
+
+	// rows with the same namespace are merged into a single range
+	filter := NewNamespaceFilter(&dah)
+	require.Len(t, filter, 1)
+	assert.True(t, filter.Contains(namespace))
+
+	n := NewNotification(1, &dah)
+	assert.EqualValues(t, 8, n.SquareSize)
+	assert.True(t, n.Namespaces.Contains(namespace))
+	assert.False(t, n.Namespaces.Contains(sharetest.RandV0Namespace()))
+}
@@ -25,6 +25,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type RecentEDSNotification struct {
 	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	DataHash []byte `protobuf:"bytes,2,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	// square_size is the width of the ODS. Set since eds-sub/v0.2.0.
+	SquareSize uint32 `protobuf:"varint,3,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// namespace_filter is the encoded set of namespace ranges present in the ODS rows.
+	// Set since eds-sub/v0.2.0.
+	NamespaceFilter []byte `protobuf:"bytes,4,opt,name=namespace_filter,json=namespaceFilter,proto3" json:"namespace_filter,omitempty"`
+	// signature of the notification with the empty signature by the announcing peer.
+	// Set since eds-sub/v0.2.0.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *RecentEDSNotification) Reset()         { *m = RecentEDSNotification{} }
@@ -74,6 +82,27 @@ func (m *RecentEDSNotification) GetDataHash() []byte {
 	return nil
 }
 
+func (m *RecentEDSNotification) GetSquareSize() uint32 {
+	if m != nil {
+		return m.SquareSize
+	}
+	return 0
+}
+
+func (m *RecentEDSNotification) GetNamespaceFilter() []byte {
+	if m != nil {
+		return m.NamespaceFilter
+	}
+	return nil
+}
+
+func (m *RecentEDSNotification) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RecentEDSNotification)(nil), "share.p2p.shrex.sub.RecentEDSNotification")
 }
@@ -83,18 +112,23 @@ func init() {
 }
 
 var fileDescriptor_1a6ade914b560e62 = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0xce, 0x48, 0x2c,
-	0x4a, 0xd5, 0x2f, 0x30, 0x2a, 0xd0, 0x2f, 0xce, 0x28, 0x4a, 0xad, 0x28, 0x2e, 0x4d, 0xd2, 0x2f,
-	0x48, 0xd2, 0xcf, 0xcb, 0x2f, 0xc9, 0x4c, 0xcb, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0xab, 0xd4, 0x2b, 0x30, 0x2a, 0xd0, 0x03, 0xab, 0xd4,
-	0x2b, 0x2e, 0x4d, 0x52, 0xf2, 0xe1, 0x12, 0x0d, 0x4a, 0x4d, 0x4e, 0xcd, 0x2b, 0x71, 0x75, 0x09,
-	0xf6, 0x43, 0xd2, 0x23, 0x24, 0xc6, 0xc5, 0x96, 0x91, 0x9a, 0x99, 0x9e, 0x51, 0x22, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x12, 0x04, 0xe5, 0x09, 0x49, 0x73, 0x71, 0xa6, 0x24, 0x96, 0x24, 0xc6, 0x67,
-	0x24, 0x16, 0x67, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x04, 0x71, 0x80, 0x04, 0x3c, 0x12, 0x8b,
-	0x33, 0x9c, 0x24, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x89, 0x0d, 0xec,
-	0x06, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x16, 0xea, 0xc6, 0xaf, 0x00, 0x00, 0x00,
+	// 250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0xc1, 0x4a, 0xc4, 0x30,
+	0x10, 0x80, 0xe1, 0x46, 0xd7, 0xc5, 0x8d, 0x8a, 0x12, 0x51, 0x02, 0x4a, 0x2c, 0x9e, 0xea, 0xa5,
+	0x85, 0xf5, 0x0d, 0x44, 0xc5, 0x93, 0x87, 0xee, 0x03, 0x94, 0x69, 0x9d, 0xdd, 0x04, 0x34, 0x8d,
+	0x99, 0x14, 0x64, 0x9f, 0xc2, 0xa7, 0xf1, 0x19, 0x3c, 0xee, 0xd1, 0xa3, 0xb4, 0x2f, 0x22, 0x46,
+	0xd1, 0x3d, 0xce, 0xc7, 0x3f, 0x03, 0xc3, 0x33, 0xd2, 0xe0, 0xb1, 0x70, 0x53, 0x57, 0x90, 0xf6,
+	0xf8, 0x42, 0x5d, 0x5d, 0xb8, 0xba, 0xb0, 0x6d, 0x30, 0x73, 0xd3, 0x40, 0x30, 0xad, 0xcd, 0x9d,
+	0x6f, 0x43, 0x2b, 0x0e, 0x63, 0x99, 0xbb, 0xa9, 0xcb, 0x63, 0x99, 0x53, 0x57, 0x9f, 0xbf, 0x31,
+	0x7e, 0x54, 0x62, 0x83, 0x36, 0xdc, 0x5c, 0xcf, 0xee, 0xd7, 0x96, 0xc4, 0x31, 0x1f, 0x6b, 0x34,
+	0x0b, 0x1d, 0x24, 0x4b, 0x59, 0x36, 0x2a, 0x7f, 0x27, 0x71, 0xc2, 0x27, 0x0f, 0x10, 0xa0, 0xd2,
+	0x40, 0x5a, 0x6e, 0xa4, 0x2c, 0xdb, 0x2d, 0xb7, 0xbf, 0xe1, 0x0e, 0x48, 0x8b, 0x33, 0xbe, 0x43,
+	0xcf, 0x1d, 0x78, 0xac, 0xc8, 0x2c, 0x51, 0x6e, 0xa6, 0x2c, 0xdb, 0x2b, 0xf9, 0x0f, 0xcd, 0xcc,
+	0x12, 0xc5, 0x05, 0x3f, 0xb0, 0xf0, 0x84, 0xe4, 0xa0, 0xc1, 0x6a, 0x6e, 0x1e, 0x03, 0x7a, 0x39,
+	0x8a, 0x47, 0xf6, 0xff, 0xfc, 0x36, 0xb2, 0x38, 0xe5, 0x13, 0x32, 0x0b, 0x0b, 0xa1, 0xf3, 0x28,
+	0xb7, 0x62, 0xf3, 0x0f, 0x57, 0xf2, 0xbd, 0x57, 0x6c, 0xd5, 0x2b, 0xf6, 0xd9, 0x2b, 0xf6, 0x3a,
+	0xa8, 0x64, 0x35, 0xa8, 0xe4, 0x63, 0x50, 0x49, 0x3d, 0x8e, 0xef, 0x5e, 0x7e, 0x05, 0x00, 0x00,
+	0xff, 0xff, 0x71, 0x34, 0xcc, 0x77, 0x1a, 0x01, 0x00, 0x00,
 }
 
 func (m *RecentEDSNotification) Marshal() (dAtA []byte, err error) {
@@ -117,6 +151,25 @@ func (m *RecentEDSNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintNotification(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NamespaceFilter) > 0 {
+		i -= len(m.NamespaceFilter)
+		copy(dAtA[i:], m.NamespaceFilter)
+		i = encodeVarintNotification(dAtA, i, uint64(len(m.NamespaceFilter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintNotification(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DataHash) > 0 {
 		i -= len(m.DataHash)
 		copy(dAtA[i:], m.DataHash)
@@ -156,6 +209,17 @@ func (m *RecentEDSNotification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNotification(uint64(l))
 	}
+	if m.SquareSize != 0 {
+		n += 1 + sovNotification(uint64(m.SquareSize))
+	}
+	l = len(m.NamespaceFilter)
+	if l > 0 {
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovNotification(uint64(l))
+	}
 	return n
 }
 
@@ -247,6 +311,93 @@ func (m *RecentEDSNotification) Unmarshal(dAtA []byte) error {
 				m.DataHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceFilter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceFilter = append(m.NamespaceFilter[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceFilter == nil {
+				m.NamespaceFilter = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNotification(dAtA[iNdEx:])
@@ -2,10 +2,12 @@ package shrexsub
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	logging "github.com/ipfs/go-log/v2"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 
@@ -15,11 +17,21 @@ import (
 
 var log = logging.Logger("shrex-sub")
 
+// signaturePrefix separates signatures of notifications from other signatures of the same key.
+var signaturePrefix = []byte("shrexsub-notification:")
+
 // pubsubTopic hardcodes the name of the EDS floodsub topic with the provided networkID.
+// Notifications on the topic carry only the DataHash and Height.
 func pubsubTopicID(networkID string) string {
 	return fmt.Sprintf("%s/eds-sub/v0.1.0", networkID)
 }
 
+// pubsubTopicIDV2 hardcodes the name of the EDS floodsub topic with the provided networkID, where
+// notifications also carry the square size and the namespace filter, signed by the announcing peer.
+func pubsubTopicIDV2(networkID string) string {
+	return fmt.Sprintf("%s/eds-sub/v0.2.0", networkID)
+}
+
 // ValidatorFn is an injectable func and governs EDS notification msg validity.
 // It receives the notification and sender peer and expects the validation result.
 // ValidatorFn is allowed to be blocking for an indefinite time or until the context is canceled.
@@ -32,16 +44,38 @@ type BroadcastFn func(context.Context, Notification) error
 type Notification struct {
 	DataHash share.DataHash
 	Height   uint64
+	// SquareSize is the width of the ODS. It is zero in notifications from the v0.1.0 topic.
+	SquareSize uint32
+	// Namespaces is the filter of namespaces present in the ODS. It is nil in notifications from
+	// the v0.1.0 topic.
+	Namespaces NamespaceFilter
+}
+
+// NewNotification creates the Notification for the EDS of the given DAH at the given height.
+func NewNotification(height uint64, dah *share.Root) Notification {
+	return Notification{
+		DataHash:   dah.Hash(),
+		Height:     height,
+		SquareSize: uint32(len(dah.RowRoots) / 2),
+		Namespaces: NewNamespaceFilter(dah),
+	}
 }
 
 // PubSub manages receiving and propagating the EDS from/to the network
 // over "eds-sub" subscription.
 type PubSub struct {
 	pubSub *pubsub.PubSub
-	topic  *pubsub.Topic
+	// topic is the v0.2.0 topic, and legacyTopic is the v0.1.0 topic, kept until all the peers
+	// migrate.
+	topic       *pubsub.Topic
+	legacyTopic *pubsub.Topic
 
-	pubsubTopic string
-	cancelRelay pubsub.RelayCancelFunc
+	pubsubTopic       string
+	legacyPubsubTopic string
+	cancelRelay       []pubsub.RelayCancelFunc
+
+	// key signs the broadcast notifications
+	key crypto.PrivKey
 }
 
 // NewPubSub creates a libp2p.PubSub wrapper.
@@ -51,47 +85,70 @@ func NewPubSub(ctx context.Context, h host.Host, networkID string) (*PubSub, err
 		return nil, err
 	}
 	return &PubSub{
-		pubSub:      pubsub,
-		pubsubTopic: pubsubTopicID(networkID),
+		pubSub:            pubsub,
+		pubsubTopic:       pubsubTopicIDV2(networkID),
+		legacyPubsubTopic: pubsubTopicID(networkID),
+		key:               h.Peerstore().PrivKey(h.ID()),
 	}, nil
 }
 
-// Start creates an instances of FloodSub and joins specified topic.
+// Start creates an instances of FloodSub and joins specified topics.
 func (s *PubSub) Start(context.Context) error {
-	topic, err := s.pubSub.Join(s.pubsubTopic)
+	legacyTopic, err := s.join(s.legacyPubsubTopic)
 	if err != nil {
 		return err
 	}
-
-	cancel, err := topic.Relay()
+	topic, err := s.join(s.pubsubTopic)
 	if err != nil {
 		return err
 	}
 
-	s.cancelRelay = cancel
+	s.legacyTopic = legacyTopic
 	s.topic = topic
 	return nil
 }
 
+func (s *PubSub) join(topicID string) (*pubsub.Topic, error) {
+	topic, err := s.pubSub.Join(topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	cancel, err := topic.Relay()
+	if err != nil {
+		return nil, err
+	}
+	s.cancelRelay = append(s.cancelRelay, cancel)
+	return topic, nil
+}
+
 // Stop completely stops the PubSub:
 // * Unregisters all the added Validators
-// * Closes the `ShrEx/Sub` topic
+// * Closes the `ShrEx/Sub` topics
 func (s *PubSub) Stop(context.Context) error {
-	s.cancelRelay()
-	err := s.pubSub.UnregisterTopicValidator(s.pubsubTopic)
-	if err != nil {
-		log.Warnw("unregistering topic", "err", err)
+	for _, cancel := range s.cancelRelay {
+		cancel()
+	}
+	for _, topicID := range []string{s.legacyPubsubTopic, s.pubsubTopic} {
+		err := s.pubSub.UnregisterTopicValidator(topicID)
+		if err != nil {
+			log.Warnw("unregistering topic", "topic", topicID, "err", err)
+		}
 	}
-	return s.topic.Close()
+	return errors.Join(s.legacyTopic.Close(), s.topic.Close())
 }
 
-// AddValidator registers given ValidatorFn for EDS notifications.
+// AddValidator registers given ValidatorFn for EDS notifications from both topics.
 // Any amount of Validators can be registered.
 func (s *PubSub) AddValidator(v ValidatorFn) error {
+	err := s.pubSub.RegisterTopicValidator(s.legacyPubsubTopic, v.validateLegacy)
+	if err != nil {
+		return err
+	}
 	return s.pubSub.RegisterTopicValidator(s.pubsubTopic, v.validate)
 }
 
-func (v ValidatorFn) validate(ctx context.Context, p peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+func (v ValidatorFn) validateLegacy(ctx context.Context, p peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
 	var pbmsg pb.RecentEDSNotification
 	if err := pbmsg.Unmarshal(msg.Data); err != nil {
 		log.Debugw("validator: unmarshal error", "err", err)
@@ -109,28 +166,157 @@ func (v ValidatorFn) validate(ctx context.Context, p peer.ID, msg *pubsub.Messag
 	return v(ctx, p, n)
 }
 
-// Subscribe provides a new Subscription for EDS notifications.
+func (v ValidatorFn) validate(ctx context.Context, p peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	var pbmsg pb.RecentEDSNotification
+	if err := pbmsg.Unmarshal(msg.Data); err != nil {
+		log.Debugw("validator: unmarshal error", "err", err)
+		return pubsub.ValidationReject
+	}
+
+	if err := verifySignature(msg, &pbmsg); err != nil {
+		log.Debugw("validator: invalid signature", "peer", p.String(), "err", err)
+		return pubsub.ValidationReject
+	}
+
+	n, err := notificationFromProto(&pbmsg)
+	if err != nil {
+		log.Debugw("validator: invalid notification", "peer", p.String(), "err", err)
+		return pubsub.ValidationReject
+	}
+	if n.DataHash.IsEmptyRoot() {
+		// we don't send empty EDS data hashes, but If someone sent it to us - do hard reject
+		return pubsub.ValidationReject
+	}
+	return v(ctx, p, n)
+}
+
+// Subscribe provides a new Subscription for EDS notifications from both topics, so that the
+// notifications of the peers publishing only on the v0.1.0 topic are received during the migration.
 func (s *PubSub) Subscribe() (*Subscription, error) {
 	if s.topic == nil {
 		return nil, fmt.Errorf("shrex-sub: topic is not started")
 	}
-	return newSubscription(s.topic)
+	return newSubscription(s.topic, s.legacyTopic)
 }
 
-// Broadcast sends the EDS notification (DataHash) to every connected peer.
+// Broadcast sends the EDS notification to every connected peer on both topics. The notification
+// is signed on the v0.2.0 topic. Failing to publish on one topic does not prevent publishing on
+// the other.
 func (s *PubSub) Broadcast(ctx context.Context, notification Notification) error {
 	if notification.DataHash.IsEmptyRoot() {
 		// no need to broadcast datahash of an empty block EDS
 		return nil
 	}
 
-	msg := pb.RecentEDSNotification{
+	// subscribers deduplicate the notifications, but still receive the signed one if the legacy one
+	// arrives first
+	return errors.Join(
+		s.broadcast(ctx, notification),
+		s.broadcastLegacy(ctx, notification),
+	)
+}
+
+func (s *PubSub) broadcastLegacy(ctx context.Context, notification Notification) error {
+	legacy := pb.RecentEDSNotification{
 		Height:   notification.Height,
 		DataHash: notification.DataHash,
 	}
+	data, err := legacy.Marshal()
+	if err != nil {
+		return fmt.Errorf("shrex-sub: marshal notification, %w", err)
+	}
+	return s.legacyTopic.Publish(ctx, data)
+}
+
+func (s *PubSub) broadcast(ctx context.Context, notification Notification) error {
+	msg, err := notificationToProto(notification)
+	if err != nil {
+		return fmt.Errorf("shrex-sub: marshal notification, %w", err)
+	}
+	payload, err := signedPayload(msg)
+	if err != nil {
+		return fmt.Errorf("shrex-sub: marshal notification, %w", err)
+	}
+	msg.Signature, err = s.key.Sign(payload)
+	if err != nil {
+		return fmt.Errorf("shrex-sub: sign notification, %w", err)
+	}
 	data, err := msg.Marshal()
 	if err != nil {
 		return fmt.Errorf("shrex-sub: marshal notification, %w", err)
 	}
 	return s.topic.Publish(ctx, data)
 }
+
+func notificationToProto(n Notification) (*pb.RecentEDSNotification, error) {
+	filter, err := n.Namespaces.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RecentEDSNotification{
+		Height:          n.Height,
+		DataHash:        n.DataHash,
+		SquareSize:      n.SquareSize,
+		NamespaceFilter: filter,
+	}, nil
+}
+
+func notificationFromProto(msg *pb.RecentEDSNotification) (Notification, error) {
+	var filter NamespaceFilter
+	if err := filter.UnmarshalBinary(msg.NamespaceFilter); err != nil {
+		return Notification{}, err
+	}
+	return Notification{
+		DataHash:   msg.DataHash,
+		Height:     msg.Height,
+		SquareSize: msg.SquareSize,
+		Namespaces: filter,
+	}, nil
+}
+
+// signedPayload returns the bytes of the notification covered by its signature.
+func signedPayload(msg *pb.RecentEDSNotification) ([]byte, error) {
+	unsigned := *msg
+	unsigned.Signature = nil
+	data, err := unsigned.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, signaturePrefix...), data...), nil
+}
+
+// verifySignature verifies the notification was signed by the author of the pubsub message.
+func verifySignature(msg *pubsub.Message, pbmsg *pb.RecentEDSNotification) error {
+	if len(pbmsg.Signature) == 0 {
+		return errors.New("missing signature")
+	}
+
+	author := msg.GetFrom()
+	if author == "" {
+		return errors.New("missing author")
+	}
+	key, err := author.ExtractPublicKey()
+	if err != nil {
+		// keys not inlined into peer IDs are sent along with the message
+		key, err = crypto.UnmarshalPublicKey(msg.GetKey())
+		if err != nil {
+			return fmt.Errorf("extracting public key: %w", err)
+		}
+		if !author.MatchesPublicKey(key) {
+			return errors.New("public key does not match the author")
+		}
+	}
+
+	payload, err := signedPayload(pbmsg)
+	if err != nil {
+		return err
+	}
+	ok, err := key.Verify(payload, pbmsg.Signature)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("signature does not match")
+	}
+	return nil
+}
@@ -6,10 +6,13 @@ import (
 	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/celestiaorg/celestia-node/share/eds/edstest"
 	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexsub/pb"
+	"github.com/celestiaorg/celestia-node/share/sharetest"
 )
 
 func TestPubSub(t *testing.T) {
@@ -50,3 +53,131 @@ func TestPubSub(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, notification, got)
 }
+
+func TestPubSub_Signed(t *testing.T) {
+	h, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	pSub1, err := NewPubSub(ctx, h.Hosts()[0], "test")
+	require.NoError(t, err)
+	pSub2, err := NewPubSub(ctx, h.Hosts()[1], "test")
+	require.NoError(t, err)
+	require.NoError(t, pSub1.Start(ctx))
+	require.NoError(t, pSub2.Start(ctx))
+
+	validated := make(chan Notification, 4)
+	err = pSub2.AddValidator(func(_ context.Context, _ peer.ID, n Notification) pubsub.ValidationResult {
+		validated <- n
+		return pubsub.ValidationAccept
+	})
+	require.NoError(t, err)
+
+	subs, err := pSub2.Subscribe()
+	require.NoError(t, err)
+
+	_, dah := edstest.RandEDSWithNamespace(t, sharetest.RandV0Namespace(), 4)
+	notification := NewNotification(1, &dah)
+
+	// wait until peers join the topics
+	require.Eventually(t, func() bool {
+		return len(pSub1.topic.ListPeers()) == 1 && len(pSub1.legacyTopic.ListPeers()) == 1
+	}, time.Second, time.Millisecond*10)
+	require.NoError(t, pSub1.Broadcast(ctx, notification))
+
+	// the legacy notification may arrive first, but the signed one is received regardless
+	got, err := subs.Next(ctx)
+	require.NoError(t, err)
+	if got.Namespaces == nil {
+		got, err = subs.Next(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, notification, got)
+
+	// both the legacy and the signed notifications are validated
+	var legacy, signed int
+	for i := 0; i < 2; i++ {
+		select {
+		case n := <-validated:
+			if n.Namespaces == nil {
+				legacy++
+				continue
+			}
+			signed++
+			require.Equal(t, notification, n)
+		case <-ctx.Done():
+			t.Fatal("notification was not validated")
+		}
+	}
+	require.Equal(t, 1, legacy)
+	require.Equal(t, 1, signed)
+
+	// notifications with invalid signature are rejected before reaching validators
+	msg, err := notificationToProto(notification)
+	require.NoError(t, err)
+	msg.Signature = []byte("invalid")
+	data, err := msg.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, pSub1.topic.Publish(ctx, data))
+
+	select {
+	case <-validated:
+		t.Fatal("notification with invalid signature was validated")
+	case <-time.After(time.Millisecond * 200):
+	}
+}
+
+func TestPubSub_Legacy(t *testing.T) {
+	h, err := mocknet.FullMeshConnected(2)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+
+	pSub1, err := NewPubSub(ctx, h.Hosts()[0], "test")
+	require.NoError(t, err)
+	pSub2, err := NewPubSub(ctx, h.Hosts()[1], "test")
+	require.NoError(t, err)
+	require.NoError(t, pSub1.Start(ctx))
+	require.NoError(t, pSub2.Start(ctx))
+
+	subs, err := pSub2.Subscribe()
+	require.NoError(t, err)
+	t.Cleanup(subs.Cancel)
+	require.Eventually(t, func() bool {
+		return len(pSub1.topic.ListPeers()) == 1 && len(pSub1.legacyTopic.ListPeers()) == 1
+	}, time.Second, time.Millisecond*10)
+
+	// notifications of the peers publishing only on the legacy topic are received
+	legacy := Notification{DataHash: []byte("legacy"), Height: 1}
+	require.NoError(t, pSub1.broadcastLegacy(ctx, legacy))
+	got, err := subs.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, legacy, got)
+
+	// signed notifications arriving after the legacy ones are received as well
+	_, dah := edstest.RandEDSWithNamespace(t, sharetest.RandV0Namespace(), 4)
+	notification := NewNotification(2, &dah)
+	require.NoError(t, pSub1.broadcastLegacy(ctx, notification))
+	got, err = subs.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, Notification{DataHash: notification.DataHash, Height: notification.Height}, got)
+	require.NoError(t, pSub1.broadcast(ctx, notification))
+	got, err = subs.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, notification, got)
+
+	// legacy notifications arriving after the signed ones are dropped
+	_, dah = edstest.RandEDSWithNamespace(t, sharetest.RandV0Namespace(), 4)
+	notification = NewNotification(3, &dah)
+	require.NoError(t, pSub1.broadcast(ctx, notification))
+	got, err = subs.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, notification, got)
+	require.NoError(t, pSub1.broadcastLegacy(ctx, notification))
+
+	nextCtx, nextCancel := context.WithTimeout(ctx, time.Millisecond*200)
+	defer nextCancel()
+	_, err = subs.Next(nextCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
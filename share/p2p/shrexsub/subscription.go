@@ -4,48 +4,123 @@ import (
 	"context"
 	"fmt"
 
+	lru "github.com/hashicorp/golang-lru"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 
 	pb "github.com/celestiaorg/celestia-node/share/p2p/shrexsub/pb"
 )
 
-// Subscription is a wrapper over pubsub.Subscription that handles
+// seenCacheSize is the amount of recent notifications remembered to deduplicate the
+// notifications received on both topics.
+const seenCacheSize = 128
+
+// Subscription is a wrapper over the pubsub.Subscriptions of both topics that handles
 // receiving an EDS DataHash from other peers.
 type Subscription struct {
-	subscription *pubsub.Subscription
+	subscriptions []*pubsub.Subscription
+	legacyTopic   string
+
+	msgs   chan *pubsub.Message
+	errs   chan error
+	cancel context.CancelFunc
+	// seen deduplicates the notifications of the same EDS, published on both topics by the
+	// migrated peers. It maps the DataHashes to whether the signed notification was received.
+	seen *lru.Cache
 }
 
-func newSubscription(t *pubsub.Topic) (*Subscription, error) {
-	subs, err := t.Subscribe()
+func newSubscription(topic, legacyTopic *pubsub.Topic) (*Subscription, error) {
+	seen, err := lru.New(seenCacheSize)
 	if err != nil {
 		return nil, err
 	}
+	ctx, cancel := context.WithCancel(context.Background())
+	subs := &Subscription{
+		legacyTopic: legacyTopic.String(),
+		msgs:        make(chan *pubsub.Message),
+		errs:        make(chan error, 2),
+		cancel:      cancel,
+		seen:        seen,
+	}
+	for _, t := range []*pubsub.Topic{topic, legacyTopic} {
+		sub, err := t.Subscribe()
+		if err != nil {
+			subs.Cancel()
+			return nil, err
+		}
+		subs.subscriptions = append(subs.subscriptions, sub)
+		go subs.listen(ctx, sub)
+	}
+	return subs, nil
+}
 
-	return &Subscription{subscription: subs}, nil
+// listen forwards the messages of the subscription until it fails or ctx is done.
+func (subs *Subscription) listen(ctx context.Context, sub *pubsub.Subscription) {
+	for {
+		msg, err := sub.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				subs.errs <- err
+			}
+			return
+		}
+		select {
+		case subs.msgs <- msg:
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
-// Next blocks the caller until any new EDS DataHash notification arrives.
-// Returns only notifications which successfully pass validation.
+// Next blocks the caller until any new EDS DataHash notification arrives on either topic.
+// Returns only notifications which successfully pass validation, once per EDS and topic. The
+// topics are read concurrently, so the signed notification is returned even if the legacy one of
+// the same EDS arrived first, as it carries more fields.
 func (subs *Subscription) Next(ctx context.Context) (Notification, error) {
-	msg, err := subs.subscription.Next(ctx)
-	if err != nil {
-		log.Errorw("listening for the next eds hash", "err", err)
-		return Notification{}, err
+	for {
+		var msg *pubsub.Message
+		select {
+		case msg = <-subs.msgs:
+		case err := <-subs.errs:
+			log.Errorw("listening for the next eds hash", "err", err)
+			return Notification{}, err
+		case <-ctx.Done():
+			return Notification{}, ctx.Err()
+		}
+
+		log.Debugw("received message", "topic", msg.Message.GetTopic(), "sender", msg.ReceivedFrom)
+		n, err := subs.notification(msg)
+		if err != nil {
+			log.Debugw("unmarshal error", "err", err)
+			return Notification{}, fmt.Errorf("shrex-sub: unmarshal notification, %w", err)
+		}
+		signed := msg.GetTopic() != subs.legacyTopic
+		if seenSigned, ok := subs.seen.Get(n.DataHash.String()); ok && (seenSigned.(bool) || !signed) {
+			continue
+		}
+		subs.seen.Add(n.DataHash.String(), signed)
+		return n, nil
 	}
+}
 
-	log.Debugw("received message", "topic", msg.Message.GetTopic(), "sender", msg.ReceivedFrom)
+func (subs *Subscription) notification(msg *pubsub.Message) (Notification, error) {
 	var pbmsg pb.RecentEDSNotification
 	if err := pbmsg.Unmarshal(msg.Data); err != nil {
-		log.Debugw("unmarshal error", "err", err)
-		return Notification{}, fmt.Errorf("shrex-sub: unmarshal notification, %w", err)
+		return Notification{}, err
+	}
+	if msg.GetTopic() == subs.legacyTopic {
+		// the legacy notifications are not signed, so only the fields of v0.1.0 are trusted
+		return Notification{
+			DataHash: pbmsg.DataHash,
+			Height:   pbmsg.Height,
+		}, nil
 	}
-	return Notification{
-		DataHash: pbmsg.DataHash,
-		Height:   pbmsg.Height,
-	}, nil
+	return notificationFromProto(&pbmsg)
 }
 
 // Cancel stops the subscription.
 func (subs *Subscription) Cancel() {
-	subs.subscription.Cancel()
+	subs.cancel()
+	for _, sub := range subs.subscriptions {
+		sub.Cancel()
+	}
 }
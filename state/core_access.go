@@ -38,10 +38,26 @@ import (
 var (
 	log              = logging.Logger("state")
 	ErrInvalidAmount = errors.New("state: amount must be greater than zero")
+	// ErrSpendLimitExceeded is returned when the fee estimated for the caller exceeds the spend
+	// limit attached to the context with WithSpendLimit.
+	ErrSpendLimitExceeded = errors.New("state: estimated fee exceeds the spend limit")
 )
 
 const maxRetries = 5
 
+type spendLimitKey struct{}
+
+// WithSpendLimit limits the fees estimated for the calls made with the context to the given amount
+// in utia.
+func WithSpendLimit(ctx context.Context, limit uint64) context.Context {
+	return context.WithValue(ctx, spendLimitKey{}, limit)
+}
+
+func spendLimitFromContext(ctx context.Context) (uint64, bool) {
+	limit, ok := ctx.Value(spendLimitKey{}).(uint64)
+	return limit, ok
+}
+
 // CoreAccessor implements service over a gRPC connection
 // with a celestia-core node.
 type CoreAccessor struct {
@@ -227,6 +243,12 @@ func (ca *CoreAccessor) SubmitPayForBlob(
 
 	var lastErr error
 	for attempt := 0; attempt < maxRetries; attempt++ {
+		// the estimated fee is unknown to the caller, so its spend limit can only be checked here
+		if limit, ok := spendLimitFromContext(ctx); estimatedFee && ok &&
+			(!fee.IsUint64() || fee.Uint64() > limit) {
+			return nil, fmt.Errorf("%w: %s > %d", ErrSpendLimitExceeded, fee, limit)
+		}
+
 		response, err := appblob.SubmitPayForBlob(
 			ctx,
 			ca.signer,
@@ -88,6 +88,12 @@ func TestSubmitPayForBlob(t *testing.T) {
 		})
 	}
 
+	t.Run("estimated fee over the spend limit", func(t *testing.T) {
+		ctx := WithSpendLimit(ctx, 1)
+		_, err := ca.SubmitPayForBlob(ctx, sdktypes.NewInt(-1), 0, []*blob.Blob{blobbyTheBlob})
+		require.ErrorIs(t, err, ErrSpendLimitExceeded)
+	})
+
 }
 
 func extractPort(addr string) string {